@@ -25,6 +25,15 @@ const (
 	UsersAdminService_UpdateUserRole_FullMethodName      = "/usersservice.v1.UsersAdminService/UpdateUserRole"
 	UsersAdminService_BanUser_FullMethodName             = "/usersservice.v1.UsersAdminService/BanUser"
 	UsersAdminService_UnbanUser_FullMethodName           = "/usersservice.v1.UsersAdminService/UnbanUser"
+	UsersAdminService_ExportUserData_FullMethodName      = "/usersservice.v1.UsersAdminService/ExportUserData"
+	UsersAdminService_ReconcileCoins_FullMethodName      = "/usersservice.v1.UsersAdminService/ReconcileCoins"
+	UsersAdminService_CreateSeason_FullMethodName        = "/usersservice.v1.UsersAdminService/CreateSeason"
+	UsersAdminService_UpdateSeason_FullMethodName        = "/usersservice.v1.UsersAdminService/UpdateSeason"
+	UsersAdminService_ListSeasons_FullMethodName         = "/usersservice.v1.UsersAdminService/ListSeasons"
+	UsersAdminService_ListReports_FullMethodName         = "/usersservice.v1.UsersAdminService/ListReports"
+	UsersAdminService_ClaimReport_FullMethodName         = "/usersservice.v1.UsersAdminService/ClaimReport"
+	UsersAdminService_ResolveReport_FullMethodName       = "/usersservice.v1.UsersAdminService/ResolveReport"
+	UsersAdminService_DismissReport_FullMethodName       = "/usersservice.v1.UsersAdminService/DismissReport"
 )
 
 // UsersAdminServiceClient is the client API for UsersAdminService service.
@@ -36,6 +45,15 @@ type UsersAdminServiceClient interface {
 	UpdateUserRole(ctx context.Context, in *UpdateUserRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDataChunk], error)
+	ReconcileCoins(ctx context.Context, in *ReconcileCoinsRequest, opts ...grpc.CallOption) (*ReconcileCoinsResponse, error)
+	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	ListSeasons(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	ClaimReport(ctx context.Context, in *ClaimReportRequest, opts ...grpc.CallOption) (*UserReport, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*UserReport, error)
+	DismissReport(ctx context.Context, in *DismissReportRequest, opts ...grpc.CallOption) (*UserReport, error)
 }
 
 type usersAdminServiceClient struct {
@@ -96,6 +114,105 @@ func (c *usersAdminServiceClient) UnbanUser(ctx context.Context, in *UnbanUserRe
 	return out, nil
 }
 
+func (c *usersAdminServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDataChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UsersAdminService_ServiceDesc.Streams[0], UsersAdminService_ExportUserData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserDataRequest, ExportDataChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersAdminService_ExportUserDataClient = grpc.ServerStreamingClient[ExportDataChunk]
+
+func (c *usersAdminServiceClient) ReconcileCoins(ctx context.Context, in *ReconcileCoinsRequest, opts ...grpc.CallOption) (*ReconcileCoinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileCoinsResponse)
+	err := c.cc.Invoke(ctx, UsersAdminService_ReconcileCoins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, UsersAdminService_CreateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, UsersAdminService_UpdateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) ListSeasons(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, UsersAdminService_ListSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, UsersAdminService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) ClaimReport(ctx context.Context, in *ClaimReportRequest, opts ...grpc.CallOption) (*UserReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReport)
+	err := c.cc.Invoke(ctx, UsersAdminService_ClaimReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*UserReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReport)
+	err := c.cc.Invoke(ctx, UsersAdminService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersAdminServiceClient) DismissReport(ctx context.Context, in *DismissReportRequest, opts ...grpc.CallOption) (*UserReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReport)
+	err := c.cc.Invoke(ctx, UsersAdminService_DismissReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersAdminServiceServer is the server API for UsersAdminService service.
 // All implementations should embed UnimplementedUsersAdminServiceServer
 // for forward compatibility.
@@ -105,6 +222,15 @@ type UsersAdminServiceServer interface {
 	UpdateUserRole(context.Context, *UpdateUserRoleRequest) (*emptypb.Empty, error)
 	BanUser(context.Context, *BanUserRequest) (*emptypb.Empty, error)
 	UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error)
+	ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[ExportDataChunk]) error
+	ReconcileCoins(context.Context, *ReconcileCoinsRequest) (*ReconcileCoinsResponse, error)
+	CreateSeason(context.Context, *CreateSeasonRequest) (*Season, error)
+	UpdateSeason(context.Context, *UpdateSeasonRequest) (*Season, error)
+	ListSeasons(context.Context, *emptypb.Empty) (*ListSeasonsResponse, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	ClaimReport(context.Context, *ClaimReportRequest) (*UserReport, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*UserReport, error)
+	DismissReport(context.Context, *DismissReportRequest) (*UserReport, error)
 }
 
 // UnimplementedUsersAdminServiceServer should be embedded to have
@@ -129,6 +255,33 @@ func (UnimplementedUsersAdminServiceServer) BanUser(context.Context, *BanUserReq
 func (UnimplementedUsersAdminServiceServer) UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
 }
+func (UnimplementedUsersAdminServiceServer) ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[ExportDataChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) ReconcileCoins(context.Context, *ReconcileCoinsRequest) (*ReconcileCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileCoins not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) CreateSeason(context.Context, *CreateSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeason not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) UpdateSeason(context.Context, *UpdateSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeason not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) ListSeasons(context.Context, *emptypb.Empty) (*ListSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasons not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) ClaimReport(context.Context, *ClaimReportRequest) (*UserReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReport not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*UserReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedUsersAdminServiceServer) DismissReport(context.Context, *DismissReportRequest) (*UserReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissReport not implemented")
+}
 func (UnimplementedUsersAdminServiceServer) testEmbeddedByValue() {}
 
 // UnsafeUsersAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -239,6 +392,161 @@ func _UsersAdminService_UnbanUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersAdminService_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsersAdminServiceServer).ExportUserData(m, &grpc.GenericServerStream[ExportUserDataRequest, ExportDataChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersAdminService_ExportUserDataServer = grpc.ServerStreamingServer[ExportDataChunk]
+
+func _UsersAdminService_ReconcileCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).ReconcileCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_ReconcileCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).ReconcileCoins(ctx, req.(*ReconcileCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_CreateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).CreateSeason(ctx, req.(*CreateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_UpdateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).UpdateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_UpdateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).UpdateSeason(ctx, req.(*UpdateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).ListSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_ListSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).ListSeasons(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_ClaimReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).ClaimReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_ClaimReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).ClaimReport(ctx, req.(*ClaimReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersAdminService_DismissReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersAdminServiceServer).DismissReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersAdminService_DismissReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersAdminServiceServer).DismissReport(ctx, req.(*DismissReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersAdminService_ServiceDesc is the grpc.ServiceDesc for UsersAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -266,7 +574,45 @@ var UsersAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnbanUser",
 			Handler:    _UsersAdminService_UnbanUser_Handler,
 		},
+		{
+			MethodName: "ReconcileCoins",
+			Handler:    _UsersAdminService_ReconcileCoins_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _UsersAdminService_CreateSeason_Handler,
+		},
+		{
+			MethodName: "UpdateSeason",
+			Handler:    _UsersAdminService_UpdateSeason_Handler,
+		},
+		{
+			MethodName: "ListSeasons",
+			Handler:    _UsersAdminService_ListSeasons_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _UsersAdminService_ListReports_Handler,
+		},
+		{
+			MethodName: "ClaimReport",
+			Handler:    _UsersAdminService_ClaimReport_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _UsersAdminService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "DismissReport",
+			Handler:    _UsersAdminService_DismissReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _UsersAdminService_ExportUserData_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "external/users/v1/admin.proto",
 }
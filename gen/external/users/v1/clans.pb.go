@@ -0,0 +1,1556 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: external/users/v1/clans.proto
+
+package usersv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClanRole int32
+
+const (
+	ClanRole_CLAN_ROLE_UNSPECIFIED ClanRole = 0
+	ClanRole_CLAN_ROLE_OWNER       ClanRole = 1
+	ClanRole_CLAN_ROLE_OFFICER     ClanRole = 2
+	ClanRole_CLAN_ROLE_MEMBER      ClanRole = 3
+)
+
+// Enum value maps for ClanRole.
+var (
+	ClanRole_name = map[int32]string{
+		0: "CLAN_ROLE_UNSPECIFIED",
+		1: "CLAN_ROLE_OWNER",
+		2: "CLAN_ROLE_OFFICER",
+		3: "CLAN_ROLE_MEMBER",
+	}
+	ClanRole_value = map[string]int32{
+		"CLAN_ROLE_UNSPECIFIED": 0,
+		"CLAN_ROLE_OWNER":       1,
+		"CLAN_ROLE_OFFICER":     2,
+		"CLAN_ROLE_MEMBER":      3,
+	}
+)
+
+func (x ClanRole) Enum() *ClanRole {
+	p := new(ClanRole)
+	*p = x
+	return p
+}
+
+func (x ClanRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClanRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_external_users_v1_clans_proto_enumTypes[0].Descriptor()
+}
+
+func (ClanRole) Type() protoreflect.EnumType {
+	return &file_external_users_v1_clans_proto_enumTypes[0]
+}
+
+func (x ClanRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClanRole.Descriptor instead.
+func (ClanRole) EnumDescriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{0}
+}
+
+type Clan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	MembersCount  uint64                 `protobuf:"varint,6,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Clan) Reset() {
+	*x = Clan{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Clan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clan) ProtoMessage() {}
+
+func (x *Clan) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clan.ProtoReflect.Descriptor instead.
+func (*Clan) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Clan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Clan) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Clan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Clan) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Clan) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Clan) GetMembersCount() uint64 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *Clan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ClanMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Role          ClanRole               `protobuf:"varint,2,opt,name=role,proto3,enum=usersservice.v1.ClanRole" json:"role,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClanMember) Reset() {
+	*x = ClanMember{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClanMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClanMember) ProtoMessage() {}
+
+func (x *ClanMember) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClanMember.ProtoReflect.Descriptor instead.
+func (*ClanMember) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClanMember) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ClanMember) GetRole() ClanRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClanRole_CLAN_ROLE_UNSPECIFIED
+}
+
+func (x *ClanMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type ClanJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClanJoinRequest) Reset() {
+	*x = ClanJoinRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClanJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClanJoinRequest) ProtoMessage() {}
+
+func (x *ClanJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClanJoinRequest.ProtoReflect.Descriptor instead.
+func (*ClanJoinRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClanJoinRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ClanJoinRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateClanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClanRequest) Reset() {
+	*x = CreateClanRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClanRequest) ProtoMessage() {}
+
+func (x *CreateClanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClanRequest.ProtoReflect.Descriptor instead.
+func (*CreateClanRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateClanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateClanRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *CreateClanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetClanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClanId        string                 `protobuf:"bytes,1,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClanRequest) Reset() {
+	*x = GetClanRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClanRequest) ProtoMessage() {}
+
+func (x *GetClanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClanRequest.ProtoReflect.Descriptor instead.
+func (*GetClanRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetClanRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+type GetClanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clan          *Clan                  `protobuf:"bytes,1,opt,name=clan,proto3" json:"clan,omitempty"`
+	Members       []*ClanMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClanResponse) Reset() {
+	*x = GetClanResponse{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClanResponse) ProtoMessage() {}
+
+func (x *GetClanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClanResponse.ProtoReflect.Descriptor instead.
+func (*GetClanResponse) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetClanResponse) GetClan() *Clan {
+	if x != nil {
+		return x.Clan
+	}
+	return nil
+}
+
+func (x *GetClanResponse) GetMembers() []*ClanMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type InviteToClanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	InviteeId     string                 `protobuf:"bytes,3,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToClanRequest) Reset() {
+	*x = InviteToClanRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToClanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToClanRequest) ProtoMessage() {}
+
+func (x *InviteToClanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToClanRequest.ProtoReflect.Descriptor instead.
+func (*InviteToClanRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InviteToClanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteToClanRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *InviteToClanRequest) GetInviteeId() string {
+	if x != nil {
+		return x.InviteeId
+	}
+	return ""
+}
+
+type JoinClanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinClanRequest) Reset() {
+	*x = JoinClanRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinClanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClanRequest) ProtoMessage() {}
+
+func (x *JoinClanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClanRequest.ProtoReflect.Descriptor instead.
+func (*JoinClanRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JoinClanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinClanRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+type JoinClanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// joined is false when the user was not invited and a join request was sent instead.
+	Joined        bool `protobuf:"varint,1,opt,name=joined,proto3" json:"joined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinClanResponse) Reset() {
+	*x = JoinClanResponse{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinClanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClanResponse) ProtoMessage() {}
+
+func (x *JoinClanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClanResponse.ProtoReflect.Descriptor instead.
+func (*JoinClanResponse) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JoinClanResponse) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type ListClanJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClanJoinRequestsRequest) Reset() {
+	*x = ListClanJoinRequestsRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClanJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClanJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListClanJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClanJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListClanJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListClanJoinRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListClanJoinRequestsRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+type ListClanJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*ClanJoinRequest     `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClanJoinRequestsResponse) Reset() {
+	*x = ListClanJoinRequestsResponse{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClanJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClanJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListClanJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClanJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListClanJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListClanJoinRequestsResponse) GetRequests() []*ClanJoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type AcceptClanJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptClanJoinRequestRequest) Reset() {
+	*x = AcceptClanJoinRequestRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptClanJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptClanJoinRequestRequest) ProtoMessage() {}
+
+func (x *AcceptClanJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptClanJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptClanJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptClanJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptClanJoinRequestRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *AcceptClanJoinRequestRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type DeclineClanJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineClanJoinRequestRequest) Reset() {
+	*x = DeclineClanJoinRequestRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineClanJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineClanJoinRequestRequest) ProtoMessage() {}
+
+func (x *DeclineClanJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineClanJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclineClanJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeclineClanJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeclineClanJoinRequestRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *DeclineClanJoinRequestRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type CancelClanJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelClanJoinRequestRequest) Reset() {
+	*x = CancelClanJoinRequestRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelClanJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelClanJoinRequestRequest) ProtoMessage() {}
+
+func (x *CancelClanJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelClanJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelClanJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelClanJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelClanJoinRequestRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+type DeclineClanInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineClanInviteRequest) Reset() {
+	*x = DeclineClanInviteRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineClanInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineClanInviteRequest) ProtoMessage() {}
+
+func (x *DeclineClanInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineClanInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeclineClanInviteRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeclineClanInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeclineClanInviteRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+type CancelClanInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	InviteeId     string                 `protobuf:"bytes,3,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelClanInviteRequest) Reset() {
+	*x = CancelClanInviteRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelClanInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelClanInviteRequest) ProtoMessage() {}
+
+func (x *CancelClanInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelClanInviteRequest.ProtoReflect.Descriptor instead.
+func (*CancelClanInviteRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelClanInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelClanInviteRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *CancelClanInviteRequest) GetInviteeId() string {
+	if x != nil {
+		return x.InviteeId
+	}
+	return ""
+}
+
+type LeaveClanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveClanRequest) Reset() {
+	*x = LeaveClanRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveClanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveClanRequest) ProtoMessage() {}
+
+func (x *LeaveClanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveClanRequest.ProtoReflect.Descriptor instead.
+func (*LeaveClanRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LeaveClanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeaveClanRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+type KickClanMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickClanMemberRequest) Reset() {
+	*x = KickClanMemberRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickClanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickClanMemberRequest) ProtoMessage() {}
+
+func (x *KickClanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickClanMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickClanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *KickClanMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *KickClanMemberRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *KickClanMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type SetClanMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role          ClanRole               `protobuf:"varint,4,opt,name=role,proto3,enum=usersservice.v1.ClanRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClanMemberRoleRequest) Reset() {
+	*x = SetClanMemberRoleRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClanMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClanMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetClanMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClanMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetClanMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetClanMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetClanMemberRoleRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *SetClanMemberRoleRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SetClanMemberRoleRequest) GetRole() ClanRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClanRole_CLAN_ROLE_UNSPECIFIED
+}
+
+type TransferClanOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClanId        string                 `protobuf:"bytes,2,opt,name=clan_id,json=clanId,proto3" json:"clan_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,3,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferClanOwnershipRequest) Reset() {
+	*x = TransferClanOwnershipRequest{}
+	mi := &file_external_users_v1_clans_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferClanOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferClanOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferClanOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_users_v1_clans_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferClanOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferClanOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_external_users_v1_clans_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TransferClanOwnershipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TransferClanOwnershipRequest) GetClanId() string {
+	if x != nil {
+		return x.ClanId
+	}
+	return ""
+}
+
+func (x *TransferClanOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+var File_external_users_v1_clans_proto protoreflect.FileDescriptor
+
+var file_external_users_v1_clans_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x1e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf,
+	0x01, 0x0a, 0x04, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x6e, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x77, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x04, 0x63, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x6e, 0x52, 0x04, 0x63, 0x6c, 0x61, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x6e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
+	0x66, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x10,
+	0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x4f, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x73, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x1d,
+	0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x50, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x6e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c,
+	0x61, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43,
+	0x6c, 0x61, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e,
+	0x49, 0x64, 0x22, 0x6a, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x6e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x49, 0x64, 0x22, 0x44,
+	0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c,
+	0x61, 0x6e, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x6e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x72, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77,
+	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x2a, 0x67, 0x0a, 0x08, 0x43,
+	0x6c, 0x61, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x41, 0x4e, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
+	0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4c, 0x41, 0x4e, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x49, 0x43, 0x45, 0x52, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42,
+	0x45, 0x52, 0x10, 0x03, 0x32, 0xe0, 0x09, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6c,
+	0x61, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x61, 0x6e, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x1f,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x61,
+	0x6e, 0x12, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x4f, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x6e, 0x12, 0x20, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x73, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x61,
+	0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43,
+	0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x6e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x54, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x6e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x6e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c,
+	0x61, 0x6e, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a,
+	0x0e, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x56, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x2d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x6e, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x12, 0x5a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+})
+
+var (
+	file_external_users_v1_clans_proto_rawDescOnce sync.Once
+	file_external_users_v1_clans_proto_rawDescData []byte
+)
+
+func file_external_users_v1_clans_proto_rawDescGZIP() []byte {
+	file_external_users_v1_clans_proto_rawDescOnce.Do(func() {
+		file_external_users_v1_clans_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_external_users_v1_clans_proto_rawDesc), len(file_external_users_v1_clans_proto_rawDesc)))
+	})
+	return file_external_users_v1_clans_proto_rawDescData
+}
+
+var file_external_users_v1_clans_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_external_users_v1_clans_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_external_users_v1_clans_proto_goTypes = []any{
+	(ClanRole)(0),                         // 0: usersservice.v1.ClanRole
+	(*Clan)(nil),                          // 1: usersservice.v1.Clan
+	(*ClanMember)(nil),                    // 2: usersservice.v1.ClanMember
+	(*ClanJoinRequest)(nil),               // 3: usersservice.v1.ClanJoinRequest
+	(*CreateClanRequest)(nil),             // 4: usersservice.v1.CreateClanRequest
+	(*GetClanRequest)(nil),                // 5: usersservice.v1.GetClanRequest
+	(*GetClanResponse)(nil),               // 6: usersservice.v1.GetClanResponse
+	(*InviteToClanRequest)(nil),           // 7: usersservice.v1.InviteToClanRequest
+	(*JoinClanRequest)(nil),               // 8: usersservice.v1.JoinClanRequest
+	(*JoinClanResponse)(nil),              // 9: usersservice.v1.JoinClanResponse
+	(*ListClanJoinRequestsRequest)(nil),   // 10: usersservice.v1.ListClanJoinRequestsRequest
+	(*ListClanJoinRequestsResponse)(nil),  // 11: usersservice.v1.ListClanJoinRequestsResponse
+	(*AcceptClanJoinRequestRequest)(nil),  // 12: usersservice.v1.AcceptClanJoinRequestRequest
+	(*DeclineClanJoinRequestRequest)(nil), // 13: usersservice.v1.DeclineClanJoinRequestRequest
+	(*CancelClanJoinRequestRequest)(nil),  // 14: usersservice.v1.CancelClanJoinRequestRequest
+	(*DeclineClanInviteRequest)(nil),      // 15: usersservice.v1.DeclineClanInviteRequest
+	(*CancelClanInviteRequest)(nil),       // 16: usersservice.v1.CancelClanInviteRequest
+	(*LeaveClanRequest)(nil),              // 17: usersservice.v1.LeaveClanRequest
+	(*KickClanMemberRequest)(nil),         // 18: usersservice.v1.KickClanMemberRequest
+	(*SetClanMemberRoleRequest)(nil),      // 19: usersservice.v1.SetClanMemberRoleRequest
+	(*TransferClanOwnershipRequest)(nil),  // 20: usersservice.v1.TransferClanOwnershipRequest
+	(*timestamppb.Timestamp)(nil),         // 21: google.protobuf.Timestamp
+	(*User)(nil),                          // 22: usersservice.v1.User
+	(*emptypb.Empty)(nil),                 // 23: google.protobuf.Empty
+}
+var file_external_users_v1_clans_proto_depIdxs = []int32{
+	21, // 0: usersservice.v1.Clan.created_at:type_name -> google.protobuf.Timestamp
+	22, // 1: usersservice.v1.ClanMember.user:type_name -> usersservice.v1.User
+	0,  // 2: usersservice.v1.ClanMember.role:type_name -> usersservice.v1.ClanRole
+	21, // 3: usersservice.v1.ClanMember.joined_at:type_name -> google.protobuf.Timestamp
+	22, // 4: usersservice.v1.ClanJoinRequest.user:type_name -> usersservice.v1.User
+	21, // 5: usersservice.v1.ClanJoinRequest.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: usersservice.v1.GetClanResponse.clan:type_name -> usersservice.v1.Clan
+	2,  // 7: usersservice.v1.GetClanResponse.members:type_name -> usersservice.v1.ClanMember
+	3,  // 8: usersservice.v1.ListClanJoinRequestsResponse.requests:type_name -> usersservice.v1.ClanJoinRequest
+	0,  // 9: usersservice.v1.SetClanMemberRoleRequest.role:type_name -> usersservice.v1.ClanRole
+	4,  // 10: usersservice.v1.UsersClansService.CreateClan:input_type -> usersservice.v1.CreateClanRequest
+	5,  // 11: usersservice.v1.UsersClansService.GetClan:input_type -> usersservice.v1.GetClanRequest
+	7,  // 12: usersservice.v1.UsersClansService.InviteToClan:input_type -> usersservice.v1.InviteToClanRequest
+	8,  // 13: usersservice.v1.UsersClansService.JoinClan:input_type -> usersservice.v1.JoinClanRequest
+	10, // 14: usersservice.v1.UsersClansService.ListClanJoinRequests:input_type -> usersservice.v1.ListClanJoinRequestsRequest
+	12, // 15: usersservice.v1.UsersClansService.AcceptClanJoinRequest:input_type -> usersservice.v1.AcceptClanJoinRequestRequest
+	13, // 16: usersservice.v1.UsersClansService.DeclineClanJoinRequest:input_type -> usersservice.v1.DeclineClanJoinRequestRequest
+	14, // 17: usersservice.v1.UsersClansService.CancelClanJoinRequest:input_type -> usersservice.v1.CancelClanJoinRequestRequest
+	15, // 18: usersservice.v1.UsersClansService.DeclineClanInvite:input_type -> usersservice.v1.DeclineClanInviteRequest
+	16, // 19: usersservice.v1.UsersClansService.CancelClanInvite:input_type -> usersservice.v1.CancelClanInviteRequest
+	17, // 20: usersservice.v1.UsersClansService.LeaveClan:input_type -> usersservice.v1.LeaveClanRequest
+	18, // 21: usersservice.v1.UsersClansService.KickClanMember:input_type -> usersservice.v1.KickClanMemberRequest
+	19, // 22: usersservice.v1.UsersClansService.SetClanMemberRole:input_type -> usersservice.v1.SetClanMemberRoleRequest
+	20, // 23: usersservice.v1.UsersClansService.TransferClanOwnership:input_type -> usersservice.v1.TransferClanOwnershipRequest
+	1,  // 24: usersservice.v1.UsersClansService.CreateClan:output_type -> usersservice.v1.Clan
+	6,  // 25: usersservice.v1.UsersClansService.GetClan:output_type -> usersservice.v1.GetClanResponse
+	23, // 26: usersservice.v1.UsersClansService.InviteToClan:output_type -> google.protobuf.Empty
+	9,  // 27: usersservice.v1.UsersClansService.JoinClan:output_type -> usersservice.v1.JoinClanResponse
+	11, // 28: usersservice.v1.UsersClansService.ListClanJoinRequests:output_type -> usersservice.v1.ListClanJoinRequestsResponse
+	23, // 29: usersservice.v1.UsersClansService.AcceptClanJoinRequest:output_type -> google.protobuf.Empty
+	23, // 30: usersservice.v1.UsersClansService.DeclineClanJoinRequest:output_type -> google.protobuf.Empty
+	23, // 31: usersservice.v1.UsersClansService.CancelClanJoinRequest:output_type -> google.protobuf.Empty
+	23, // 32: usersservice.v1.UsersClansService.DeclineClanInvite:output_type -> google.protobuf.Empty
+	23, // 33: usersservice.v1.UsersClansService.CancelClanInvite:output_type -> google.protobuf.Empty
+	23, // 34: usersservice.v1.UsersClansService.LeaveClan:output_type -> google.protobuf.Empty
+	23, // 35: usersservice.v1.UsersClansService.KickClanMember:output_type -> google.protobuf.Empty
+	23, // 36: usersservice.v1.UsersClansService.SetClanMemberRole:output_type -> google.protobuf.Empty
+	23, // 37: usersservice.v1.UsersClansService.TransferClanOwnership:output_type -> google.protobuf.Empty
+	24, // [24:38] is the sub-list for method output_type
+	10, // [10:24] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_external_users_v1_clans_proto_init() }
+func file_external_users_v1_clans_proto_init() {
+	if File_external_users_v1_clans_proto != nil {
+		return
+	}
+	file_external_users_v1_shared_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_external_users_v1_clans_proto_rawDesc), len(file_external_users_v1_clans_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_external_users_v1_clans_proto_goTypes,
+		DependencyIndexes: file_external_users_v1_clans_proto_depIdxs,
+		EnumInfos:         file_external_users_v1_clans_proto_enumTypes,
+		MessageInfos:      file_external_users_v1_clans_proto_msgTypes,
+	}.Build()
+	File_external_users_v1_clans_proto = out.File
+	file_external_users_v1_clans_proto_goTypes = nil
+	file_external_users_v1_clans_proto_depIdxs = nil
+}
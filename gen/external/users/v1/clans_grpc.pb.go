@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: external/users/v1/clans.proto
+
+package usersv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UsersClansService_CreateClan_FullMethodName             = "/usersservice.v1.UsersClansService/CreateClan"
+	UsersClansService_GetClan_FullMethodName                = "/usersservice.v1.UsersClansService/GetClan"
+	UsersClansService_InviteToClan_FullMethodName           = "/usersservice.v1.UsersClansService/InviteToClan"
+	UsersClansService_JoinClan_FullMethodName               = "/usersservice.v1.UsersClansService/JoinClan"
+	UsersClansService_ListClanJoinRequests_FullMethodName   = "/usersservice.v1.UsersClansService/ListClanJoinRequests"
+	UsersClansService_AcceptClanJoinRequest_FullMethodName  = "/usersservice.v1.UsersClansService/AcceptClanJoinRequest"
+	UsersClansService_DeclineClanJoinRequest_FullMethodName = "/usersservice.v1.UsersClansService/DeclineClanJoinRequest"
+	UsersClansService_CancelClanJoinRequest_FullMethodName  = "/usersservice.v1.UsersClansService/CancelClanJoinRequest"
+	UsersClansService_DeclineClanInvite_FullMethodName      = "/usersservice.v1.UsersClansService/DeclineClanInvite"
+	UsersClansService_CancelClanInvite_FullMethodName       = "/usersservice.v1.UsersClansService/CancelClanInvite"
+	UsersClansService_LeaveClan_FullMethodName              = "/usersservice.v1.UsersClansService/LeaveClan"
+	UsersClansService_KickClanMember_FullMethodName         = "/usersservice.v1.UsersClansService/KickClanMember"
+	UsersClansService_SetClanMemberRole_FullMethodName      = "/usersservice.v1.UsersClansService/SetClanMemberRole"
+	UsersClansService_TransferClanOwnership_FullMethodName  = "/usersservice.v1.UsersClansService/TransferClanOwnership"
+)
+
+// UsersClansServiceClient is the client API for UsersClansService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UsersClansServiceClient interface {
+	CreateClan(ctx context.Context, in *CreateClanRequest, opts ...grpc.CallOption) (*Clan, error)
+	GetClan(ctx context.Context, in *GetClanRequest, opts ...grpc.CallOption) (*GetClanResponse, error)
+	InviteToClan(ctx context.Context, in *InviteToClanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	JoinClan(ctx context.Context, in *JoinClanRequest, opts ...grpc.CallOption) (*JoinClanResponse, error)
+	ListClanJoinRequests(ctx context.Context, in *ListClanJoinRequestsRequest, opts ...grpc.CallOption) (*ListClanJoinRequestsResponse, error)
+	AcceptClanJoinRequest(ctx context.Context, in *AcceptClanJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeclineClanJoinRequest(ctx context.Context, in *DeclineClanJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelClanJoinRequest(ctx context.Context, in *CancelClanJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeclineClanInvite(ctx context.Context, in *DeclineClanInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelClanInvite(ctx context.Context, in *CancelClanInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaveClan(ctx context.Context, in *LeaveClanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	KickClanMember(ctx context.Context, in *KickClanMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetClanMemberRole(ctx context.Context, in *SetClanMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransferClanOwnership(ctx context.Context, in *TransferClanOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type usersClansServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUsersClansServiceClient(cc grpc.ClientConnInterface) UsersClansServiceClient {
+	return &usersClansServiceClient{cc}
+}
+
+func (c *usersClansServiceClient) CreateClan(ctx context.Context, in *CreateClanRequest, opts ...grpc.CallOption) (*Clan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Clan)
+	err := c.cc.Invoke(ctx, UsersClansService_CreateClan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) GetClan(ctx context.Context, in *GetClanRequest, opts ...grpc.CallOption) (*GetClanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClanResponse)
+	err := c.cc.Invoke(ctx, UsersClansService_GetClan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) InviteToClan(ctx context.Context, in *InviteToClanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_InviteToClan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) JoinClan(ctx context.Context, in *JoinClanRequest, opts ...grpc.CallOption) (*JoinClanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinClanResponse)
+	err := c.cc.Invoke(ctx, UsersClansService_JoinClan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) ListClanJoinRequests(ctx context.Context, in *ListClanJoinRequestsRequest, opts ...grpc.CallOption) (*ListClanJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClanJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, UsersClansService_ListClanJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) AcceptClanJoinRequest(ctx context.Context, in *AcceptClanJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_AcceptClanJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) DeclineClanJoinRequest(ctx context.Context, in *DeclineClanJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_DeclineClanJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) CancelClanJoinRequest(ctx context.Context, in *CancelClanJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_CancelClanJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) DeclineClanInvite(ctx context.Context, in *DeclineClanInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_DeclineClanInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) CancelClanInvite(ctx context.Context, in *CancelClanInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_CancelClanInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) LeaveClan(ctx context.Context, in *LeaveClanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_LeaveClan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) KickClanMember(ctx context.Context, in *KickClanMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_KickClanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) SetClanMemberRole(ctx context.Context, in *SetClanMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_SetClanMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClansServiceClient) TransferClanOwnership(ctx context.Context, in *TransferClanOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersClansService_TransferClanOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersClansServiceServer is the server API for UsersClansService service.
+// All implementations should embed UnimplementedUsersClansServiceServer
+// for forward compatibility.
+type UsersClansServiceServer interface {
+	CreateClan(context.Context, *CreateClanRequest) (*Clan, error)
+	GetClan(context.Context, *GetClanRequest) (*GetClanResponse, error)
+	InviteToClan(context.Context, *InviteToClanRequest) (*emptypb.Empty, error)
+	JoinClan(context.Context, *JoinClanRequest) (*JoinClanResponse, error)
+	ListClanJoinRequests(context.Context, *ListClanJoinRequestsRequest) (*ListClanJoinRequestsResponse, error)
+	AcceptClanJoinRequest(context.Context, *AcceptClanJoinRequestRequest) (*emptypb.Empty, error)
+	DeclineClanJoinRequest(context.Context, *DeclineClanJoinRequestRequest) (*emptypb.Empty, error)
+	CancelClanJoinRequest(context.Context, *CancelClanJoinRequestRequest) (*emptypb.Empty, error)
+	DeclineClanInvite(context.Context, *DeclineClanInviteRequest) (*emptypb.Empty, error)
+	CancelClanInvite(context.Context, *CancelClanInviteRequest) (*emptypb.Empty, error)
+	LeaveClan(context.Context, *LeaveClanRequest) (*emptypb.Empty, error)
+	KickClanMember(context.Context, *KickClanMemberRequest) (*emptypb.Empty, error)
+	SetClanMemberRole(context.Context, *SetClanMemberRoleRequest) (*emptypb.Empty, error)
+	TransferClanOwnership(context.Context, *TransferClanOwnershipRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedUsersClansServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUsersClansServiceServer struct{}
+
+func (UnimplementedUsersClansServiceServer) CreateClan(context.Context, *CreateClanRequest) (*Clan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClan not implemented")
+}
+func (UnimplementedUsersClansServiceServer) GetClan(context.Context, *GetClanRequest) (*GetClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClan not implemented")
+}
+func (UnimplementedUsersClansServiceServer) InviteToClan(context.Context, *InviteToClanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToClan not implemented")
+}
+func (UnimplementedUsersClansServiceServer) JoinClan(context.Context, *JoinClanRequest) (*JoinClanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinClan not implemented")
+}
+func (UnimplementedUsersClansServiceServer) ListClanJoinRequests(context.Context, *ListClanJoinRequestsRequest) (*ListClanJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClanJoinRequests not implemented")
+}
+func (UnimplementedUsersClansServiceServer) AcceptClanJoinRequest(context.Context, *AcceptClanJoinRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptClanJoinRequest not implemented")
+}
+func (UnimplementedUsersClansServiceServer) DeclineClanJoinRequest(context.Context, *DeclineClanJoinRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineClanJoinRequest not implemented")
+}
+func (UnimplementedUsersClansServiceServer) CancelClanJoinRequest(context.Context, *CancelClanJoinRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelClanJoinRequest not implemented")
+}
+func (UnimplementedUsersClansServiceServer) DeclineClanInvite(context.Context, *DeclineClanInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineClanInvite not implemented")
+}
+func (UnimplementedUsersClansServiceServer) CancelClanInvite(context.Context, *CancelClanInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelClanInvite not implemented")
+}
+func (UnimplementedUsersClansServiceServer) LeaveClan(context.Context, *LeaveClanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveClan not implemented")
+}
+func (UnimplementedUsersClansServiceServer) KickClanMember(context.Context, *KickClanMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickClanMember not implemented")
+}
+func (UnimplementedUsersClansServiceServer) SetClanMemberRole(context.Context, *SetClanMemberRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClanMemberRole not implemented")
+}
+func (UnimplementedUsersClansServiceServer) TransferClanOwnership(context.Context, *TransferClanOwnershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferClanOwnership not implemented")
+}
+func (UnimplementedUsersClansServiceServer) testEmbeddedByValue() {}
+
+// UnsafeUsersClansServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UsersClansServiceServer will
+// result in compilation errors.
+type UnsafeUsersClansServiceServer interface {
+	mustEmbedUnimplementedUsersClansServiceServer()
+}
+
+func RegisterUsersClansServiceServer(s grpc.ServiceRegistrar, srv UsersClansServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUsersClansServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UsersClansService_ServiceDesc, srv)
+}
+
+func _UsersClansService_CreateClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).CreateClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_CreateClan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).CreateClan(ctx, req.(*CreateClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_GetClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).GetClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_GetClan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).GetClan(ctx, req.(*GetClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_InviteToClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).InviteToClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_InviteToClan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).InviteToClan(ctx, req.(*InviteToClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_JoinClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).JoinClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_JoinClan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).JoinClan(ctx, req.(*JoinClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_ListClanJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClanJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).ListClanJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_ListClanJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).ListClanJoinRequests(ctx, req.(*ListClanJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_AcceptClanJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptClanJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).AcceptClanJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_AcceptClanJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).AcceptClanJoinRequest(ctx, req.(*AcceptClanJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_DeclineClanJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineClanJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).DeclineClanJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_DeclineClanJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).DeclineClanJoinRequest(ctx, req.(*DeclineClanJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_CancelClanJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelClanJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).CancelClanJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_CancelClanJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).CancelClanJoinRequest(ctx, req.(*CancelClanJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_DeclineClanInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineClanInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).DeclineClanInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_DeclineClanInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).DeclineClanInvite(ctx, req.(*DeclineClanInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_CancelClanInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelClanInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).CancelClanInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_CancelClanInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).CancelClanInvite(ctx, req.(*CancelClanInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_LeaveClan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveClanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).LeaveClan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_LeaveClan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).LeaveClan(ctx, req.(*LeaveClanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_KickClanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickClanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).KickClanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_KickClanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).KickClanMember(ctx, req.(*KickClanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_SetClanMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClanMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).SetClanMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_SetClanMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).SetClanMemberRole(ctx, req.(*SetClanMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersClansService_TransferClanOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferClanOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersClansServiceServer).TransferClanOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersClansService_TransferClanOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersClansServiceServer).TransferClanOwnership(ctx, req.(*TransferClanOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UsersClansService_ServiceDesc is the grpc.ServiceDesc for UsersClansService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UsersClansService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "usersservice.v1.UsersClansService",
+	HandlerType: (*UsersClansServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClan",
+			Handler:    _UsersClansService_CreateClan_Handler,
+		},
+		{
+			MethodName: "GetClan",
+			Handler:    _UsersClansService_GetClan_Handler,
+		},
+		{
+			MethodName: "InviteToClan",
+			Handler:    _UsersClansService_InviteToClan_Handler,
+		},
+		{
+			MethodName: "JoinClan",
+			Handler:    _UsersClansService_JoinClan_Handler,
+		},
+		{
+			MethodName: "ListClanJoinRequests",
+			Handler:    _UsersClansService_ListClanJoinRequests_Handler,
+		},
+		{
+			MethodName: "AcceptClanJoinRequest",
+			Handler:    _UsersClansService_AcceptClanJoinRequest_Handler,
+		},
+		{
+			MethodName: "DeclineClanJoinRequest",
+			Handler:    _UsersClansService_DeclineClanJoinRequest_Handler,
+		},
+		{
+			MethodName: "CancelClanJoinRequest",
+			Handler:    _UsersClansService_CancelClanJoinRequest_Handler,
+		},
+		{
+			MethodName: "DeclineClanInvite",
+			Handler:    _UsersClansService_DeclineClanInvite_Handler,
+		},
+		{
+			MethodName: "CancelClanInvite",
+			Handler:    _UsersClansService_CancelClanInvite_Handler,
+		},
+		{
+			MethodName: "LeaveClan",
+			Handler:    _UsersClansService_LeaveClan_Handler,
+		},
+		{
+			MethodName: "KickClanMember",
+			Handler:    _UsersClansService_KickClanMember_Handler,
+		},
+		{
+			MethodName: "SetClanMemberRole",
+			Handler:    _UsersClansService_SetClanMemberRole_Handler,
+		},
+		{
+			MethodName: "TransferClanOwnership",
+			Handler:    _UsersClansService_TransferClanOwnership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "external/users/v1/clans.proto",
+}
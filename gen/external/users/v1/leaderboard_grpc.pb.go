@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: external/users/v1/leaderboard.proto
+
+package usersv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UsersLeaderboardService_GetGlobalLeaderboard_FullMethodName   = "/usersservice.v1.UsersLeaderboardService/GetGlobalLeaderboard"
+	UsersLeaderboardService_GetCountryLeaderboard_FullMethodName  = "/usersservice.v1.UsersLeaderboardService/GetCountryLeaderboard"
+	UsersLeaderboardService_GetLeaderboardAroundMe_FullMethodName = "/usersservice.v1.UsersLeaderboardService/GetLeaderboardAroundMe"
+	UsersLeaderboardService_GetFriendsLeaderboard_FullMethodName  = "/usersservice.v1.UsersLeaderboardService/GetFriendsLeaderboard"
+)
+
+// UsersLeaderboardServiceClient is the client API for UsersLeaderboardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UsersLeaderboardServiceClient interface {
+	GetGlobalLeaderboard(ctx context.Context, in *GetGlobalLeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	GetCountryLeaderboard(ctx context.Context, in *GetCountryLeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	GetLeaderboardAroundMe(ctx context.Context, in *GetLeaderboardAroundMeRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	GetFriendsLeaderboard(ctx context.Context, in *GetFriendsLeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+}
+
+type usersLeaderboardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUsersLeaderboardServiceClient(cc grpc.ClientConnInterface) UsersLeaderboardServiceClient {
+	return &usersLeaderboardServiceClient{cc}
+}
+
+func (c *usersLeaderboardServiceClient) GetGlobalLeaderboard(ctx context.Context, in *GetGlobalLeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, UsersLeaderboardService_GetGlobalLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersLeaderboardServiceClient) GetCountryLeaderboard(ctx context.Context, in *GetCountryLeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, UsersLeaderboardService_GetCountryLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersLeaderboardServiceClient) GetLeaderboardAroundMe(ctx context.Context, in *GetLeaderboardAroundMeRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, UsersLeaderboardService_GetLeaderboardAroundMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersLeaderboardServiceClient) GetFriendsLeaderboard(ctx context.Context, in *GetFriendsLeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, UsersLeaderboardService_GetFriendsLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersLeaderboardServiceServer is the server API for UsersLeaderboardService service.
+// All implementations should embed UnimplementedUsersLeaderboardServiceServer
+// for forward compatibility.
+type UsersLeaderboardServiceServer interface {
+	GetGlobalLeaderboard(context.Context, *GetGlobalLeaderboardRequest) (*Leaderboard, error)
+	GetCountryLeaderboard(context.Context, *GetCountryLeaderboardRequest) (*Leaderboard, error)
+	GetLeaderboardAroundMe(context.Context, *GetLeaderboardAroundMeRequest) (*Leaderboard, error)
+	GetFriendsLeaderboard(context.Context, *GetFriendsLeaderboardRequest) (*Leaderboard, error)
+}
+
+// UnimplementedUsersLeaderboardServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUsersLeaderboardServiceServer struct{}
+
+func (UnimplementedUsersLeaderboardServiceServer) GetGlobalLeaderboard(context.Context, *GetGlobalLeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGlobalLeaderboard not implemented")
+}
+func (UnimplementedUsersLeaderboardServiceServer) GetCountryLeaderboard(context.Context, *GetCountryLeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCountryLeaderboard not implemented")
+}
+func (UnimplementedUsersLeaderboardServiceServer) GetLeaderboardAroundMe(context.Context, *GetLeaderboardAroundMeRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboardAroundMe not implemented")
+}
+func (UnimplementedUsersLeaderboardServiceServer) GetFriendsLeaderboard(context.Context, *GetFriendsLeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendsLeaderboard not implemented")
+}
+func (UnimplementedUsersLeaderboardServiceServer) testEmbeddedByValue() {}
+
+// UnsafeUsersLeaderboardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UsersLeaderboardServiceServer will
+// result in compilation errors.
+type UnsafeUsersLeaderboardServiceServer interface {
+	mustEmbedUnimplementedUsersLeaderboardServiceServer()
+}
+
+func RegisterUsersLeaderboardServiceServer(s grpc.ServiceRegistrar, srv UsersLeaderboardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUsersLeaderboardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UsersLeaderboardService_ServiceDesc, srv)
+}
+
+func _UsersLeaderboardService_GetGlobalLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGlobalLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersLeaderboardServiceServer).GetGlobalLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersLeaderboardService_GetGlobalLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersLeaderboardServiceServer).GetGlobalLeaderboard(ctx, req.(*GetGlobalLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersLeaderboardService_GetCountryLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCountryLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersLeaderboardServiceServer).GetCountryLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersLeaderboardService_GetCountryLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersLeaderboardServiceServer).GetCountryLeaderboard(ctx, req.(*GetCountryLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersLeaderboardService_GetLeaderboardAroundMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardAroundMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersLeaderboardServiceServer).GetLeaderboardAroundMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersLeaderboardService_GetLeaderboardAroundMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersLeaderboardServiceServer).GetLeaderboardAroundMe(ctx, req.(*GetLeaderboardAroundMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersLeaderboardService_GetFriendsLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersLeaderboardServiceServer).GetFriendsLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersLeaderboardService_GetFriendsLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersLeaderboardServiceServer).GetFriendsLeaderboard(ctx, req.(*GetFriendsLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UsersLeaderboardService_ServiceDesc is the grpc.ServiceDesc for UsersLeaderboardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UsersLeaderboardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "usersservice.v1.UsersLeaderboardService",
+	HandlerType: (*UsersLeaderboardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGlobalLeaderboard",
+			Handler:    _UsersLeaderboardService_GetGlobalLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetCountryLeaderboard",
+			Handler:    _UsersLeaderboardService_GetCountryLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetLeaderboardAroundMe",
+			Handler:    _UsersLeaderboardService_GetLeaderboardAroundMe_Handler,
+		},
+		{
+			MethodName: "GetFriendsLeaderboard",
+			Handler:    _UsersLeaderboardService_GetFriendsLeaderboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "external/users/v1/leaderboard.proto",
+}
@@ -20,11 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UsersProfileService_GetProfile_FullMethodName     = "/usersservice.v1.UsersProfileService/GetProfile"
-	UsersProfileService_UpdateProfile_FullMethodName  = "/usersservice.v1.UsersProfileService/UpdateProfile"
-	UsersProfileService_UpdateAvatar_FullMethodName   = "/usersservice.v1.UsersProfileService/UpdateAvatar"
-	UsersProfileService_ChangePassword_FullMethodName = "/usersservice.v1.UsersProfileService/ChangePassword"
-	UsersProfileService_DeleteAccount_FullMethodName  = "/usersservice.v1.UsersProfileService/DeleteAccount"
+	UsersProfileService_GetProfile_FullMethodName           = "/usersservice.v1.UsersProfileService/GetProfile"
+	UsersProfileService_GetUsersByIDs_FullMethodName        = "/usersservice.v1.UsersProfileService/GetUsersByIDs"
+	UsersProfileService_UpdateProfile_FullMethodName        = "/usersservice.v1.UsersProfileService/UpdateProfile"
+	UsersProfileService_UpdateAvatar_FullMethodName         = "/usersservice.v1.UsersProfileService/UpdateAvatar"
+	UsersProfileService_ChangePassword_FullMethodName       = "/usersservice.v1.UsersProfileService/ChangePassword"
+	UsersProfileService_DeleteAccount_FullMethodName        = "/usersservice.v1.UsersProfileService/DeleteAccount"
+	UsersProfileService_ExportMyData_FullMethodName         = "/usersservice.v1.UsersProfileService/ExportMyData"
+	UsersProfileService_CreditCoins_FullMethodName          = "/usersservice.v1.UsersProfileService/CreditCoins"
+	UsersProfileService_DebitCoins_FullMethodName           = "/usersservice.v1.UsersProfileService/DebitCoins"
+	UsersProfileService_ListCoinTransactions_FullMethodName = "/usersservice.v1.UsersProfileService/ListCoinTransactions"
+	UsersProfileService_ReportMatchResult_FullMethodName    = "/usersservice.v1.UsersProfileService/ReportMatchResult"
+	UsersProfileService_GetSeasonHistory_FullMethodName     = "/usersservice.v1.UsersProfileService/GetSeasonHistory"
+	UsersProfileService_ListAchievements_FullMethodName     = "/usersservice.v1.UsersProfileService/ListAchievements"
+	UsersProfileService_GrantXP_FullMethodName              = "/usersservice.v1.UsersProfileService/GrantXP"
+	UsersProfileService_ClaimDailyReward_FullMethodName     = "/usersservice.v1.UsersProfileService/ClaimDailyReward"
+	UsersProfileService_ReportAnswerResults_FullMethodName  = "/usersservice.v1.UsersProfileService/ReportAnswerResults"
+	UsersProfileService_GetCategoryStats_FullMethodName     = "/usersservice.v1.UsersProfileService/GetCategoryStats"
+	UsersProfileService_RecordMatch_FullMethodName          = "/usersservice.v1.UsersProfileService/RecordMatch"
+	UsersProfileService_ListMatchHistory_FullMethodName     = "/usersservice.v1.UsersProfileService/ListMatchHistory"
+	UsersProfileService_ListShopItems_FullMethodName        = "/usersservice.v1.UsersProfileService/ListShopItems"
+	UsersProfileService_PurchaseItem_FullMethodName         = "/usersservice.v1.UsersProfileService/PurchaseItem"
+	UsersProfileService_ListInventory_FullMethodName        = "/usersservice.v1.UsersProfileService/ListInventory"
 )
 
 // UsersProfileServiceClient is the client API for UsersProfileService service.
@@ -32,10 +49,27 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UsersProfileServiceClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*GetUsersByIDsResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateAvatar(ctx context.Context, in *UpdateAvatarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDataChunk], error)
+	CreditCoins(ctx context.Context, in *CreditCoinsRequest, opts ...grpc.CallOption) (*CoinTransaction, error)
+	DebitCoins(ctx context.Context, in *DebitCoinsRequest, opts ...grpc.CallOption) (*CoinTransaction, error)
+	ListCoinTransactions(ctx context.Context, in *ListCoinTransactionsRequest, opts ...grpc.CallOption) (*ListCoinTransactionsResponse, error)
+	ReportMatchResult(ctx context.Context, in *ReportMatchResultRequest, opts ...grpc.CallOption) (*ReportMatchResultResponse, error)
+	GetSeasonHistory(ctx context.Context, in *GetSeasonHistoryRequest, opts ...grpc.CallOption) (*GetSeasonHistoryResponse, error)
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+	GrantXP(ctx context.Context, in *GrantXPRequest, opts ...grpc.CallOption) (*XPGrant, error)
+	ClaimDailyReward(ctx context.Context, in *ClaimDailyRewardRequest, opts ...grpc.CallOption) (*DailyReward, error)
+	ReportAnswerResults(ctx context.Context, in *ReportAnswerResultsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCategoryStats(ctx context.Context, in *GetCategoryStatsRequest, opts ...grpc.CallOption) (*GetCategoryStatsResponse, error)
+	RecordMatch(ctx context.Context, in *RecordMatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error)
+	ListShopItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListShopItemsResponse, error)
+	PurchaseItem(ctx context.Context, in *PurchaseItemRequest, opts ...grpc.CallOption) (*Purchase, error)
+	ListInventory(ctx context.Context, in *ListInventoryRequest, opts ...grpc.CallOption) (*ListInventoryResponse, error)
 }
 
 type usersProfileServiceClient struct {
@@ -56,6 +90,16 @@ func (c *usersProfileServiceClient) GetProfile(ctx context.Context, in *GetProfi
 	return out, nil
 }
 
+func (c *usersProfileServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*GetUsersByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIDsResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_GetUsersByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersProfileServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -96,15 +140,201 @@ func (c *usersProfileServiceClient) DeleteAccount(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *usersProfileServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDataChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UsersProfileService_ServiceDesc.Streams[0], UsersProfileService_ExportMyData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMyDataRequest, ExportDataChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersProfileService_ExportMyDataClient = grpc.ServerStreamingClient[ExportDataChunk]
+
+func (c *usersProfileServiceClient) CreditCoins(ctx context.Context, in *CreditCoinsRequest, opts ...grpc.CallOption) (*CoinTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoinTransaction)
+	err := c.cc.Invoke(ctx, UsersProfileService_CreditCoins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) DebitCoins(ctx context.Context, in *DebitCoinsRequest, opts ...grpc.CallOption) (*CoinTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoinTransaction)
+	err := c.cc.Invoke(ctx, UsersProfileService_DebitCoins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ListCoinTransactions(ctx context.Context, in *ListCoinTransactionsRequest, opts ...grpc.CallOption) (*ListCoinTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoinTransactionsResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_ListCoinTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ReportMatchResult(ctx context.Context, in *ReportMatchResultRequest, opts ...grpc.CallOption) (*ReportMatchResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportMatchResultResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_ReportMatchResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) GetSeasonHistory(ctx context.Context, in *GetSeasonHistoryRequest, opts ...grpc.CallOption) (*GetSeasonHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeasonHistoryResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_GetSeasonHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_ListAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) GrantXP(ctx context.Context, in *GrantXPRequest, opts ...grpc.CallOption) (*XPGrant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(XPGrant)
+	err := c.cc.Invoke(ctx, UsersProfileService_GrantXP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ClaimDailyReward(ctx context.Context, in *ClaimDailyRewardRequest, opts ...grpc.CallOption) (*DailyReward, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyReward)
+	err := c.cc.Invoke(ctx, UsersProfileService_ClaimDailyReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ReportAnswerResults(ctx context.Context, in *ReportAnswerResultsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersProfileService_ReportAnswerResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) GetCategoryStats(ctx context.Context, in *GetCategoryStatsRequest, opts ...grpc.CallOption) (*GetCategoryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryStatsResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_GetCategoryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) RecordMatch(ctx context.Context, in *RecordMatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersProfileService_RecordMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_ListMatchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ListShopItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListShopItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShopItemsResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_ListShopItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) PurchaseItem(ctx context.Context, in *PurchaseItemRequest, opts ...grpc.CallOption) (*Purchase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Purchase)
+	err := c.cc.Invoke(ctx, UsersProfileService_PurchaseItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersProfileServiceClient) ListInventory(ctx context.Context, in *ListInventoryRequest, opts ...grpc.CallOption) (*ListInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryResponse)
+	err := c.cc.Invoke(ctx, UsersProfileService_ListInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersProfileServiceServer is the server API for UsersProfileService service.
 // All implementations should embed UnimplementedUsersProfileServiceServer
 // for forward compatibility.
 type UsersProfileServiceServer interface {
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*GetUsersByIDsResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error)
 	UpdateAvatar(context.Context, *UpdateAvatarRequest) (*emptypb.Empty, error)
 	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
+	ExportMyData(*ExportMyDataRequest, grpc.ServerStreamingServer[ExportDataChunk]) error
+	CreditCoins(context.Context, *CreditCoinsRequest) (*CoinTransaction, error)
+	DebitCoins(context.Context, *DebitCoinsRequest) (*CoinTransaction, error)
+	ListCoinTransactions(context.Context, *ListCoinTransactionsRequest) (*ListCoinTransactionsResponse, error)
+	ReportMatchResult(context.Context, *ReportMatchResultRequest) (*ReportMatchResultResponse, error)
+	GetSeasonHistory(context.Context, *GetSeasonHistoryRequest) (*GetSeasonHistoryResponse, error)
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+	GrantXP(context.Context, *GrantXPRequest) (*XPGrant, error)
+	ClaimDailyReward(context.Context, *ClaimDailyRewardRequest) (*DailyReward, error)
+	ReportAnswerResults(context.Context, *ReportAnswerResultsRequest) (*emptypb.Empty, error)
+	GetCategoryStats(context.Context, *GetCategoryStatsRequest) (*GetCategoryStatsResponse, error)
+	RecordMatch(context.Context, *RecordMatchRequest) (*emptypb.Empty, error)
+	ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error)
+	ListShopItems(context.Context, *emptypb.Empty) (*ListShopItemsResponse, error)
+	PurchaseItem(context.Context, *PurchaseItemRequest) (*Purchase, error)
+	ListInventory(context.Context, *ListInventoryRequest) (*ListInventoryResponse, error)
 }
 
 // UnimplementedUsersProfileServiceServer should be embedded to have
@@ -117,6 +347,9 @@ type UnimplementedUsersProfileServiceServer struct{}
 func (UnimplementedUsersProfileServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
 }
+func (UnimplementedUsersProfileServiceServer) GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*GetUsersByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
+}
 func (UnimplementedUsersProfileServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
@@ -129,6 +362,54 @@ func (UnimplementedUsersProfileServiceServer) ChangePassword(context.Context, *C
 func (UnimplementedUsersProfileServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedUsersProfileServiceServer) ExportMyData(*ExportMyDataRequest, grpc.ServerStreamingServer[ExportDataChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) CreditCoins(context.Context, *CreditCoinsRequest) (*CoinTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditCoins not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) DebitCoins(context.Context, *DebitCoinsRequest) (*CoinTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebitCoins not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ListCoinTransactions(context.Context, *ListCoinTransactionsRequest) (*ListCoinTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoinTransactions not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ReportMatchResult(context.Context, *ReportMatchResultRequest) (*ReportMatchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMatchResult not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) GetSeasonHistory(context.Context, *GetSeasonHistoryRequest) (*GetSeasonHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonHistory not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAchievements not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) GrantXP(context.Context, *GrantXPRequest) (*XPGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantXP not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ClaimDailyReward(context.Context, *ClaimDailyRewardRequest) (*DailyReward, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDailyReward not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ReportAnswerResults(context.Context, *ReportAnswerResultsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportAnswerResults not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) GetCategoryStats(context.Context, *GetCategoryStatsRequest) (*GetCategoryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryStats not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) RecordMatch(context.Context, *RecordMatchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMatch not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatchHistory not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ListShopItems(context.Context, *emptypb.Empty) (*ListShopItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShopItems not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) PurchaseItem(context.Context, *PurchaseItemRequest) (*Purchase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseItem not implemented")
+}
+func (UnimplementedUsersProfileServiceServer) ListInventory(context.Context, *ListInventoryRequest) (*ListInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInventory not implemented")
+}
 func (UnimplementedUsersProfileServiceServer) testEmbeddedByValue() {}
 
 // UnsafeUsersProfileServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +448,24 @@ func _UsersProfileService_GetProfile_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersProfileService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).GetUsersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_GetUsersByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).GetUsersByIDs(ctx, req.(*GetUsersByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProfileRequest)
 	if err := dec(in); err != nil {
@@ -239,6 +538,287 @@ func _UsersProfileService_DeleteAccount_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersProfileService_ExportMyData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMyDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsersProfileServiceServer).ExportMyData(m, &grpc.GenericServerStream[ExportMyDataRequest, ExportDataChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersProfileService_ExportMyDataServer = grpc.ServerStreamingServer[ExportDataChunk]
+
+func _UsersProfileService_CreditCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).CreditCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_CreditCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).CreditCoins(ctx, req.(*CreditCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_DebitCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebitCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).DebitCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_DebitCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).DebitCoins(ctx, req.(*DebitCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ListCoinTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoinTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ListCoinTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ListCoinTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ListCoinTransactions(ctx, req.(*ListCoinTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ReportMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ReportMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ReportMatchResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ReportMatchResult(ctx, req.(*ReportMatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_GetSeasonHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).GetSeasonHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_GetSeasonHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).GetSeasonHistory(ctx, req.(*GetSeasonHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ListAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_GrantXP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantXPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).GrantXP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_GrantXP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).GrantXP(ctx, req.(*GrantXPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ClaimDailyReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimDailyRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ClaimDailyReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ClaimDailyReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ClaimDailyReward(ctx, req.(*ClaimDailyRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ReportAnswerResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportAnswerResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ReportAnswerResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ReportAnswerResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ReportAnswerResults(ctx, req.(*ReportAnswerResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_GetCategoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).GetCategoryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_GetCategoryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).GetCategoryStats(ctx, req.(*GetCategoryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_RecordMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).RecordMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_RecordMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).RecordMatch(ctx, req.(*RecordMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ListMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ListMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ListMatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ListMatchHistory(ctx, req.(*ListMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ListShopItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ListShopItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ListShopItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ListShopItems(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_PurchaseItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).PurchaseItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_PurchaseItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).PurchaseItem(ctx, req.(*PurchaseItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersProfileService_ListInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersProfileServiceServer).ListInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersProfileService_ListInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersProfileServiceServer).ListInventory(ctx, req.(*ListInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersProfileService_ServiceDesc is the grpc.ServiceDesc for UsersProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -250,6 +830,10 @@ var UsersProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfile",
 			Handler:    _UsersProfileService_GetProfile_Handler,
 		},
+		{
+			MethodName: "GetUsersByIDs",
+			Handler:    _UsersProfileService_GetUsersByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateProfile",
 			Handler:    _UsersProfileService_UpdateProfile_Handler,
@@ -266,7 +850,73 @@ var UsersProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _UsersProfileService_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "CreditCoins",
+			Handler:    _UsersProfileService_CreditCoins_Handler,
+		},
+		{
+			MethodName: "DebitCoins",
+			Handler:    _UsersProfileService_DebitCoins_Handler,
+		},
+		{
+			MethodName: "ListCoinTransactions",
+			Handler:    _UsersProfileService_ListCoinTransactions_Handler,
+		},
+		{
+			MethodName: "ReportMatchResult",
+			Handler:    _UsersProfileService_ReportMatchResult_Handler,
+		},
+		{
+			MethodName: "GetSeasonHistory",
+			Handler:    _UsersProfileService_GetSeasonHistory_Handler,
+		},
+		{
+			MethodName: "ListAchievements",
+			Handler:    _UsersProfileService_ListAchievements_Handler,
+		},
+		{
+			MethodName: "GrantXP",
+			Handler:    _UsersProfileService_GrantXP_Handler,
+		},
+		{
+			MethodName: "ClaimDailyReward",
+			Handler:    _UsersProfileService_ClaimDailyReward_Handler,
+		},
+		{
+			MethodName: "ReportAnswerResults",
+			Handler:    _UsersProfileService_ReportAnswerResults_Handler,
+		},
+		{
+			MethodName: "GetCategoryStats",
+			Handler:    _UsersProfileService_GetCategoryStats_Handler,
+		},
+		{
+			MethodName: "RecordMatch",
+			Handler:    _UsersProfileService_RecordMatch_Handler,
+		},
+		{
+			MethodName: "ListMatchHistory",
+			Handler:    _UsersProfileService_ListMatchHistory_Handler,
+		},
+		{
+			MethodName: "ListShopItems",
+			Handler:    _UsersProfileService_ListShopItems_Handler,
+		},
+		{
+			MethodName: "PurchaseItem",
+			Handler:    _UsersProfileService_PurchaseItem_Handler,
+		},
+		{
+			MethodName: "ListInventory",
+			Handler:    _UsersProfileService_ListInventory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMyData",
+			Handler:       _UsersProfileService_ExportMyData_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "external/users/v1/profile.proto",
 }
@@ -20,13 +20,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UsersSocialService_AddFriend_FullMethodName     = "/usersservice.v1.UsersSocialService/AddFriend"
-	UsersSocialService_AcceptFriend_FullMethodName  = "/usersservice.v1.UsersSocialService/AcceptFriend"
-	UsersSocialService_RejectFriend_FullMethodName  = "/usersservice.v1.UsersSocialService/RejectFriend"
-	UsersSocialService_RemoveFriend_FullMethodName  = "/usersservice.v1.UsersSocialService/RemoveFriend"
-	UsersSocialService_ListFriends_FullMethodName   = "/usersservice.v1.UsersSocialService/ListFriends"
-	UsersSocialService_BlockFriend_FullMethodName   = "/usersservice.v1.UsersSocialService/BlockFriend"
-	UsersSocialService_UnblockFriend_FullMethodName = "/usersservice.v1.UsersSocialService/UnblockFriend"
+	UsersSocialService_AddFriend_FullMethodName                  = "/usersservice.v1.UsersSocialService/AddFriend"
+	UsersSocialService_AcceptFriend_FullMethodName               = "/usersservice.v1.UsersSocialService/AcceptFriend"
+	UsersSocialService_RejectFriend_FullMethodName               = "/usersservice.v1.UsersSocialService/RejectFriend"
+	UsersSocialService_RemoveFriend_FullMethodName               = "/usersservice.v1.UsersSocialService/RemoveFriend"
+	UsersSocialService_ListFriends_FullMethodName                = "/usersservice.v1.UsersSocialService/ListFriends"
+	UsersSocialService_ListAcceptedFriends_FullMethodName        = "/usersservice.v1.UsersSocialService/ListAcceptedFriends"
+	UsersSocialService_ListIncomingFriendRequests_FullMethodName = "/usersservice.v1.UsersSocialService/ListIncomingFriendRequests"
+	UsersSocialService_ListOutgoingFriendRequests_FullMethodName = "/usersservice.v1.UsersSocialService/ListOutgoingFriendRequests"
+	UsersSocialService_BlockFriend_FullMethodName                = "/usersservice.v1.UsersSocialService/BlockFriend"
+	UsersSocialService_UnblockFriend_FullMethodName              = "/usersservice.v1.UsersSocialService/UnblockFriend"
+	UsersSocialService_ListBlockedUsers_FullMethodName           = "/usersservice.v1.UsersSocialService/ListBlockedUsers"
+	UsersSocialService_SearchPlayers_FullMethodName              = "/usersservice.v1.UsersSocialService/SearchPlayers"
+	UsersSocialService_SuggestFriends_FullMethodName             = "/usersservice.v1.UsersSocialService/SuggestFriends"
+	UsersSocialService_GetMutualFriends_FullMethodName           = "/usersservice.v1.UsersSocialService/GetMutualFriends"
+	UsersSocialService_Heartbeat_FullMethodName                  = "/usersservice.v1.UsersSocialService/Heartbeat"
+	UsersSocialService_UpdatePresence_FullMethodName             = "/usersservice.v1.UsersSocialService/UpdatePresence"
+	UsersSocialService_SubscribeFriendsPresence_FullMethodName   = "/usersservice.v1.UsersSocialService/SubscribeFriendsPresence"
+	UsersSocialService_CreateParty_FullMethodName                = "/usersservice.v1.UsersSocialService/CreateParty"
+	UsersSocialService_GetParty_FullMethodName                   = "/usersservice.v1.UsersSocialService/GetParty"
+	UsersSocialService_InviteToParty_FullMethodName              = "/usersservice.v1.UsersSocialService/InviteToParty"
+	UsersSocialService_AcceptPartyInvite_FullMethodName          = "/usersservice.v1.UsersSocialService/AcceptPartyInvite"
+	UsersSocialService_DeclinePartyInvite_FullMethodName         = "/usersservice.v1.UsersSocialService/DeclinePartyInvite"
+	UsersSocialService_LeaveParty_FullMethodName                 = "/usersservice.v1.UsersSocialService/LeaveParty"
+	UsersSocialService_PromotePartyLeader_FullMethodName         = "/usersservice.v1.UsersSocialService/PromotePartyLeader"
+	UsersSocialService_SubscribeParty_FullMethodName             = "/usersservice.v1.UsersSocialService/SubscribeParty"
+	UsersSocialService_ReportUser_FullMethodName                 = "/usersservice.v1.UsersSocialService/ReportUser"
+	UsersSocialService_SubscribeNotifications_FullMethodName     = "/usersservice.v1.UsersSocialService/SubscribeNotifications"
+	UsersSocialService_ListNotifications_FullMethodName          = "/usersservice.v1.UsersSocialService/ListNotifications"
+	UsersSocialService_MarkRead_FullMethodName                   = "/usersservice.v1.UsersSocialService/MarkRead"
 )
 
 // UsersSocialServiceClient is the client API for UsersSocialService service.
@@ -38,8 +60,30 @@ type UsersSocialServiceClient interface {
 	RejectFriend(ctx context.Context, in *RejectFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*FriendsList, error)
+	ListAcceptedFriends(ctx context.Context, in *ListAcceptedFriendsRequest, opts ...grpc.CallOption) (*FriendsPage, error)
+	ListIncomingFriendRequests(ctx context.Context, in *ListIncomingFriendRequestsRequest, opts ...grpc.CallOption) (*FriendsPage, error)
+	ListOutgoingFriendRequests(ctx context.Context, in *ListOutgoingFriendRequestsRequest, opts ...grpc.CallOption) (*FriendsPage, error)
 	BlockFriend(ctx context.Context, in *BlockFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnblockFriend(ctx context.Context, in *UnblockFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	SearchPlayers(ctx context.Context, in *SearchPlayersRequest, opts ...grpc.CallOption) (*SearchPlayersResponse, error)
+	SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error)
+	GetMutualFriends(ctx context.Context, in *GetMutualFriendsRequest, opts ...grpc.CallOption) (*GetMutualFriendsResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SubscribeFriendsPresence(ctx context.Context, in *SubscribeFriendsPresenceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Presence], error)
+	CreateParty(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Party, error)
+	GetParty(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Party, error)
+	InviteToParty(ctx context.Context, in *InviteToPartyRequest, opts ...grpc.CallOption) (*Party, error)
+	AcceptPartyInvite(ctx context.Context, in *AcceptPartyInviteRequest, opts ...grpc.CallOption) (*Party, error)
+	DeclinePartyInvite(ctx context.Context, in *DeclinePartyInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaveParty(ctx context.Context, in *LeavePartyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PromotePartyLeader(ctx context.Context, in *PromotePartyLeaderRequest, opts ...grpc.CallOption) (*Party, error)
+	SubscribeParty(ctx context.Context, in *SubscribePartyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Party], error)
+	ReportUser(ctx context.Context, in *ReportUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SubscribeNotifications(ctx context.Context, in *SubscribeNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
 }
 
 type usersSocialServiceClient struct {
@@ -100,6 +144,36 @@ func (c *usersSocialServiceClient) ListFriends(ctx context.Context, in *ListFrie
 	return out, nil
 }
 
+func (c *usersSocialServiceClient) ListAcceptedFriends(ctx context.Context, in *ListAcceptedFriendsRequest, opts ...grpc.CallOption) (*FriendsPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendsPage)
+	err := c.cc.Invoke(ctx, UsersSocialService_ListAcceptedFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) ListIncomingFriendRequests(ctx context.Context, in *ListIncomingFriendRequestsRequest, opts ...grpc.CallOption) (*FriendsPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendsPage)
+	err := c.cc.Invoke(ctx, UsersSocialService_ListIncomingFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) ListOutgoingFriendRequests(ctx context.Context, in *ListOutgoingFriendRequestsRequest, opts ...grpc.CallOption) (*FriendsPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendsPage)
+	err := c.cc.Invoke(ctx, UsersSocialService_ListOutgoingFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersSocialServiceClient) BlockFriend(ctx context.Context, in *BlockFriendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -120,6 +194,223 @@ func (c *usersSocialServiceClient) UnblockFriend(ctx context.Context, in *Unbloc
 	return out, nil
 }
 
+func (c *usersSocialServiceClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, UsersSocialService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) SearchPlayers(ctx context.Context, in *SearchPlayersRequest, opts ...grpc.CallOption) (*SearchPlayersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPlayersResponse)
+	err := c.cc.Invoke(ctx, UsersSocialService_SearchPlayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestFriendsResponse)
+	err := c.cc.Invoke(ctx, UsersSocialService_SuggestFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) GetMutualFriends(ctx context.Context, in *GetMutualFriendsRequest, opts ...grpc.CallOption) (*GetMutualFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutualFriendsResponse)
+	err := c.cc.Invoke(ctx, UsersSocialService_GetMutualFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersSocialService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersSocialService_UpdatePresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) SubscribeFriendsPresence(ctx context.Context, in *SubscribeFriendsPresenceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Presence], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UsersSocialService_ServiceDesc.Streams[0], UsersSocialService_SubscribeFriendsPresence_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeFriendsPresenceRequest, Presence]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersSocialService_SubscribeFriendsPresenceClient = grpc.ServerStreamingClient[Presence]
+
+func (c *usersSocialServiceClient) CreateParty(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Party, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, UsersSocialService_CreateParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) GetParty(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Party, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, UsersSocialService_GetParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) InviteToParty(ctx context.Context, in *InviteToPartyRequest, opts ...grpc.CallOption) (*Party, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, UsersSocialService_InviteToParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) AcceptPartyInvite(ctx context.Context, in *AcceptPartyInviteRequest, opts ...grpc.CallOption) (*Party, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, UsersSocialService_AcceptPartyInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) DeclinePartyInvite(ctx context.Context, in *DeclinePartyInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersSocialService_DeclinePartyInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) LeaveParty(ctx context.Context, in *LeavePartyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersSocialService_LeaveParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) PromotePartyLeader(ctx context.Context, in *PromotePartyLeaderRequest, opts ...grpc.CallOption) (*Party, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, UsersSocialService_PromotePartyLeader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) SubscribeParty(ctx context.Context, in *SubscribePartyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Party], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UsersSocialService_ServiceDesc.Streams[1], UsersSocialService_SubscribeParty_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribePartyRequest, Party]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersSocialService_SubscribePartyClient = grpc.ServerStreamingClient[Party]
+
+func (c *usersSocialServiceClient) ReportUser(ctx context.Context, in *ReportUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UsersSocialService_ReportUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) SubscribeNotifications(ctx context.Context, in *SubscribeNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UsersSocialService_ServiceDesc.Streams[2], UsersSocialService_SubscribeNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeNotificationsRequest, Notification]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersSocialService_SubscribeNotificationsClient = grpc.ServerStreamingClient[Notification]
+
+func (c *usersSocialServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, UsersSocialService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersSocialServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, UsersSocialService_MarkRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersSocialServiceServer is the server API for UsersSocialService service.
 // All implementations should embed UnimplementedUsersSocialServiceServer
 // for forward compatibility.
@@ -129,8 +420,30 @@ type UsersSocialServiceServer interface {
 	RejectFriend(context.Context, *RejectFriendRequest) (*emptypb.Empty, error)
 	RemoveFriend(context.Context, *RemoveFriendRequest) (*emptypb.Empty, error)
 	ListFriends(context.Context, *ListFriendsRequest) (*FriendsList, error)
+	ListAcceptedFriends(context.Context, *ListAcceptedFriendsRequest) (*FriendsPage, error)
+	ListIncomingFriendRequests(context.Context, *ListIncomingFriendRequestsRequest) (*FriendsPage, error)
+	ListOutgoingFriendRequests(context.Context, *ListOutgoingFriendRequestsRequest) (*FriendsPage, error)
 	BlockFriend(context.Context, *BlockFriendRequest) (*emptypb.Empty, error)
 	UnblockFriend(context.Context, *UnblockFriendRequest) (*emptypb.Empty, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	SearchPlayers(context.Context, *SearchPlayersRequest) (*SearchPlayersResponse, error)
+	SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error)
+	GetMutualFriends(context.Context, *GetMutualFriendsRequest) (*GetMutualFriendsResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*emptypb.Empty, error)
+	UpdatePresence(context.Context, *UpdatePresenceRequest) (*emptypb.Empty, error)
+	SubscribeFriendsPresence(*SubscribeFriendsPresenceRequest, grpc.ServerStreamingServer[Presence]) error
+	CreateParty(context.Context, *CreatePartyRequest) (*Party, error)
+	GetParty(context.Context, *GetPartyRequest) (*Party, error)
+	InviteToParty(context.Context, *InviteToPartyRequest) (*Party, error)
+	AcceptPartyInvite(context.Context, *AcceptPartyInviteRequest) (*Party, error)
+	DeclinePartyInvite(context.Context, *DeclinePartyInviteRequest) (*emptypb.Empty, error)
+	LeaveParty(context.Context, *LeavePartyRequest) (*emptypb.Empty, error)
+	PromotePartyLeader(context.Context, *PromotePartyLeaderRequest) (*Party, error)
+	SubscribeParty(*SubscribePartyRequest, grpc.ServerStreamingServer[Party]) error
+	ReportUser(context.Context, *ReportUserRequest) (*emptypb.Empty, error)
+	SubscribeNotifications(*SubscribeNotificationsRequest, grpc.ServerStreamingServer[Notification]) error
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
 }
 
 // UnimplementedUsersSocialServiceServer should be embedded to have
@@ -155,12 +468,78 @@ func (UnimplementedUsersSocialServiceServer) RemoveFriend(context.Context, *Remo
 func (UnimplementedUsersSocialServiceServer) ListFriends(context.Context, *ListFriendsRequest) (*FriendsList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
 }
+func (UnimplementedUsersSocialServiceServer) ListAcceptedFriends(context.Context, *ListAcceptedFriendsRequest) (*FriendsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAcceptedFriends not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) ListIncomingFriendRequests(context.Context, *ListIncomingFriendRequestsRequest) (*FriendsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncomingFriendRequests not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) ListOutgoingFriendRequests(context.Context, *ListOutgoingFriendRequestsRequest) (*FriendsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingFriendRequests not implemented")
+}
 func (UnimplementedUsersSocialServiceServer) BlockFriend(context.Context, *BlockFriendRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockFriend not implemented")
 }
 func (UnimplementedUsersSocialServiceServer) UnblockFriend(context.Context, *UnblockFriendRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnblockFriend not implemented")
 }
+func (UnimplementedUsersSocialServiceServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) SearchPlayers(context.Context, *SearchPlayersRequest) (*SearchPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPlayers not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFriends not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) GetMutualFriends(context.Context, *GetMutualFriendsRequest) (*GetMutualFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutualFriends not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) UpdatePresence(context.Context, *UpdatePresenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) SubscribeFriendsPresence(*SubscribeFriendsPresenceRequest, grpc.ServerStreamingServer[Presence]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFriendsPresence not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) CreateParty(context.Context, *CreatePartyRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateParty not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) GetParty(context.Context, *GetPartyRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParty not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) InviteToParty(context.Context, *InviteToPartyRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToParty not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) AcceptPartyInvite(context.Context, *AcceptPartyInviteRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPartyInvite not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) DeclinePartyInvite(context.Context, *DeclinePartyInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePartyInvite not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) LeaveParty(context.Context, *LeavePartyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveParty not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) PromotePartyLeader(context.Context, *PromotePartyLeaderRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromotePartyLeader not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) SubscribeParty(*SubscribePartyRequest, grpc.ServerStreamingServer[Party]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeParty not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) ReportUser(context.Context, *ReportUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUser not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) SubscribeNotifications(*SubscribeNotificationsRequest, grpc.ServerStreamingServer[Notification]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNotifications not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedUsersSocialServiceServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
 func (UnimplementedUsersSocialServiceServer) testEmbeddedByValue() {}
 
 // UnsafeUsersSocialServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +650,60 @@ func _UsersSocialService_ListFriends_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersSocialService_ListAcceptedFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAcceptedFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).ListAcceptedFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_ListAcceptedFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).ListAcceptedFriends(ctx, req.(*ListAcceptedFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_ListIncomingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncomingFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).ListIncomingFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_ListIncomingFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).ListIncomingFriendRequests(ctx, req.(*ListIncomingFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_ListOutgoingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).ListOutgoingFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_ListOutgoingFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).ListOutgoingFriendRequests(ctx, req.(*ListOutgoingFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersSocialService_BlockFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockFriendRequest)
 	if err := dec(in); err != nil {
@@ -307,6 +740,327 @@ func _UsersSocialService_UnblockFriend_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersSocialService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_SearchPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).SearchPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_SearchPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).SearchPlayers(ctx, req.(*SearchPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_SuggestFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).SuggestFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_SuggestFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).SuggestFriends(ctx, req.(*SuggestFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_GetMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).GetMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_GetMutualFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).GetMutualFriends(ctx, req.(*GetMutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).UpdatePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_UpdatePresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_SubscribeFriendsPresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFriendsPresenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsersSocialServiceServer).SubscribeFriendsPresence(m, &grpc.GenericServerStream[SubscribeFriendsPresenceRequest, Presence]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersSocialService_SubscribeFriendsPresenceServer = grpc.ServerStreamingServer[Presence]
+
+func _UsersSocialService_CreateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).CreateParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_CreateParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).CreateParty(ctx, req.(*CreatePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_GetParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).GetParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_GetParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).GetParty(ctx, req.(*GetPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_InviteToParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).InviteToParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_InviteToParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).InviteToParty(ctx, req.(*InviteToPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_AcceptPartyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPartyInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).AcceptPartyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_AcceptPartyInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).AcceptPartyInvite(ctx, req.(*AcceptPartyInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_DeclinePartyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePartyInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).DeclinePartyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_DeclinePartyInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).DeclinePartyInvite(ctx, req.(*DeclinePartyInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_LeaveParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeavePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).LeaveParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_LeaveParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).LeaveParty(ctx, req.(*LeavePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_PromotePartyLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotePartyLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).PromotePartyLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_PromotePartyLeader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).PromotePartyLeader(ctx, req.(*PromotePartyLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_SubscribeParty_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePartyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsersSocialServiceServer).SubscribeParty(m, &grpc.GenericServerStream[SubscribePartyRequest, Party]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersSocialService_SubscribePartyServer = grpc.ServerStreamingServer[Party]
+
+func _UsersSocialService_ReportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).ReportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_ReportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).ReportUser(ctx, req.(*ReportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_SubscribeNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsersSocialServiceServer).SubscribeNotifications(m, &grpc.GenericServerStream[SubscribeNotificationsRequest, Notification]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UsersSocialService_SubscribeNotificationsServer = grpc.ServerStreamingServer[Notification]
+
+func _UsersSocialService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersSocialService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersSocialServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersSocialService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersSocialServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersSocialService_ServiceDesc is the grpc.ServiceDesc for UsersSocialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -334,6 +1088,18 @@ var UsersSocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFriends",
 			Handler:    _UsersSocialService_ListFriends_Handler,
 		},
+		{
+			MethodName: "ListAcceptedFriends",
+			Handler:    _UsersSocialService_ListAcceptedFriends_Handler,
+		},
+		{
+			MethodName: "ListIncomingFriendRequests",
+			Handler:    _UsersSocialService_ListIncomingFriendRequests_Handler,
+		},
+		{
+			MethodName: "ListOutgoingFriendRequests",
+			Handler:    _UsersSocialService_ListOutgoingFriendRequests_Handler,
+		},
 		{
 			MethodName: "BlockFriend",
 			Handler:    _UsersSocialService_BlockFriend_Handler,
@@ -342,7 +1108,87 @@ var UsersSocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnblockFriend",
 			Handler:    _UsersSocialService_UnblockFriend_Handler,
 		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _UsersSocialService_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "SearchPlayers",
+			Handler:    _UsersSocialService_SearchPlayers_Handler,
+		},
+		{
+			MethodName: "SuggestFriends",
+			Handler:    _UsersSocialService_SuggestFriends_Handler,
+		},
+		{
+			MethodName: "GetMutualFriends",
+			Handler:    _UsersSocialService_GetMutualFriends_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _UsersSocialService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "UpdatePresence",
+			Handler:    _UsersSocialService_UpdatePresence_Handler,
+		},
+		{
+			MethodName: "CreateParty",
+			Handler:    _UsersSocialService_CreateParty_Handler,
+		},
+		{
+			MethodName: "GetParty",
+			Handler:    _UsersSocialService_GetParty_Handler,
+		},
+		{
+			MethodName: "InviteToParty",
+			Handler:    _UsersSocialService_InviteToParty_Handler,
+		},
+		{
+			MethodName: "AcceptPartyInvite",
+			Handler:    _UsersSocialService_AcceptPartyInvite_Handler,
+		},
+		{
+			MethodName: "DeclinePartyInvite",
+			Handler:    _UsersSocialService_DeclinePartyInvite_Handler,
+		},
+		{
+			MethodName: "LeaveParty",
+			Handler:    _UsersSocialService_LeaveParty_Handler,
+		},
+		{
+			MethodName: "PromotePartyLeader",
+			Handler:    _UsersSocialService_PromotePartyLeader_Handler,
+		},
+		{
+			MethodName: "ReportUser",
+			Handler:    _UsersSocialService_ReportUser_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _UsersSocialService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _UsersSocialService_MarkRead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeFriendsPresence",
+			Handler:       _UsersSocialService_SubscribeFriendsPresence_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeParty",
+			Handler:       _UsersSocialService_SubscribeParty_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNotifications",
+			Handler:       _UsersSocialService_SubscribeNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "external/users/v1/social.proto",
 }
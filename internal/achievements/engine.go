@@ -0,0 +1,127 @@
+package achievements
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/config"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const sectionKey = "achievements"
+
+// Engine holds the achievement catalogue and decides which achievements a user has earned.
+// The catalogue is replaced as a whole when the config section changes.
+type Engine struct {
+	mu        sync.RWMutex
+	catalogue []*profile.AchievementDefinition
+}
+
+func NewEngine(cfg *config.AchievementsConfig) (*Engine, error) {
+	var engine Engine
+
+	if err := engine.UpdateConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return &engine, nil
+}
+
+func (e *Engine) SectionKey() string {
+	return sectionKey
+}
+
+// UpdateConfig validates the catalogue and swaps it in, the current catalogue is kept when it is invalid.
+func (e *Engine) UpdateConfig(cfg *config.AchievementsConfig) error {
+	var entries []*config.AchievementConfig
+	if cfg != nil {
+		entries = cfg.Catalogue
+	}
+
+	catalogue := make([]*profile.AchievementDefinition, 0, len(entries))
+	codes := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		definition := &profile.AchievementDefinition{
+			Code:        entry.Code,
+			Title:       entry.Title,
+			Description: entry.Description,
+			Metric:      profile.AchievementMetric(entry.Metric),
+			Threshold:   entry.Threshold,
+		}
+
+		if err := validate(definition); err != nil {
+			return err
+		}
+
+		if _, ok := codes[definition.Code]; ok {
+			return fmt.Errorf("achievement %q is defined twice", definition.Code)
+		}
+
+		codes[definition.Code] = struct{}{}
+		catalogue = append(catalogue, definition)
+	}
+
+	e.mu.Lock()
+	e.catalogue = catalogue
+	e.mu.Unlock()
+
+	return nil
+}
+
+// Catalogue returns every defined achievement in the configured order.
+func (e *Engine) Catalogue() []*profile.AchievementDefinition {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.catalogue
+}
+
+// Affected reports whether any achievement depends on a metric the event may change.
+func (e *Engine) Affected(event profile.AchievementEvent) bool {
+	metrics := event.Metrics()
+
+	for _, definition := range e.Catalogue() {
+		for _, metric := range metrics {
+			if definition.Metric == metric {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Evaluate returns codes of achievements depending on metrics changed by the event that the progress reaches.
+// Codes the user already holds are returned as well, awarding is expected to be idempotent.
+func (e *Engine) Evaluate(event profile.AchievementEvent, progress profile.AchievementProgress) []string {
+	var codes []string
+
+	for _, definition := range e.Catalogue() {
+		for _, metric := range event.Metrics() {
+			if definition.Metric == metric && progress[metric] >= definition.Threshold {
+				codes = append(codes, definition.Code)
+			}
+		}
+	}
+
+	return codes
+}
+
+func validate(definition *profile.AchievementDefinition) error {
+	switch {
+	case definition.Code == "":
+		return errors.New("achievement code not provided")
+	case len(definition.Code) > 64:
+		return fmt.Errorf("achievement code %q is too long", definition.Code)
+	case definition.Title == "":
+		return fmt.Errorf("achievement %q has no title", definition.Code)
+	case !definition.Metric.Valid():
+		return fmt.Errorf("achievement %q has unknown metric %q", definition.Code, definition.Metric)
+	case definition.Threshold <= 0:
+		return fmt.Errorf("achievement %q must have a positive threshold", definition.Code)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/admin"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -148,3 +149,125 @@ func (h *Handler) UnbanUser(ctx context.Context, request *userspb.UnbanUserReque
 
 	return Empty, nil
 }
+
+func (h *Handler) ExportUserData(request *userspb.ExportUserDataRequest, stream grpc.ServerStreamingServer[userspb.ExportDataChunk]) error {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("ExportUserData").Inc()
+
+	ctx := stream.Context()
+
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues("ExportUserData", err.Error()).Inc()
+		return err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	export, err := h.service.AdminExportUserData(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return sendExport(stream, export, profile.ExportFormatFromGRPCEnum(request.GetFormat()))
+}
+
+func (h *Handler) ReconcileCoins(ctx context.Context, request *userspb.ReconcileCoinsRequest) (*userspb.ReconcileCoinsResponse, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("ReconcileCoins").Inc()
+
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues("ReconcileCoins", err.Error()).Inc()
+		return nil, err
+	}
+
+	res, err := h.service.AdminReconcileCoins(ctx, request.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) CreateSeason(ctx context.Context, request *userspb.CreateSeasonRequest) (*userspb.Season, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("CreateSeason").Inc()
+
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues("CreateSeason", err.Error()).Inc()
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.Season](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.AdminCreateSeason(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) UpdateSeason(ctx context.Context, request *userspb.UpdateSeasonRequest) (*userspb.Season, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("UpdateSeason").Inc()
+
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues("UpdateSeason", err.Error()).Inc()
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.UpdateSeason](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.AdminUpdateSeason(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListSeasons(ctx context.Context, _ *emptypb.Empty) (*userspb.ListSeasonsResponse, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("ListSeasons").Inc()
+
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues("ListSeasons", err.Error()).Inc()
+		return nil, err
+	}
+
+	res, err := h.service.AdminListSeasons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
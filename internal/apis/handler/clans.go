@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/clan"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) CreateClan(ctx context.Context, request *userspb.CreateClanRequest) (*userspb.Clan, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[clan.CreateClan](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.CreateClan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetClan(ctx context.Context, request *userspb.GetClanRequest) (*userspb.GetClanResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetClan(ctx, viewerID(claims.UserID), clanID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) InviteToClan(ctx context.Context, request *userspb.InviteToClanRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	inviteeID, err := uuidx.Parse(request.GetInviteeId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.InviteToClan(ctx, clanID, userID, inviteeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) JoinClan(ctx context.Context, request *userspb.JoinClanRequest) (*userspb.JoinClanResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.JoinClan(ctx, clanID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListClanJoinRequests(ctx context.Context, request *userspb.ListClanJoinRequestsRequest) (*userspb.ListClanJoinRequestsResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListClanJoinRequests(ctx, clanID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) AcceptClanJoinRequest(ctx context.Context, request *userspb.AcceptClanJoinRequestRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	requesterID, err := uuidx.Parse(request.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.AcceptClanJoinRequest(ctx, clanID, userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) DeclineClanJoinRequest(ctx context.Context, request *userspb.DeclineClanJoinRequestRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	requesterID, err := uuidx.Parse(request.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.DeclineClanJoinRequest(ctx, clanID, userID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) CancelClanJoinRequest(ctx context.Context, request *userspb.CancelClanJoinRequestRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.CancelClanJoinRequest(ctx, clanID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) DeclineClanInvite(ctx context.Context, request *userspb.DeclineClanInviteRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.DeclineClanInvite(ctx, clanID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) CancelClanInvite(ctx context.Context, request *userspb.CancelClanInviteRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	inviteeID, err := uuidx.Parse(request.GetInviteeId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.CancelClanInvite(ctx, clanID, userID, inviteeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) LeaveClan(ctx context.Context, request *userspb.LeaveClanRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.LeaveClan(ctx, clanID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) KickClanMember(ctx context.Context, request *userspb.KickClanMemberRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := uuidx.Parse(request.GetMemberId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.KickClanMember(ctx, clanID, userID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) SetClanMemberRole(ctx context.Context, request *userspb.SetClanMemberRoleRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[clan.SetMemberRole](request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.SetClanMemberRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) TransferClanOwnership(ctx context.Context, request *userspb.TransferClanOwnershipRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	clanID, err := uuidx.Parse(request.GetClanId())
+	if err != nil {
+		return nil, err
+	}
+
+	newOwnerID, err := uuidx.Parse(request.GetNewOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.TransferClanOwnership(ctx, clanID, userID, newOwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/service"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -11,10 +12,12 @@ import (
 var Empty = &emptypb.Empty{}
 
 var (
-	_ userspb.UsersAdminServiceServer   = (*Handler)(nil)
-	_ userspb.UsersAuthServiceServer    = (*Handler)(nil)
-	_ userspb.UsersProfileServiceServer = (*Handler)(nil)
-	_ userspb.UsersSocialServiceServer  = (*Handler)(nil)
+	_ userspb.UsersAdminServiceServer       = (*Handler)(nil)
+	_ userspb.UsersAuthServiceServer        = (*Handler)(nil)
+	_ userspb.UsersProfileServiceServer     = (*Handler)(nil)
+	_ userspb.UsersSocialServiceServer      = (*Handler)(nil)
+	_ userspb.UsersLeaderboardServiceServer = (*Handler)(nil)
+	_ userspb.UsersClansServiceServer       = (*Handler)(nil)
 )
 
 type Handler struct {
@@ -26,3 +29,13 @@ type Handler struct {
 func NewHandler(service *service.Service, jwt *jwt.Service, logger *zap.Logger) *Handler {
 	return &Handler{service: service, jwt: jwt, logger: logger}
 }
+
+// viewerID returns the id of the caller or uuid.Nil when the token does not carry a user id.
+func viewerID(userID string) uuid.UUID {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return id
+}
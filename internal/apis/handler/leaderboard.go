@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (h *Handler) GetGlobalLeaderboard(ctx context.Context, request *userspb.GetGlobalLeaderboardRequest) (*userspb.Leaderboard, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := profile.NewLeaderboardFilter(request.GetSize(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetLeaderboard(ctx, viewerID(claims.UserID), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetCountryLeaderboard(ctx context.Context, request *userspb.GetCountryLeaderboardRequest) (*userspb.Leaderboard, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	country := request.GetCountry()
+
+	filter, err := profile.NewLeaderboardFilter(request.GetSize(), &country)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetLeaderboard(ctx, viewerID(claims.UserID), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetLeaderboardAroundMe(ctx context.Context, request *userspb.GetLeaderboardAroundMeRequest) (*userspb.Leaderboard, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetLeaderboardAroundUser(ctx, userID, userID, request.GetRadius())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetFriendsLeaderboard(ctx context.Context, request *userspb.GetFriendsLeaderboardRequest) (*userspb.Leaderboard, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetFriendsLeaderboard(ctx, userID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
+	"google.golang.org/grpc"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (h *Handler) SubscribeNotifications(request *userspb.SubscribeNotificationsRequest, stream grpc.ServerStreamingServer[userspb.Notification]) error {
+	ctx := stream.Context()
+
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	return h.service.WatchNotifications(ctx, userID, func(n *profile.Notification) error {
+		res, resErr := abstractions.MakeResponse(n)
+		if resErr != nil {
+			return resErr
+		}
+
+		return stream.Send(res)
+	})
+}
+
+func (h *Handler) ListNotifications(ctx context.Context, request *userspb.ListNotificationsRequest) (*userspb.ListNotificationsResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.ListNotifications](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListNotifications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) MarkRead(ctx context.Context, request *userspb.MarkReadRequest) (*userspb.MarkReadResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.MarkRead](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.MarkRead(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
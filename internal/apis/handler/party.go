@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) CreateParty(ctx context.Context, request *userspb.CreatePartyRequest) (*userspb.Party, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.CreateParty(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetParty(ctx context.Context, request *userspb.GetPartyRequest) (*userspb.Party, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetParty(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) InviteToParty(ctx context.Context, request *userspb.InviteToPartyRequest) (*userspb.Party, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuidx.Parse(request.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+
+	friendID, err := uuidx.Parse(request.GetFriendId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.InviteToParty(ctx, partyID, userID, friendID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) AcceptPartyInvite(ctx context.Context, request *userspb.AcceptPartyInviteRequest) (*userspb.Party, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuidx.Parse(request.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.AcceptPartyInvite(ctx, partyID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) DeclinePartyInvite(ctx context.Context, request *userspb.DeclinePartyInviteRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuidx.Parse(request.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.DeclinePartyInvite(ctx, partyID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) LeaveParty(ctx context.Context, request *userspb.LeavePartyRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuidx.Parse(request.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.LeaveParty(ctx, partyID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) PromotePartyLeader(ctx context.Context, request *userspb.PromotePartyLeaderRequest) (*userspb.Party, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuidx.Parse(request.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := uuidx.Parse(request.GetMemberId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.PromotePartyLeader(ctx, partyID, userID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) SubscribeParty(request *userspb.SubscribePartyRequest, stream grpc.ServerStreamingServer[userspb.Party]) error {
+	ctx := stream.Context()
+
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	partyID, err := uuidx.Parse(request.GetPartyId())
+	if err != nil {
+		return err
+	}
+
+	return h.service.WatchParty(ctx, partyID, userID, func(p *profile.Party) error {
+		res, resErr := abstractions.MakeResponse(p)
+		if resErr != nil {
+			return resErr
+		}
+
+		return stream.Send(res)
+	})
+}
@@ -2,14 +2,18 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -45,14 +49,21 @@ func (h *Handler) GetProfile(ctx context.Context, request *userspb.GetProfileReq
 				return nil, err
 			}
 
+			var achievements []*userspb.Achievement
+			achievements, err = h.earnedAchievements(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+
 			return &userspb.GetProfileResponse{
 				Data: &userspb.GetProfileResponse_Profile{
 					Profile: r,
 				},
+				Achievements: achievements,
 			}, nil
 		}
 
-		res, err = h.service.GetProfileByID(ctx, userID)
+		res, err = h.service.GetProfileByID(ctx, viewerID(claims.UserID), userID)
 		if err != nil {
 			return nil, err
 		}
@@ -62,7 +73,7 @@ func (h *Handler) GetProfile(ctx context.Context, request *userspb.GetProfileReq
 			return nil, err
 		}
 	case *userspb.GetProfileRequest_Username:
-		res, err = h.service.GetProfileByUsername(ctx, request.GetUsername())
+		res, err = h.service.GetProfileByUsername(ctx, viewerID(claims.UserID), request.GetUsername())
 		if err != nil {
 			return nil, err
 		}
@@ -71,15 +82,76 @@ func (h *Handler) GetProfile(ctx context.Context, request *userspb.GetProfileReq
 		if err != nil {
 			return nil, err
 		}
+	default:
+		return nil, apperrors.BadRequest(errors.New("user id or username not provided"))
+	}
+
+	achievements, err := h.earnedAchievements(ctx, res.ID)
+	if err != nil {
+		return nil, err
 	}
 
 	return &userspb.GetProfileResponse{
 		Data: &userspb.GetProfileResponse_User{
 			User: result,
 		},
+		Achievements: achievements,
 	}, nil
 }
 
+func (h *Handler) earnedAchievements(ctx context.Context, userID uuid.UUID) ([]*userspb.Achievement, error) {
+	earned, err := h.service.GetEarnedAchievements(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	achievements := make([]*userspb.Achievement, len(earned))
+	for i, achievement := range earned {
+		if achievements[i], err = achievement.Response(); err != nil {
+			return nil, err
+		}
+	}
+
+	return achievements, nil
+}
+
+func (h *Handler) GetUsersByIDs(ctx context.Context, request *userspb.GetUsersByIDsRequest) (*userspb.GetUsersByIDsResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(request.GetUserIds()))
+	seen := make(map[uuid.UUID]struct{}, len(request.GetUserIds()))
+
+	for _, id := range request.GetUserIds() {
+		var userID uuid.UUID
+		userID, err = uuidx.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+
+		seen[userID] = struct{}{}
+		userIDs = append(userIDs, userID)
+	}
+
+	res, err := h.service.GetUsersByIDs(ctx, viewerID(claims.UserID), userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (h *Handler) UpdateProfile(ctx context.Context, request *userspb.UpdateProfileRequest) (*emptypb.Empty, error) {
 	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
 	if err != nil {
@@ -165,3 +237,393 @@ func (h *Handler) DeleteAccount(ctx context.Context, request *userspb.DeleteAcco
 
 	return Empty, nil
 }
+
+func (h *Handler) ExportMyData(request *userspb.ExportMyDataRequest, stream grpc.ServerStreamingServer[userspb.ExportDataChunk]) error {
+	ctx := stream.Context()
+
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	export, err := h.service.ExportProfileData(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return sendExport(stream, export, profile.ExportFormatFromGRPCEnum(request.GetFormat()))
+}
+
+const exportChunkSize = 64 << 10
+
+func sendExport(stream grpc.ServerStreamingServer[userspb.ExportDataChunk], export *profile.DataExport, format profile.ExportFormat) error {
+	data, err := export.Encode(format)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	defer metrics.ProfileExportTotalCounter.WithLabelValues(format.String()).Inc()
+
+	chunk := &userspb.ExportDataChunk{
+		FileName:    export.FileName(format),
+		ContentType: format.ContentType(),
+	}
+
+	for offset := 0; offset < len(data); offset += exportChunkSize {
+		end := min(offset+exportChunkSize, len(data))
+		chunk.Data = data[offset:end]
+
+		if err = stream.Send(chunk); err != nil {
+			return err
+		}
+
+		chunk = &userspb.ExportDataChunk{}
+	}
+
+	return nil
+}
+
+func (h *Handler) CreditCoins(ctx context.Context, request *userspb.CreditCoinsRequest) (*userspb.CoinTransaction, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.CreditCoins](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.CreditCoins(ctx, &req.CoinOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) DebitCoins(ctx context.Context, request *userspb.DebitCoinsRequest) (*userspb.CoinTransaction, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.DebitCoins](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.DebitCoins(ctx, &req.CoinOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListCoinTransactions(ctx context.Context, request *userspb.ListCoinTransactionsRequest) (*userspb.ListCoinTransactionsResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.ListCoinTransactions](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListCoinTransactions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ReportMatchResult(ctx context.Context, request *userspb.ReportMatchResultRequest) (*userspb.ReportMatchResultResponse, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.MatchResult](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ReportMatchResult(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetSeasonHistory(ctx context.Context, request *userspb.GetSeasonHistoryRequest) (*userspb.GetSeasonHistoryResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetSeasonHistory(ctx, viewerID(claims.UserID), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListAchievements(ctx context.Context, request *userspb.ListAchievementsRequest) (*userspb.ListAchievementsResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListAchievements(ctx, viewerID(claims.UserID), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GrantXP(ctx context.Context, request *userspb.GrantXPRequest) (*userspb.XPGrant, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.GrantXP](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GrantXP(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ClaimDailyReward(ctx context.Context, request *userspb.ClaimDailyRewardRequest) (*userspb.DailyReward, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ClaimDailyReward(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ReportAnswerResults(ctx context.Context, request *userspb.ReportAnswerResultsRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.AnswerResults](request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.ReportAnswerResults(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) GetCategoryStats(ctx context.Context, request *userspb.GetCategoryStatsRequest) (*userspb.GetCategoryStatsResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.GetCategoryStats(ctx, viewerID(claims.UserID), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) RecordMatch(ctx context.Context, request *userspb.RecordMatchRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.MatchRecord](request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.RecordMatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) ListMatchHistory(ctx context.Context, request *userspb.ListMatchHistoryRequest) (*userspb.ListMatchHistoryResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.ListMatchHistory](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListMatchHistory(ctx, viewerID(claims.UserID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListShopItems(ctx context.Context, _ *emptypb.Empty) (*userspb.ListShopItemsResponse, error) {
+	_, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(h.service.ListShopItems())
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) PurchaseItem(ctx context.Context, request *userspb.PurchaseItemRequest) (*userspb.Purchase, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.PurchaseItem](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.PurchaseItem(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListInventory(ctx context.Context, request *userspb.ListInventoryRequest) (*userspb.ListInventoryResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListInventory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
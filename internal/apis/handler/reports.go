@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) ReportUser(ctx context.Context, request *userspb.ReportUserRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[report.ReportUser](request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.service.ReportUser(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) ListReports(ctx context.Context, request *userspb.ListReportsRequest) (*userspb.ListReportsResponse, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("ListReports").Inc()
+
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues("ListReports", err.Error()).Inc()
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[report.Filter](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.AdminListReports(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ClaimReport(ctx context.Context, request *userspb.ClaimReportRequest) (*userspb.UserReport, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("ClaimReport").Inc()
+
+	moderatorID, err := h.moderatorID(ctx, "ClaimReport")
+	if err != nil {
+		return nil, err
+	}
+
+	reportID, err := uuidx.Parse(request.GetReportId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.AdminClaimReport(ctx, reportID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ResolveReport(ctx context.Context, request *userspb.ResolveReportRequest) (*userspb.UserReport, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("ResolveReport").Inc()
+
+	moderatorID, err := h.moderatorID(ctx, "ResolveReport")
+	if err != nil {
+		return nil, err
+	}
+
+	reportID, err := uuidx.Parse(request.GetReportId())
+	if err != nil {
+		return nil, err
+	}
+
+	action, ok := report.ActionFromGRPCEnum(request.GetAction())
+	if !ok {
+		return nil, apperrors.BadRequest(report.ErrInvalidAction)
+	}
+
+	res, err := h.service.AdminResolveReport(ctx, reportID, moderatorID, action)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) DismissReport(ctx context.Context, request *userspb.DismissReportRequest) (*userspb.UserReport, error) {
+	defer metrics.AdminActionsTotalCounter.WithLabelValues("DismissReport").Inc()
+
+	moderatorID, err := h.moderatorID(ctx, "DismissReport")
+	if err != nil {
+		return nil, err
+	}
+
+	reportID, err := uuidx.Parse(request.GetReportId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.AdminDismissReport(ctx, reportID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// moderatorID checks that the caller is an admin and returns their id, reports are claimed and closed in their name.
+func (h *Handler) moderatorID(ctx context.Context, action string) (uuid.UUID, error) {
+	err := h.jwt.ValidateRoleWithContext(ctx, string(jwt.Admin))
+	if err != nil {
+		metrics.AdminForbittenActionsTotalCounter.WithLabelValues(action, err.Error()).Inc()
+		return uuid.Nil, err
+	}
+
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuidx.Parse(claims.UserID)
+}
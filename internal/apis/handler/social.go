@@ -7,6 +7,8 @@ import (
 	"github.com/QuizWars-Ecosystem/go-common/pkg/uuidx"
 
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -102,6 +104,10 @@ func (h *Handler) ListFriends(ctx context.Context, request *userspb.ListFriendsR
 		return nil, err
 	}
 
+	if h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId()) == nil {
+		h.service.AttachPresence(ctx, res)
+	}
+
 	friends := make([]*userspb.Friend, len(res))
 	for i, f := range res {
 		var friend *userspb.Friend
@@ -119,6 +125,78 @@ func (h *Handler) ListFriends(ctx context.Context, request *userspb.ListFriendsR
 	}, nil
 }
 
+func (h *Handler) ListAcceptedFriends(ctx context.Context, request *userspb.ListAcceptedFriendsRequest) (*userspb.FriendsPage, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.ListFriends](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListFriends(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListIncomingFriendRequests(ctx context.Context, request *userspb.ListIncomingFriendRequestsRequest) (*userspb.FriendsPage, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.ListIncomingFriendRequests](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListFriends(ctx, &req.ListFriends)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) ListOutgoingFriendRequests(ctx context.Context, request *userspb.ListOutgoingFriendRequestsRequest) (*userspb.FriendsPage, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.ListOutgoingFriendRequests](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListFriends(ctx, &req.ListFriends)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (h *Handler) BlockFriend(ctx context.Context, request *userspb.BlockFriendRequest) (*emptypb.Empty, error) {
 	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
 	if err != nil {
@@ -166,3 +244,162 @@ func (h *Handler) UnblockFriend(ctx context.Context, request *userspb.UnblockFri
 
 	return Empty, nil
 }
+
+func (h *Handler) ListBlockedUsers(ctx context.Context, request *userspb.ListBlockedUsersRequest) (*userspb.ListBlockedUsersResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) SuggestFriends(ctx context.Context, request *userspb.SuggestFriendsRequest) (*userspb.SuggestFriendsResponse, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.SuggestFriends](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.SuggestFriends(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) GetMutualFriends(ctx context.Context, request *userspb.GetMutualFriendsRequest) (*userspb.GetMutualFriendsResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.GetMutualFriends](request)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ViewerID = viewerID(claims.UserID)
+
+	res, err := h.service.GetMutualFriends(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) SearchPlayers(ctx context.Context, request *userspb.SearchPlayersRequest) (*userspb.SearchPlayersResponse, error) {
+	claims, err := h.jwt.ValidateTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := abstractions.MakeRequest[profile.SearchPlayers](request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.service.SearchPlayers(ctx, viewerID(claims.UserID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := abstractions.MakeResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Handler) Heartbeat(ctx context.Context, request *userspb.HeartbeatRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.Heartbeat(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) UpdatePresence(ctx context.Context, request *userspb.UpdatePresenceRequest) (*emptypb.Empty, error) {
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.service.UpdatePresence(ctx, userID, profile.PresenceStatusFromGRPCEnum(request.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+
+	return Empty, nil
+}
+
+func (h *Handler) SubscribeFriendsPresence(request *userspb.SubscribeFriendsPresenceRequest, stream grpc.ServerStreamingServer[userspb.Presence]) error {
+	ctx := stream.Context()
+
+	err := h.jwt.ValidateUserIDWithContext(ctx, request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	userID, err := uuidx.Parse(request.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	return h.service.WatchFriendsPresence(ctx, userID, func(p *profile.Presence) error {
+		res, resErr := abstractions.MakeResponse(p)
+		if resErr != nil {
+			return resErr
+		}
+
+		return stream.Send(res)
+	})
+}
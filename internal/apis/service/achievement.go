@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// ListAchievements returns the whole catalogue with the progress of the user. The progress follows the
+// privacy setting of the profile: friends only progress is visible to accepted friends.
+func (s *Service) ListAchievements(ctx context.Context, viewerID, userID uuid.UUID) (*profile.Achievements, error) {
+	if err := s.checkVisible(ctx, viewerID, userID, profile.ErrAchievementsHidden); err != nil {
+		return nil, err
+	}
+
+	progress, err := s.store.GetAchievementProgress(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	awarded, err := s.awardedAt(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogue := s.achievements.Catalogue()
+	result := &profile.Achievements{Achievements: make([]*profile.Achievement, len(catalogue))}
+
+	for i, definition := range catalogue {
+		result.Achievements[i] = &profile.Achievement{
+			Definition: definition,
+			Progress:   progress[definition.Metric],
+			AwardedAt:  awarded[definition.Code],
+		}
+	}
+
+	return result, nil
+}
+
+// GetEarnedAchievements returns achievements the user holds, codes removed from the catalogue are left out.
+func (s *Service) GetEarnedAchievements(ctx context.Context, userID uuid.UUID) ([]*profile.Achievement, error) {
+	awarded, err := s.awardedAt(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	earned := make([]*profile.Achievement, 0, len(awarded))
+
+	for _, definition := range s.achievements.Catalogue() {
+		if awardedAt, ok := awarded[definition.Code]; ok {
+			earned = append(earned, &profile.Achievement{
+				Definition: definition,
+				Progress:   definition.Threshold,
+				AwardedAt:  awardedAt,
+			})
+		}
+	}
+
+	return earned, nil
+}
+
+// evaluateAchievements awards achievements the users reached after the event.
+func (s *Service) evaluateAchievements(ctx context.Context, event profile.AchievementEvent, userIDs ...uuid.UUID) {
+	if !s.achievements.Affected(event) {
+		return
+	}
+
+	for _, userID := range userIDs {
+		progress, err := s.store.GetAchievementProgress(ctx, userID)
+		if err != nil {
+			s.logger.Warn("failed to load achievement progress", zap.String("user_id", userID.String()), zap.Error(err))
+			continue
+		}
+
+		codes := s.achievements.Evaluate(event, progress)
+		if len(codes) == 0 {
+			continue
+		}
+
+		awarded, err := s.store.AwardAchievements(ctx, userID, codes)
+		if err != nil {
+			s.logger.Warn("failed to award achievements", zap.String("user_id", userID.String()), zap.Error(err))
+			continue
+		}
+
+		notifications := make([]*profile.Notification, len(awarded))
+
+		for i, achievement := range awarded {
+			metrics.AchievementsAwardedTotalCounter.WithLabelValues(achievement.Code).Inc()
+			s.logger.Info("achievement awarded", zap.String("user_id", userID.String()), zap.String("code", achievement.Code))
+
+			code := achievement.Code
+			notifications[i] = &profile.Notification{UserID: userID, Kind: profile.NotificationAchievementAwarded, AchievementCode: &code}
+		}
+
+		s.notify(ctx, notifications...)
+	}
+}
+
+// recordLogin counts the day towards login achievements of the user.
+func (s *Service) recordLogin(ctx context.Context, userID uuid.UUID) {
+	if err := s.store.RecordLoginDay(ctx, userID, time.Now().UTC()); err != nil {
+		s.logger.Warn("failed to record login day", zap.String("user_id", userID.String()), zap.Error(err))
+		return
+	}
+
+	s.evaluateAchievements(ctx, profile.EventLogin, userID)
+}
+
+func (s *Service) awardedAt(ctx context.Context, userID uuid.UUID) (map[string]*time.Time, error) {
+	achievements, err := s.store.GetUserAchievements(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	awarded := make(map[string]*time.Time, len(achievements))
+	for _, achievement := range achievements {
+		awarded[achievement.Code] = &achievement.AwardedAt
+	}
+
+	return awarded, nil
+}
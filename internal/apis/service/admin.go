@@ -70,6 +70,8 @@ func (s *Service) AdminBanUserByID(ctx context.Context, userID uuid.UUID) error
 		return err
 	}
 
+	s.syncLeaderboard(ctx, userID)
+
 	s.logger.Info("admin banned user", zap.String("user_id", userID.String()))
 
 	return nil
@@ -81,7 +83,25 @@ func (s *Service) AdminUnbanUserByID(ctx context.Context, userID uuid.UUID) erro
 		return err
 	}
 
+	s.syncLeaderboard(ctx, userID)
+
 	s.logger.Info("admin unbanned user", zap.String("user_id", userID.String()))
 
 	return nil
 }
+
+func (s *Service) AdminExportUserData(ctx context.Context, userID uuid.UUID) (*profile.DataExport, error) {
+	user, err := s.store.AdminGetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := s.exportData(ctx, user.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("admin exported user data", zap.String("user_id", userID.String()))
+
+	return export, nil
+}
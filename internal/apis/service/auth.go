@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/auth"
@@ -12,6 +14,10 @@ import (
 )
 
 func (s *Service) Register(ctx context.Context, credits *auth.ProfileWithCredentials) (*profile.Profile, error) {
+	if _, ok := s.shop.Catalogue().Avatar(credits.Profile.User.AvatarID); ok {
+		return nil, apperrors.Forbidden(profile.ErrAvatarNotOwned)
+	}
+
 	passHash, err := bcrypt.GenerateFromPassword([]byte(credits.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, apperrors.Internal(err)
@@ -19,11 +25,20 @@ func (s *Service) Register(ctx context.Context, credits *auth.ProfileWithCredent
 
 	credits.Password = string(passHash)
 
+	curve, err := s.levelCurve()
+	if err != nil {
+		return nil, err
+	}
+
+	credits.Profile.User.LevelProgress = curve.Start()
+
 	prof, err := s.store.SaveProfile(ctx, credits)
 	if err != nil {
 		return nil, err
 	}
 
+	s.syncLeaderboard(ctx, prof.User.ID)
+
 	return prof, nil
 }
 
@@ -33,11 +48,11 @@ func (s *Service) LoginByUsername(ctx context.Context, username, password string
 		return nil, err
 	}
 
-	if err = bcrypt.CompareHashAndPassword([]byte(prof.Password), []byte(password)); err != nil {
-		return nil, apperrors.UnauthorizedHidden(err, "wrong credentials")
+	if !s.restorable(prof) {
+		return nil, apperrors.NotFound("user", "username", username)
 	}
 
-	return prof, nil
+	return s.login(ctx, prof, password)
 }
 
 func (s *Service) LoginByEmail(ctx context.Context, email, password string) (*auth.ProfileWithCredentials, error) {
@@ -46,11 +61,11 @@ func (s *Service) LoginByEmail(ctx context.Context, email, password string) (*au
 		return nil, err
 	}
 
-	if err = bcrypt.CompareHashAndPassword([]byte(prof.Password), []byte(password)); err != nil {
-		return nil, apperrors.UnauthorizedHidden(err, "wrong credentials")
+	if !s.restorable(prof) {
+		return nil, apperrors.NotFound("user", "email", email)
 	}
 
-	return prof, nil
+	return s.login(ctx, prof, password)
 }
 
 func (s *Service) Logout(ctx context.Context, userID uuid.UUID) error {
@@ -61,3 +76,33 @@ func (s *Service) Logout(ctx context.Context, userID uuid.UUID) error {
 
 	return nil
 }
+
+// login checks the password and restores an account that is still within
+// its deletion grace period.
+func (s *Service) login(ctx context.Context, prof *auth.ProfileWithCredentials, password string) (*auth.ProfileWithCredentials, error) {
+	if err := bcrypt.CompareHashAndPassword([]byte(prof.Password), []byte(password)); err != nil {
+		return nil, apperrors.UnauthorizedHidden(err, "wrong credentials")
+	}
+
+	if prof.DeletedAt != nil {
+		if err := s.store.RestoreProfile(ctx, prof.Profile.User.ID); err != nil {
+			return nil, err
+		}
+
+		prof.DeletedAt = nil
+		s.syncLeaderboard(ctx, prof.Profile.User.ID)
+		s.logger.Info("deleted user restored by login", zap.String("user_id", prof.Profile.User.ID.String()))
+	}
+
+	s.recordLogin(ctx, prof.Profile.User.ID)
+
+	return prof, nil
+}
+
+func (s *Service) restorable(prof *auth.ProfileWithCredentials) bool {
+	if prof.DeletedAt == nil {
+		return true
+	}
+
+	return time.Since(*prof.DeletedAt) < s.deletionGracePeriod()
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Service) ReportAnswerResults(ctx context.Context, results *profile.AnswerResults) error {
+	return s.store.AddAnswerResults(ctx, results)
+}
+
+// GetCategoryStats returns the stats of the user per category, names come from the
+// questions service and are left out when it cannot be reached. The stats follow the
+// privacy setting of the profile: friends only stats are visible to accepted friends.
+func (s *Service) GetCategoryStats(ctx context.Context, viewerID, userID uuid.UUID) (*profile.CategoryStatsList, error) {
+	if err := s.checkVisible(ctx, viewerID, userID, profile.ErrCategoryStatsHidden); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.store.GetCategoryStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stats) == 0 {
+		return &profile.CategoryStatsList{}, nil
+	}
+
+	names := s.categories.Names(ctx)
+	for _, category := range stats {
+		if name, ok := names[category.CategoryID]; ok {
+			category.Name = &name
+		}
+	}
+
+	return &profile.CategoryStatsList{Stats: stats}, nil
+}
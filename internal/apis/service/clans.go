@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/clan"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Service) CreateClan(ctx context.Context, request *clan.CreateClan) (*clan.Clan, error) {
+	if clan.ContainsBlockedWord(request.Tag, s.clanBlockedWords()) || clan.ContainsBlockedWord(request.Name, s.clanBlockedWords()) {
+		return nil, apperrors.BadRequest(clan.ErrNotAllowed)
+	}
+
+	return s.store.CreateClan(ctx, request)
+}
+
+func (s *Service) GetClan(ctx context.Context, viewerID, clanID uuid.UUID) (*clan.ClanWithMembers, error) {
+	c, err := s.store.GetClan(ctx, clanID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetClanMembers(ctx, clanID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*profile.User, len(members))
+	for i, member := range members {
+		users[i] = member.User
+	}
+
+	s.redact(ctx, viewerID, users...)
+
+	return &clan.ClanWithMembers{Clan: c, Members: members}, nil
+}
+
+func (s *Service) InviteToClan(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error {
+	if actorID == inviteeID {
+		return apperrors.BadRequest(clan.ErrSelfAction)
+	}
+
+	return s.store.InviteToClan(ctx, clanID, actorID, inviteeID)
+}
+
+// JoinClan accepts the invite of the user or sends a join request when the user was not invited.
+func (s *Service) JoinClan(ctx context.Context, clanID, userID uuid.UUID) (*clan.JoinResult, error) {
+	joined, err := s.store.JoinClan(ctx, clanID, userID, s.clanMaxMembers())
+	if err != nil {
+		return nil, err
+	}
+
+	return &clan.JoinResult{Joined: joined}, nil
+}
+
+// ListClanJoinRequests lists the pending join requests, only officers and the owner can see them.
+func (s *Service) ListClanJoinRequests(ctx context.Context, clanID, actorID uuid.UUID) (*clan.JoinRequests, error) {
+	role, err := s.store.GetClanRole(ctx, clanID, actorID)
+	switch {
+	case err != nil:
+		return nil, err
+	case !role.CanManage():
+		return nil, apperrors.Forbidden(clan.ErrNotOfficer)
+	}
+
+	requests, err := s.store.ListClanJoinRequests(ctx, clanID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*profile.User, len(requests))
+	for i, request := range requests {
+		users[i] = request.User
+	}
+
+	s.redact(ctx, actorID, users...)
+
+	return &clan.JoinRequests{Requests: requests}, nil
+}
+
+func (s *Service) AcceptClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID) error {
+	return s.store.AcceptClanJoinRequest(ctx, clanID, actorID, requesterID, s.clanMaxMembers())
+}
+
+func (s *Service) DeclineClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID) error {
+	return s.store.DeclineClanJoinRequest(ctx, clanID, actorID, requesterID)
+}
+
+func (s *Service) CancelClanJoinRequest(ctx context.Context, clanID, userID uuid.UUID) error {
+	return s.store.CancelClanJoinRequest(ctx, clanID, userID)
+}
+
+func (s *Service) DeclineClanInvite(ctx context.Context, clanID, userID uuid.UUID) error {
+	return s.store.DeclineClanInvite(ctx, clanID, userID)
+}
+
+func (s *Service) CancelClanInvite(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error {
+	return s.store.CancelClanInvite(ctx, clanID, actorID, inviteeID)
+}
+
+func (s *Service) LeaveClan(ctx context.Context, clanID, userID uuid.UUID) error {
+	return s.store.LeaveClan(ctx, clanID, userID)
+}
+
+func (s *Service) KickClanMember(ctx context.Context, clanID, actorID, memberID uuid.UUID) error {
+	if actorID == memberID {
+		return apperrors.BadRequest(clan.ErrSelfAction)
+	}
+
+	return s.store.KickClanMember(ctx, clanID, actorID, memberID)
+}
+
+func (s *Service) SetClanMemberRole(ctx context.Context, request *clan.SetMemberRole) error {
+	if request.ActorID == request.MemberID {
+		return apperrors.BadRequest(clan.ErrSelfAction)
+	}
+
+	return s.store.SetClanMemberRole(ctx, request)
+}
+
+func (s *Service) TransferClanOwnership(ctx context.Context, clanID, ownerID, newOwnerID uuid.UUID) error {
+	if ownerID == newOwnerID {
+		return apperrors.BadRequest(clan.ErrSelfAction)
+	}
+
+	return s.store.TransferClanOwnership(ctx, clanID, ownerID, newOwnerID)
+}
+
+func (s *Service) clanMaxMembers() int64 {
+	if s.cfg.Clans == nil || s.cfg.Clans.MaxMembers <= 0 {
+		return clan.DefaultMaxMembers
+	}
+
+	return s.cfg.Clans.MaxMembers
+}
+
+func (s *Service) clanBlockedWords() []string {
+	if s.cfg.Clans == nil {
+		return nil
+	}
+
+	return s.cfg.Clans.BlockedWords
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Service) CreditCoins(ctx context.Context, op *profile.CoinOperation) (*profile.CoinTransaction, error) {
+	transaction, err := s.store.ApplyCoinOperation(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.CoinsTransactionsTotalCounter.WithLabelValues("credit").Inc()
+
+	return transaction, nil
+}
+
+func (s *Service) DebitCoins(ctx context.Context, op *profile.CoinOperation) (*profile.CoinTransaction, error) {
+	transaction, err := s.store.ApplyCoinOperation(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.CoinsTransactionsTotalCounter.WithLabelValues("debit").Inc()
+
+	return transaction, nil
+}
+
+func (s *Service) ListCoinTransactions(ctx context.Context, filter *profile.ListCoinTransactions) (*profile.CoinTransactionsPage, error) {
+	page, err := s.store.ListCoinTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (s *Service) AdminReconcileCoins(ctx context.Context, limit uint64) (*profile.CoinsReconciliation, error) {
+	if limit == 0 {
+		limit = s.reconcileBatchSize()
+	}
+
+	mismatches, err := s.store.FindCoinsMismatches(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile.CoinsReconciliation{Mismatches: mismatches}, nil
+}
+
+// CheckCoinsLedger reports users whose balance does not match the ledger.
+func (s *Service) CheckCoinsLedger(ctx context.Context) error {
+	mismatches, err := s.store.FindCoinsMismatches(ctx, s.reconcileBatchSize())
+	if err != nil {
+		return err
+	}
+
+	metrics.CoinsLedgerMismatchesGauge.Set(float64(len(mismatches)))
+
+	for _, m := range mismatches {
+		s.logger.Error("coins balance does not match ledger",
+			zap.String("user_id", m.UserID.String()),
+			zap.Int64("balance", m.Balance),
+			zap.Int64("ledger_sum", m.LedgerSum),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) reconcileBatchSize() uint64 {
+	if s.cfg.Coins == nil || s.cfg.Coins.ReconcileBatchSize == 0 {
+		return profile.DefaultReconcileBatchSize
+	}
+
+	return s.cfg.Coins.ReconcileBatchSize
+}
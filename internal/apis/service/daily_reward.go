@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// ClaimDailyReward pays the reward of the streak day from the configured table.
+func (s *Service) ClaimDailyReward(ctx context.Context, userID uuid.UUID) (*profile.DailyRewardClaim, error) {
+	claim, err := s.store.ClaimDailyReward(ctx, userID, time.Now(), s.dailyRewardTable())
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.DailyRewardsClaimedTotalCounter.Inc()
+
+	if claim.Coins > 0 {
+		metrics.CoinsTransactionsTotalCounter.WithLabelValues("credit").Inc()
+	}
+
+	return claim, nil
+}
+
+// dailyRewardTable returns the configured reward table, claims still advance the streak but pay nothing
+// when the table is not configured.
+func (s *Service) dailyRewardTable() profile.DailyRewardTable {
+	if s.cfg.DailyRewards == nil {
+		return nil
+	}
+
+	table := make(profile.DailyRewardTable, len(s.cfg.DailyRewards.Rewards))
+	for i, reward := range s.cfg.DailyRewards.Rewards {
+		table[i] = profile.DailyReward{
+			Coins:         reward.Coins,
+			StreakFreezes: reward.StreakFreezes,
+		}
+	}
+
+	return table
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// GetLeaderboard returns a slice of the global or a country ranking. The Redis board is used when
+// available, the ranking is computed by the database otherwise.
+func (s *Service) GetLeaderboard(ctx context.Context, viewerID uuid.UUID, filter *profile.LeaderboardFilter) (*profile.Leaderboard, error) {
+	entries, err := s.leaderboardEntries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.redactEntries(ctx, viewerID, entries)
+
+	return &profile.Leaderboard{Entries: entries}, nil
+}
+
+// GetLeaderboardAroundUser returns up to radius players ranked above and below the user in the global ranking.
+func (s *Service) GetLeaderboardAroundUser(ctx context.Context, viewerID, userID uuid.UUID, radius uint64) (*profile.Leaderboard, error) {
+	rank, err := s.leaderboardRank(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetLeaderboard(ctx, viewerID, profile.NewLeaderboardWindow(rank, radius))
+}
+
+// GetFriendsLeaderboard ranks the user among accepted friends.
+func (s *Service) GetFriendsLeaderboard(ctx context.Context, viewerID, userID uuid.UUID) (*profile.Leaderboard, error) {
+	entries, err := s.store.GetFriendsLeaderboard(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.redactEntries(ctx, viewerID, entries)
+
+	return &profile.Leaderboard{Entries: entries}, nil
+}
+
+// RebuildLeaderboards replaces the Redis board with the current standings,
+// repairing updates that were lost while Redis was unavailable.
+func (s *Service) RebuildLeaderboards(ctx context.Context) error {
+	if s.board == nil {
+		return nil
+	}
+
+	users, err := s.store.GetLeaderboardStandings(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.board.Rebuild(ctx, users); err != nil {
+		return err
+	}
+
+	s.logger.Info("leaderboards rebuilt", zap.Int("players", len(users)))
+
+	return nil
+}
+
+func (s *Service) leaderboardEntries(ctx context.Context, filter *profile.LeaderboardFilter) ([]*profile.LeaderboardEntry, error) {
+	if s.board == nil {
+		return s.store.GetLeaderboard(ctx, filter)
+	}
+
+	ranked, err := s.board.Range(ctx, filter.Country, filter.Offset, filter.Limit)
+	if err != nil {
+		s.logger.Warn("failed to read leaderboard, falling back to database", zap.Error(err))
+		return s.store.GetLeaderboard(ctx, filter)
+	}
+
+	if len(ranked) == 0 {
+		return ranked, nil
+	}
+
+	ids := make([]uuid.UUID, len(ranked))
+	for i, entry := range ranked {
+		ids[i] = entry.User.ID
+	}
+
+	users, err := s.store.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[uuid.UUID]*profile.User, len(users))
+	for _, user := range users {
+		found[user.ID] = user
+	}
+
+	entries := make([]*profile.LeaderboardEntry, 0, len(ranked))
+	for _, entry := range ranked {
+		// Users removed since the board was updated are skipped until the next sync.
+		if user, ok := found[entry.User.ID]; ok {
+			entries = append(entries, &profile.LeaderboardEntry{Rank: entry.Rank, User: user})
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *Service) leaderboardRank(ctx context.Context, userID uuid.UUID) (uint64, error) {
+	if s.board != nil {
+		rank, err := s.board.Rank(ctx, nil, userID)
+		if err == nil {
+			return rank, nil
+		}
+
+		s.logger.Warn("failed to read leaderboard rank, falling back to database", zap.Error(err))
+	}
+
+	return s.store.GetLeaderboardRank(ctx, userID, nil)
+}
+
+// syncLeaderboard puts the users on the board with their current rating and country, inactive users are removed.
+func (s *Service) syncLeaderboard(ctx context.Context, userIDs ...uuid.UUID) {
+	if s.board == nil || len(userIDs) == 0 {
+		return
+	}
+
+	users, err := s.store.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		s.logger.Warn("failed to load users for leaderboard sync", zap.Error(err))
+		return
+	}
+
+	active := make(map[uuid.UUID]struct{}, len(users))
+	for _, user := range users {
+		active[user.ID] = struct{}{}
+	}
+
+	var inactive []uuid.UUID
+	for _, id := range userIDs {
+		if _, ok := active[id]; !ok {
+			inactive = append(inactive, id)
+		}
+	}
+
+	if err = s.board.Set(ctx, users...); err != nil {
+		s.logger.Warn("failed to update leaderboard", zap.Error(err))
+	}
+
+	if err = s.board.Remove(ctx, inactive...); err != nil {
+		s.logger.Warn("failed to remove users from leaderboard", zap.Error(err))
+	}
+}
+
+func (s *Service) redactEntries(ctx context.Context, viewerID uuid.UUID, entries []*profile.LeaderboardEntry) {
+	users := make([]*profile.User, len(entries))
+	for i, entry := range entries {
+		users[i] = entry.User
+	}
+
+	s.redact(ctx, viewerID, users...)
+}
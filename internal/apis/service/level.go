@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// GrantXP awards XP for an event of a trusted service. Levels gained by the grant are
+// rewarded with coins in the same transaction and announced once with a level up notification,
+// replays announce nothing.
+func (s *Service) GrantXP(ctx context.Context, grant *profile.GrantXP) (*profile.XPGrant, error) {
+	curve, err := s.levelCurve()
+	if err != nil {
+		return nil, err
+	}
+
+	var coinsPerLevel int64
+	if s.cfg.Levels != nil {
+		coinsPerLevel = s.cfg.Levels.CoinsPerLevel
+	}
+
+	result, err := s.store.GrantXP(ctx, grant, curve, coinsPerLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Replayed || result.LevelAfter == result.LevelBefore {
+		return result, nil
+	}
+
+	metrics.LevelUpsTotalCounter.Add(float64(result.LevelAfter - result.LevelBefore))
+
+	if result.CoinsRewarded > 0 {
+		metrics.CoinsTransactionsTotalCounter.WithLabelValues("credit").Inc()
+	}
+
+	s.logger.Info("user leveled up",
+		zap.String("user_id", grant.UserID.String()),
+		zap.Int32("level_before", result.LevelBefore),
+		zap.Int32("level_after", result.LevelAfter),
+		zap.Int64("coins_rewarded", result.CoinsRewarded),
+	)
+
+	level := result.LevelAfter
+	s.notify(ctx, &profile.Notification{UserID: grant.UserID, Kind: profile.NotificationLevelUp, Level: &level})
+
+	s.evaluateAchievements(ctx, profile.EventLevelUp, grant.UserID)
+
+	return result, nil
+}
+
+// levelCurve builds the configured level curve, the default curve is used when levels are not configured.
+func (s *Service) levelCurve() (profile.LevelCurve, error) {
+	var (
+		baseXP   int64
+		growth   float64
+		maxLevel int32
+	)
+
+	if s.cfg.Levels != nil {
+		baseXP, growth, maxLevel = s.cfg.Levels.BaseXP, s.cfg.Levels.Growth, s.cfg.Levels.MaxLevel
+	}
+
+	curve, err := profile.NewLevelCurve(baseXP, growth, maxLevel)
+	if err != nil {
+		return profile.LevelCurve{}, apperrors.Internal(err)
+	}
+
+	return curve, nil
+}
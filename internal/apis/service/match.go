@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Service) RecordMatch(ctx context.Context, match *profile.MatchRecord) error {
+	return s.store.RecordMatch(ctx, match)
+}
+
+// ListMatchHistory returns a page of the matches of the user, newest first. The history follows
+// the privacy setting of the profile: friends only histories are visible to accepted friends.
+func (s *Service) ListMatchHistory(ctx context.Context, viewerID uuid.UUID, filter *profile.ListMatchHistory) (*profile.MatchHistoryPage, error) {
+	user, err := s.store.GetUserByID(ctx, filter.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner := viewerID == filter.UserID
+
+	var isFriend bool
+	if user.Privacy == profile.Friends && !isOwner && viewerID != uuid.Nil {
+		if isFriend, err = s.store.AreFriends(ctx, viewerID, filter.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if !profile.CanViewMatchHistory(user.Privacy, isOwner, isFriend) {
+		return nil, apperrors.Forbidden(profile.ErrMatchHistoryHidden)
+	}
+
+	return s.store.ListMatchHistory(ctx, filter)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const (
+	// notificationBacklogSize caps the unread notifications replayed when a stream is opened.
+	notificationBacklogSize = 50
+
+	defaultNotificationPurgeBatchSize = 1000
+)
+
+func (s *Service) ListNotifications(ctx context.Context, filter *profile.ListNotifications) (*profile.NotificationsPage, error) {
+	return s.store.ListNotifications(ctx, filter)
+}
+
+func (s *Service) MarkRead(ctx context.Context, request *profile.MarkRead) (*profile.UnreadCount, error) {
+	unread, err := s.store.MarkNotificationsRead(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile.UnreadCount{Count: unread}, nil
+}
+
+// WatchNotifications sends the unread notifications of the user, oldest first, and then every new one
+// until ctx is done. Unread notifications beyond the backlog and read ones are listed with ListNotifications.
+func (s *Service) WatchNotifications(ctx context.Context, userID uuid.UUID, send func(*profile.Notification) error) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribing before reading the backlog makes sure no notification between the two is missed.
+	updates, err := s.broker.Subscribe(watchCtx, userID)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	backlog, err := s.store.ListNotifications(ctx, &profile.ListNotifications{
+		UserID:     userID,
+		Size:       notificationBacklogSize,
+		UnreadOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	sent := make(map[uuid.UUID]struct{}, len(backlog.Notifications))
+
+	for _, n := range slices.Backward(backlog.Notifications) {
+		sent[n.ID] = struct{}{}
+
+		if err = send(n); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			if _, ok = sent[n.ID]; ok {
+				continue
+			}
+
+			if err = send(n); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PurgeNotifications deletes notifications older than the retention batch by batch.
+func (s *Service) PurgeNotifications(ctx context.Context) error {
+	cfg := s.cfg.Notifications
+	if cfg == nil || cfg.Retention <= 0 {
+		return nil
+	}
+
+	batchSize := cfg.PurgeBatchSize
+	if batchSize == 0 {
+		batchSize = defaultNotificationPurgeBatchSize
+	}
+
+	createdBefore := time.Now().Add(-cfg.Retention)
+
+	var total int
+
+	for ctx.Err() == nil {
+		purged, err := s.store.PurgeNotifications(ctx, createdBefore, batchSize)
+		if err != nil {
+			return err
+		}
+
+		total += purged
+
+		if uint64(purged) < batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		metrics.NotificationsPurgedTotalCounter.Add(float64(total))
+		s.logger.Info("expired notifications purged", zap.Int("amount", total))
+	}
+
+	return nil
+}
+
+// notify stores the notifications and publishes them to the streams of their recipients.
+// It is best effort, failures are logged and do not fail the action that caused the notifications.
+func (s *Service) notify(ctx context.Context, notifications ...*profile.Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	if err := s.store.CreateNotifications(ctx, notifications); err != nil {
+		s.logger.Warn("failed to store notifications", zap.Int("amount", len(notifications)), zap.Error(err))
+		return
+	}
+
+	for _, n := range notifications {
+		if err := s.broker.Publish(ctx, n); err != nil {
+			s.logger.Warn("failed to publish notification",
+				zap.String("user_id", n.UserID.String()),
+				zap.String("kind", n.Kind.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
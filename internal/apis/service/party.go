@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/internal/party"
+)
+
+func (s *Service) CreateParty(ctx context.Context, userID uuid.UUID) (*profile.Party, error) {
+	p := &profile.Party{
+		ID:        uuid.New(),
+		LeaderID:  userID,
+		MemberIDs: []uuid.UUID{userID},
+		Event:     profile.PartyCreated,
+		Version:   1,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if err := s.parties.Create(ctx, p); err != nil {
+		return nil, partyError(err, p.ID, userID)
+	}
+
+	return p, nil
+}
+
+// GetParty returns the party the user is a member of.
+func (s *Service) GetParty(ctx context.Context, userID uuid.UUID) (*profile.Party, error) {
+	partyID, ok, err := s.parties.PartyOf(ctx, userID)
+	switch {
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	case !ok:
+		return nil, apperrors.NotFound("party member", "user_id", userID)
+	}
+
+	p, err := s.parties.Get(ctx, partyID)
+	if err != nil {
+		return nil, partyError(err, partyID, userID)
+	}
+
+	return p, nil
+}
+
+// InviteToParty invites an accepted friend of the leader, the friend joins by accepting the invite.
+func (s *Service) InviteToParty(ctx context.Context, partyID, userID, friendID uuid.UUID) (*profile.Party, error) {
+	if userID == friendID {
+		return nil, apperrors.BadRequest(errors.New("cannot invite yourself"))
+	}
+
+	friends, err := s.store.AreFriends(ctx, userID, friendID)
+	switch {
+	case err != nil:
+		return nil, err
+	case !friends:
+		return nil, apperrors.Forbidden(profile.ErrNotFriends)
+	}
+
+	invited, err := s.updateParty(ctx, partyID, userID, func(p *profile.Party) error {
+		switch {
+		case p.LeaderID != userID:
+			return apperrors.Forbidden(profile.ErrNotPartyLeader)
+		case p.IsMember(friendID):
+			return apperrors.AlreadyExists("party member", "user_id", friendID)
+		case p.IsInvited(friendID):
+			return apperrors.AlreadyExists("party invite", "user_id", friendID)
+		}
+
+		p.InvitedIDs = append(p.InvitedIDs, friendID)
+		p.Event = profile.PartyInvited
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invite := profile.NewActorNotification(friendID, profile.NotificationPartyInvite, userID)
+	invite.PartyID = &partyID
+
+	s.notify(ctx, invite)
+
+	return invited, nil
+}
+
+func (s *Service) AcceptPartyInvite(ctx context.Context, partyID, userID uuid.UUID) (*profile.Party, error) {
+	return s.updateParty(ctx, partyID, userID, func(p *profile.Party) error {
+		switch {
+		case !p.RemoveInvite(userID):
+			return apperrors.NotFound("party invite", "user_id", userID)
+		case len(p.MemberIDs) >= s.partyMaxSize():
+			return apperrors.Forbidden(profile.ErrPartyFull)
+		}
+
+		p.MemberIDs = append(p.MemberIDs, userID)
+		p.Event = profile.PartyJoined
+
+		return nil
+	})
+}
+
+func (s *Service) DeclinePartyInvite(ctx context.Context, partyID, userID uuid.UUID) error {
+	_, err := s.updateParty(ctx, partyID, userID, func(p *profile.Party) error {
+		if !p.RemoveInvite(userID) {
+			return apperrors.NotFound("party invite", "user_id", userID)
+		}
+
+		p.Event = profile.PartyDeclined
+
+		return nil
+	})
+
+	return err
+}
+
+// LeaveParty removes the member, the leadership passes to the longest staying member
+// and the party is disbanded when the last member leaves.
+func (s *Service) LeaveParty(ctx context.Context, partyID, userID uuid.UUID) error {
+	_, err := s.updateParty(ctx, partyID, userID, func(p *profile.Party) error {
+		if !p.RemoveMember(userID) {
+			return apperrors.NotFound("party member", "user_id", userID)
+		}
+
+		p.Event = profile.PartyLeft
+		if p.Disbanded() {
+			p.Event = profile.PartyDisbanded
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (s *Service) PromotePartyLeader(ctx context.Context, partyID, userID, memberID uuid.UUID) (*profile.Party, error) {
+	return s.updateParty(ctx, partyID, userID, func(p *profile.Party) error {
+		switch {
+		case p.LeaderID != userID:
+			return apperrors.Forbidden(profile.ErrNotPartyLeader)
+		case !p.IsMember(memberID):
+			return apperrors.NotFound("party member", "user_id", memberID)
+		}
+
+		p.LeaderID = memberID
+		p.Event = profile.PartyLeaderChanged
+
+		return nil
+	})
+}
+
+// WatchParty sends the current state of the party to a member or an invited user and then every change,
+// until the party is disbanded, the user is no longer in it or ctx is done.
+func (s *Service) WatchParty(ctx context.Context, partyID, userID uuid.UUID, send func(*profile.Party) error) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribing before reading the party makes sure no change between the two is missed.
+	updates, err := s.parties.Subscribe(watchCtx, partyID)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	current, err := s.parties.Get(ctx, partyID)
+	if err != nil {
+		return partyError(err, partyID, userID)
+	}
+
+	if !current.IsMember(userID) && !current.IsInvited(userID) {
+		return apperrors.Forbidden(profile.ErrNotPartyMember)
+	}
+
+	if err = send(current); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case p, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			// An expired party carries no version, it is the last update either way.
+			if !p.Disbanded() && p.Version <= current.Version {
+				continue
+			}
+
+			current = p
+
+			if err = send(p); err != nil {
+				return err
+			}
+
+			if p.Disbanded() || (!p.IsMember(userID) && !p.IsInvited(userID)) {
+				return nil
+			}
+		}
+	}
+}
+
+// updateParty stamps the change made by fn, fn checks the rules against the current state of the party.
+func (s *Service) updateParty(ctx context.Context, partyID, userID uuid.UUID, fn func(p *profile.Party) error) (*profile.Party, error) {
+	p, err := s.parties.Update(ctx, partyID, func(p *profile.Party) error {
+		if fnErr := fn(p); fnErr != nil {
+			return fnErr
+		}
+
+		p.UpdatedAt = time.Now().UTC()
+
+		return nil
+	})
+	if err != nil {
+		return nil, partyError(err, partyID, userID)
+	}
+
+	return p, nil
+}
+
+func (s *Service) partyMaxSize() int {
+	if s.cfg.Parties == nil || s.cfg.Parties.MaxSize <= 0 {
+		return party.DefaultMaxSize
+	}
+
+	return s.cfg.Parties.MaxSize
+}
+
+// partyError maps errors of the registry, errors returned by the update functions are passed through.
+func partyError(err error, partyID, userID uuid.UUID) error {
+	switch {
+	case errors.Is(err, party.ErrNotFound):
+		return apperrors.NotFound("party", "id", partyID)
+	case errors.Is(err, party.ErrInAnotherParty):
+		return apperrors.AlreadyExists("party member", "user_id", userID)
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return apperrors.Internal(err)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/internal/presence"
+)
+
+func (s *Service) Heartbeat(ctx context.Context, userID uuid.UUID) error {
+	if err := s.presence.Touch(ctx, userID); err != nil {
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+func (s *Service) UpdatePresence(ctx context.Context, userID uuid.UUID, presenceStatus profile.PresenceStatus) error {
+	if presenceStatus == profile.PresenceUnknown {
+		return apperrors.BadRequest(errors.New("presence status not provided"))
+	}
+
+	if err := s.presence.Set(ctx, userID, presenceStatus); err != nil {
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+// AttachPresence fills presence of accepted friends, the list is returned without it when the tracker is unavailable.
+func (s *Service) AttachPresence(ctx context.Context, friends []*profile.Friend) {
+	ids := make([]uuid.UUID, 0, len(friends))
+	for _, f := range friends {
+		if f.Status == profile.Accepted {
+			ids = append(ids, f.User.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	presences, err := s.presence.Get(ctx, ids)
+	if err != nil {
+		s.logger.Warn("failed to load friends presence", zap.Error(err))
+		return
+	}
+
+	for _, f := range friends {
+		if f.Status != profile.Accepted {
+			continue
+		}
+
+		if p, ok := presences[f.User.ID]; ok {
+			f.Presence = p
+		} else {
+			f.Presence = profile.OfflinePresence(f.User.ID)
+		}
+	}
+}
+
+// WatchFriendsPresence sends the current presence of every accepted friend of the user and then
+// each change until ctx is done. The friend list is re-read before each change is sent and every
+// resync interval, which also reports friends whose presence expired since expirations are not published.
+func (s *Service) WatchFriendsPresence(ctx context.Context, userID uuid.UUID, send func(*profile.Presence) error) error {
+	ticker := time.NewTicker(s.presenceResyncInterval())
+	defer ticker.Stop()
+
+	known := make(map[uuid.UUID]profile.PresenceStatus)
+
+	var (
+		friendIDs []uuid.UUID
+		updates   <-chan *profile.Presence
+		cancel    context.CancelFunc = func() {}
+	)
+
+	defer func() { cancel() }()
+
+	for {
+		ids, err := s.acceptedFriendIDs(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if !sameIDs(friendIDs, ids) {
+			cancel()
+
+			updates, cancel, err = s.subscribePresence(ctx, ids)
+			if err != nil {
+				return err
+			}
+
+			friendIDs = ids
+		}
+
+		if err = s.syncPresence(ctx, friendIDs, known, send); err != nil {
+			return err
+		}
+
+	watch:
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				break watch
+			case p, ok := <-updates:
+				if !ok {
+					return nil
+				}
+
+				last, friend := known[p.UserID]
+				if !friend || last == p.Status {
+					continue
+				}
+
+				// A block or removal ends a friendship without a presence change, the list is re-read so
+				// the presence of a former friend is not sent once the friendship is gone.
+				if ids, err = s.acceptedFriendIDs(ctx, userID); err != nil {
+					return err
+				}
+
+				if !sameIDs(friendIDs, ids) {
+					break watch
+				}
+
+				known[p.UserID] = p.Status
+
+				if err = send(p); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// subscribePresence subscribes to presence changes of the users until the returned cancel is called.
+func (s *Service) subscribePresence(ctx context.Context, userIDs []uuid.UUID) (<-chan *profile.Presence, context.CancelFunc, error) {
+	watchCtx, cancel := context.WithCancel(ctx)
+
+	updates, err := s.presence.Subscribe(watchCtx, userIDs)
+	if err != nil {
+		cancel()
+		return nil, cancel, apperrors.Internal(err)
+	}
+
+	return updates, cancel, nil
+}
+
+// syncPresence sends presence of friends that changed since the last sync and forgets removed friends.
+func (s *Service) syncPresence(
+	ctx context.Context,
+	friendIDs []uuid.UUID,
+	known map[uuid.UUID]profile.PresenceStatus,
+	send func(*profile.Presence) error,
+) error {
+	presences, err := s.presence.Get(ctx, friendIDs)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	current := make(map[uuid.UUID]struct{}, len(friendIDs))
+
+	for _, id := range friendIDs {
+		current[id] = struct{}{}
+
+		p, ok := presences[id]
+		if !ok {
+			p = profile.OfflinePresence(id)
+		}
+
+		if last, seen := known[id]; seen && last == p.Status {
+			continue
+		}
+
+		known[id] = p.Status
+
+		if err = send(p); err != nil {
+			return err
+		}
+	}
+
+	for id := range known {
+		if _, ok := current[id]; !ok {
+			delete(known, id)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) acceptedFriendIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	friends, err := s.store.GetFriends(ctx, userID)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(friends))
+	for _, f := range friends {
+		if f.Status == profile.Accepted {
+			ids = append(ids, f.User.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *Service) presenceResyncInterval() time.Duration {
+	if s.cfg.Presence == nil || s.cfg.Presence.ResyncInterval <= 0 {
+		return presence.DefaultResyncInterval
+	}
+
+	return s.cfg.Presence.ResyncInterval
+}
+
+func sameIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[uuid.UUID]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
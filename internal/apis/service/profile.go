@@ -2,14 +2,22 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const MaxUsersBatchSize = 100
+
 func (s *Service) GetSelfProfile(ctx context.Context, userID uuid.UUID) (*profile.Profile, error) {
 	prof, err := s.store.GetProfile(ctx, userID)
 	if err != nil {
@@ -19,35 +27,141 @@ func (s *Service) GetSelfProfile(ctx context.Context, userID uuid.UUID) (*profil
 	return prof, nil
 }
 
-func (s *Service) GetProfileByID(ctx context.Context, userID uuid.UUID) (*profile.User, error) {
+func (s *Service) GetProfileByID(ctx context.Context, viewerID, userID uuid.UUID) (*profile.User, error) {
 	user, err := s.store.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	s.redact(ctx, viewerID, user)
+
 	return user, nil
 }
 
-func (s *Service) GetProfileByUsername(ctx context.Context, username string) (*profile.User, error) {
+func (s *Service) GetProfileByUsername(ctx context.Context, viewerID uuid.UUID, username string) (*profile.User, error) {
 	user, err := s.store.GetUserByUsername(ctx, username)
 	if err != nil {
 		return nil, err
 	}
 
+	s.redact(ctx, viewerID, user)
+
 	return user, nil
 }
 
+// redact hides activity details of the users the viewer may not see. Friends of the viewer are only
+// looked up when a user shares the details with friends, the details stay hidden when the lookup fails.
+func (s *Service) redact(ctx context.Context, viewerID uuid.UUID, users ...*profile.User) {
+	var friendIDs map[uuid.UUID]struct{}
+
+	for _, user := range users {
+		if friendIDs == nil && user.Privacy == profile.Friends && user.ID != viewerID {
+			friendIDs = s.friendSet(ctx, viewerID)
+		}
+
+		_, isFriend := friendIDs[user.ID]
+		user.Redact(viewerID, isFriend)
+	}
+}
+
+func (s *Service) friendSet(ctx context.Context, userID uuid.UUID) map[uuid.UUID]struct{} {
+	friends, err := s.store.GetFriends(ctx, userID)
+	if err != nil && status.Code(err) != codes.NotFound {
+		s.logger.Warn("failed to load friends of viewer", zap.String("user_id", userID.String()), zap.Error(err))
+	}
+
+	set := make(map[uuid.UUID]struct{}, len(friends))
+	for _, f := range friends {
+		if f.Status == profile.Accepted {
+			set[f.User.ID] = struct{}{}
+		}
+	}
+
+	return set
+}
+
+// checkVisible returns hidden as forbidden when the privacy setting of the user hides data from the viewer,
+// friends only data is visible to accepted friends.
+func (s *Service) checkVisible(ctx context.Context, viewerID, userID uuid.UUID, hidden error) error {
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	isOwner := viewerID == userID
+
+	var isFriend bool
+	if user.Privacy == profile.Friends && !isOwner && viewerID != uuid.Nil {
+		if isFriend, err = s.store.AreFriends(ctx, viewerID, userID); err != nil {
+			return err
+		}
+	}
+
+	if !user.Privacy.VisibleTo(isOwner, isFriend) {
+		return apperrors.Forbidden(hidden)
+	}
+
+	return nil
+}
+
+// GetUsersByIDs returns the users in the order of userIDs together with the ids
+// that do not belong to an active user.
+func (s *Service) GetUsersByIDs(ctx context.Context, viewerID uuid.UUID, userIDs []uuid.UUID) (*profile.UsersBatch, error) {
+	if len(userIDs) > MaxUsersBatchSize {
+		return nil, apperrors.BadRequest(fmt.Errorf("too many user ids: maximum is %d", MaxUsersBatchSize))
+	}
+
+	batch := &profile.UsersBatch{
+		Users: make([]*profile.User, 0, len(userIDs)),
+	}
+
+	if len(userIDs) == 0 {
+		return batch, nil
+	}
+
+	users, err := s.store.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	s.redact(ctx, viewerID, users...)
+
+	found := make(map[uuid.UUID]*profile.User, len(users))
+	for _, user := range users {
+		found[user.ID] = user
+	}
+
+	for _, id := range userIDs {
+		if user, ok := found[id]; ok {
+			batch.Users = append(batch.Users, user)
+		} else {
+			batch.MissingIDs = append(batch.MissingIDs, id)
+		}
+	}
+
+	return batch, nil
+}
+
 func (s *Service) UpdateProfile(ctx context.Context, userID uuid.UUID, req *profile.UpdateProfile) error {
 	err := s.store.UpdateProfile(ctx, userID, req)
 	if err != nil {
 		return err
 	}
 
+	if req.Country != nil {
+		s.syncLeaderboard(ctx, userID)
+	}
+
 	return nil
 }
 
 func (s *Service) UpdateProfileAvatar(ctx context.Context, userID uuid.UUID, avatarID int32) error {
-	err := s.store.UpdateProfileAvatar(ctx, userID, avatarID)
+	err := s.checkAvatarOwned(ctx, userID, avatarID)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.UpdateProfileAvatar(ctx, userID, avatarID)
 	if err != nil {
 		return err
 	}
@@ -75,5 +189,62 @@ func (s *Service) DeleteProfile(ctx context.Context, userID uuid.UUID) error {
 		return err
 	}
 
+	s.syncLeaderboard(ctx, userID)
+
 	return nil
 }
+
+func (s *Service) PurgeDeletedProfiles(ctx context.Context) error {
+	deletedBefore := time.Now().Add(-s.deletionGracePeriod())
+
+	purged, err := s.store.PurgeDeletedProfiles(ctx, deletedBefore, s.purgeBatchSize())
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		metrics.ProfilePurgedTotalCounter.Add(float64(purged))
+		s.logger.Info("deleted users purged", zap.Int("amount", purged))
+	}
+
+	return nil
+}
+
+// deletionGracePeriod returns how long deleted accounts are kept.
+func (s *Service) deletionGracePeriod() time.Duration {
+	if s.cfg.Account == nil || s.cfg.Account.DeletionGracePeriod <= 0 {
+		return profile.DefaultDeletionGracePeriod
+	}
+
+	return s.cfg.Account.DeletionGracePeriod
+}
+
+func (s *Service) purgeBatchSize() uint64 {
+	if s.cfg.Account == nil || s.cfg.Account.PurgeBatchSize == 0 {
+		return profile.DefaultPurgeBatchSize
+	}
+
+	return s.cfg.Account.PurgeBatchSize
+}
+
+func (s *Service) ExportProfileData(ctx context.Context, userID uuid.UUID) (*profile.DataExport, error) {
+	prof, err := s.store.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.exportData(ctx, prof)
+}
+
+func (s *Service) exportData(ctx context.Context, prof *profile.Profile) (*profile.DataExport, error) {
+	records, err := s.store.GetExportRecords(ctx, prof.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile.DataExport{
+		Profile:       prof,
+		ExportRecords: *records,
+		ExportedAt:    time.Now(),
+	}, nil
+}
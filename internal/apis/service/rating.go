@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/internal/rating"
+)
+
+func (s *Service) ReportMatchResult(ctx context.Context, result *profile.MatchResult) (*profile.MatchRatings, error) {
+	algorithm, eloK, glickoTau := rating.Elo, 0.0, 0.0
+	if s.cfg.Rating != nil {
+		eloK, glickoTau = s.cfg.Rating.EloK, s.cfg.Rating.GlickoTau
+
+		if s.cfg.Rating.Algorithm != "" {
+			algorithm = s.cfg.Rating.Algorithm
+		}
+	}
+
+	calculator, err := rating.NewCalculator(algorithm, eloK, glickoTau)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rate := func(participants []*profile.MatchParticipant, states []*profile.RatingState) []*profile.RatingChange {
+		players := make([]rating.Player, len(participants))
+		for i, p := range participants {
+			players[i] = rating.Player{
+				Rating:     float64(states[i].Rating),
+				Deviation:  states[i].Deviation,
+				Volatility: states[i].Volatility,
+				Placement:  p.Placement,
+			}
+		}
+
+		results := calculator.Rate(players)
+
+		changes := make([]*profile.RatingChange, len(participants))
+		for i, p := range participants {
+			changes[i] = &profile.RatingChange{
+				UserID:          p.UserID,
+				Placement:       p.Placement,
+				RatingBefore:    states[i].Rating,
+				RatingAfter:     results[i].Rating,
+				DeviationBefore: states[i].Deviation,
+				DeviationAfter:  results[i].Deviation,
+				Volatility:      results[i].Volatility,
+			}
+		}
+
+		return changes
+	}
+
+	ratings, err := s.store.ApplyMatchRatings(ctx, result, algorithm, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ratings.Changes))
+	for i, change := range ratings.Changes {
+		ids[i] = change.UserID
+	}
+
+	s.syncLeaderboard(ctx, ids...)
+	s.evaluateAchievements(ctx, profile.EventMatchResult, ids...)
+
+	return ratings, nil
+}
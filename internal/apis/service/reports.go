@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
+)
+
+// ReportUser files a report into the moderation queue. A reporter cannot repeat a report of the same
+// category about a user while it waits for a moderator, and files a limited number of reports per window.
+func (s *Service) ReportUser(ctx context.Context, request *report.ReportUser) error {
+	r, err := s.store.CreateReport(ctx, request, s.reportLimits())
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("user reported",
+		zap.String("report_id", r.ID.String()),
+		zap.String("reporter_id", r.ReporterID.String()),
+		zap.String("reported_id", r.ReportedID.String()),
+		zap.String("category", r.Category.String()),
+	)
+
+	return nil
+}
+
+func (s *Service) AdminListReports(ctx context.Context, filter *report.Filter) (*report.ReportsPage, error) {
+	reports, amount, err := s.store.ListReports(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report.ReportsPage{
+		Reports: reports,
+		Page:    filter.Offset,
+		Size:    filter.Limit,
+		Amount:  amount,
+	}, nil
+}
+
+func (s *Service) AdminClaimReport(ctx context.Context, reportID, moderatorID uuid.UUID) (*report.Report, error) {
+	r, err := s.store.ClaimReport(ctx, reportID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("admin claimed report", zap.String("report_id", reportID.String()), zap.String("moderator_id", moderatorID.String()))
+
+	return r, nil
+}
+
+// AdminResolveReport claims the report, applies the action to the reported user and closes the report. Claiming
+// first keeps another moderator from acting on the same report, and a report whose action failed stays claimed
+// by the moderator and can be resolved again.
+func (s *Service) AdminResolveReport(ctx context.Context, reportID, moderatorID uuid.UUID, action report.Action) (*report.Report, error) {
+	r, err := s.store.ClaimReport(ctx, reportID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case report.ActionBan:
+		err = s.AdminBanUserByID(ctx, r.ReportedID)
+	case report.ActionResetUsername:
+		err = s.AdminResetUsername(ctx, r.ReportedID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	r, err = s.store.CloseReport(ctx, reportID, moderatorID, report.StatusResolved, &action)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("admin resolved report",
+		zap.String("report_id", reportID.String()),
+		zap.String("moderator_id", moderatorID.String()),
+		zap.String("action", action.String()),
+	)
+
+	return r, nil
+}
+
+func (s *Service) AdminDismissReport(ctx context.Context, reportID, moderatorID uuid.UUID) (*report.Report, error) {
+	r, err := s.store.CloseReport(ctx, reportID, moderatorID, report.StatusDismissed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("admin dismissed report", zap.String("report_id", reportID.String()), zap.String("moderator_id", moderatorID.String()))
+
+	return r, nil
+}
+
+// AdminResetUsername replaces the username of the user with a neutral one derived from the user id.
+func (s *Service) AdminResetUsername(ctx context.Context, userID uuid.UUID) error {
+	err := s.store.AdminResetUsername(ctx, userID, report.ResetUsername(userID))
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("admin reset username", zap.String("user_id", userID.String()))
+
+	return nil
+}
+
+func (s *Service) reportLimits() report.Limits {
+	if s.cfg.Reports == nil {
+		return report.Limits{}
+	}
+
+	limits := report.Limits{
+		MaxPerWindow: s.cfg.Reports.MaxPerWindow,
+		Window:       s.cfg.Reports.Window,
+	}
+
+	if limits.Window <= 0 {
+		limits.Window = report.DefaultWindow
+	}
+
+	return limits
+}
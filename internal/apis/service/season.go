@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Service) AdminCreateSeason(ctx context.Context, season *profile.Season) (*profile.Season, error) {
+	created, err := s.store.CreateSeason(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("admin created season", zap.Int32("season_id", created.ID), zap.String("name", created.Name))
+
+	return created, nil
+}
+
+func (s *Service) AdminUpdateSeason(ctx context.Context, update *profile.UpdateSeason) (*profile.Season, error) {
+	updated, err := s.store.UpdateSeason(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("admin updated season", zap.Int32("season_id", updated.ID))
+
+	return updated, nil
+}
+
+func (s *Service) AdminListSeasons(ctx context.Context) (*profile.Seasons, error) {
+	seasons, err := s.store.ListSeasons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile.Seasons{Seasons: seasons}, nil
+}
+
+// GetSeasonHistory returns the archived season results of the user. The history follows the privacy
+// setting of the profile: friends only histories are visible to accepted friends.
+func (s *Service) GetSeasonHistory(ctx context.Context, viewerID, userID uuid.UUID) (*profile.SeasonHistory, error) {
+	if err := s.checkVisible(ctx, viewerID, userID, profile.ErrSeasonHistoryHidden); err != nil {
+		return nil, err
+	}
+
+	results, err := s.store.GetSeasonHistory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile.SeasonHistory{Results: results}, nil
+}
+
+// RolloverSeasons archives every season that has ended, oldest first, and rebuilds
+// leaderboards once ratings were reset.
+func (s *Service) RolloverSeasons(ctx context.Context) error {
+	var archived int
+
+	for {
+		season, err := s.store.ArchiveEndedSeason(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if season == nil {
+			break
+		}
+
+		archived++
+		s.logger.Info("season archived", zap.Int32("season_id", season.ID), zap.String("name", season.Name))
+	}
+
+	if archived == 0 {
+		return nil
+	}
+
+	if err := s.RebuildLeaderboards(ctx); err != nil {
+		s.logger.Warn("failed to rebuild leaderboards after season rollover", zap.Error(err))
+	}
+
+	return nil
+}
@@ -1,15 +1,43 @@
 package service
 
 import (
+	"github.com/QuizWars-Ecosystem/users-service/internal/achievements"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/users-service/internal/categories"
+	"github.com/QuizWars-Ecosystem/users-service/internal/config"
+	"github.com/QuizWars-Ecosystem/users-service/internal/leaderboard"
+	"github.com/QuizWars-Ecosystem/users-service/internal/notification"
+	"github.com/QuizWars-Ecosystem/users-service/internal/party"
+	"github.com/QuizWars-Ecosystem/users-service/internal/presence"
+	"github.com/QuizWars-Ecosystem/users-service/internal/shop"
 	"go.uber.org/zap"
 )
 
 type Service struct {
-	store  store.IStore
-	logger *zap.Logger
+	store    store.IStore
+	presence presence.Tracker
+	// board is nil when Redis is not configured, leaderboards are then read from the database.
+	board        leaderboard.Board
+	parties      party.Registry
+	broker       notification.Broker
+	achievements *achievements.Engine
+	shop         *shop.Shop
+	categories   *categories.Directory
+	cfg          *config.Config
+	logger       *zap.Logger
 }
 
-func NewService(store store.IStore, logger *zap.Logger) *Service {
-	return &Service{store: store, logger: logger}
+func NewService(
+	store store.IStore,
+	presence presence.Tracker,
+	board leaderboard.Board,
+	parties party.Registry,
+	broker notification.Broker,
+	achievements *achievements.Engine,
+	shop *shop.Shop,
+	categories *categories.Directory,
+	cfg *config.Config,
+	logger *zap.Logger,
+) *Service {
+	return &Service{store: store, presence: presence, board: board, parties: parties, broker: broker, achievements: achievements, shop: shop, categories: categories, cfg: cfg, logger: logger}
 }
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Service) ListShopItems() *profile.ShopCatalogue {
+	return s.shop.Catalogue()
+}
+
+func (s *Service) PurchaseItem(ctx context.Context, request *profile.PurchaseItem) (*profile.Purchase, error) {
+	item, ok := s.shop.Catalogue().Item(request.ItemCode)
+	if !ok {
+		return nil, apperrors.NotFound("item", "code", request.ItemCode)
+	}
+
+	purchase, err := s.store.PurchaseItem(ctx, request, item)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ShopPurchasesTotalCounter.WithLabelValues(item.Kind.String()).Inc()
+
+	if item.Price > 0 {
+		metrics.CoinsTransactionsTotalCounter.WithLabelValues("debit").Inc()
+	}
+
+	return purchase, nil
+}
+
+// ListInventory returns the items owned by the user, described by the current catalogue.
+func (s *Service) ListInventory(ctx context.Context, userID uuid.UUID) (*profile.Inventory, error) {
+	catalogue := s.shop.Catalogue()
+
+	if _, err := s.store.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	items, err := s.store.GetInventory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, owned := range items {
+		if item, ok := catalogue.Item(owned.ItemCode); ok {
+			owned.Item = item
+		}
+	}
+
+	return &profile.Inventory{Items: items}, nil
+}
+
+// checkAvatarOwned rejects avatars sold in the shop that the user has not bought.
+func (s *Service) checkAvatarOwned(ctx context.Context, userID uuid.UUID, avatarID int32) error {
+	item, ok := s.shop.Catalogue().Avatar(avatarID)
+	if !ok {
+		return nil
+	}
+
+	owned, err := s.store.HasItem(ctx, userID, item.Code)
+	switch {
+	case err != nil:
+		return err
+	case !owned:
+		return apperrors.Forbidden(profile.ErrAvatarNotOwned)
+	}
+
+	return nil
+}
@@ -2,27 +2,50 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/metrics"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
 )
 
+const defaultFriendExpiryBatchSize = 100
+
+// AddFriend sends a friend request, a pending request of the recipient to the requester is accepted instead.
 func (s *Service) AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID) error {
-	err := s.store.AddFriend(ctx, requesterID, recipientID)
+	if requesterID == recipientID {
+		return apperrors.BadRequest(profile.ErrSelfFriendRequest)
+	}
+
+	accepted, err := s.store.AddFriend(ctx, requesterID, recipientID, s.friendLimits())
 	if err != nil {
 		return err
 	}
 
+	if accepted {
+		s.evaluateAchievements(ctx, profile.EventFriendAccepted, recipientID, requesterID)
+		s.notify(ctx, profile.NewActorNotification(recipientID, profile.NotificationFriendRequestAccepted, requesterID))
+
+		return nil
+	}
+
+	s.notify(ctx, profile.NewActorNotification(recipientID, profile.NotificationFriendRequestReceived, requesterID))
+
 	return nil
 }
 
 func (s *Service) AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error {
-	err := s.store.AcceptFriend(ctx, recipientID, requesterID)
+	err := s.store.AcceptFriend(ctx, recipientID, requesterID, s.friendLimits())
 	if err != nil {
 		return err
 	}
 
+	s.evaluateAchievements(ctx, profile.EventFriendAccepted, recipientID, requesterID)
+	s.notify(ctx, profile.NewActorNotification(requesterID, profile.NotificationFriendRequestAccepted, recipientID))
+
 	return nil
 }
 
@@ -41,6 +64,8 @@ func (s *Service) RemoveFriend(ctx context.Context, userID, friendID uuid.UUID)
 		return err
 	}
 
+	s.notify(ctx, profile.NewActorNotification(friendID, profile.NotificationFriendRemoved, userID))
+
 	return nil
 }
 
@@ -53,7 +78,25 @@ func (s *Service) GetFriends(ctx context.Context, userID uuid.UUID) ([]*profile.
 	return friends, nil
 }
 
+// ListFriends returns a page of accepted friends or pending requests, accepted friends come with their presence.
+func (s *Service) ListFriends(ctx context.Context, filter *profile.ListFriends) (*profile.FriendsPage, error) {
+	page, err := s.store.ListFriends(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Relation == profile.RelationAccepted {
+		s.AttachPresence(ctx, page.Friends)
+	}
+
+	return page, nil
+}
+
 func (s *Service) BlockFriend(ctx context.Context, userID, friendID uuid.UUID) error {
+	if userID == friendID {
+		return apperrors.BadRequest(profile.ErrSelfBlock)
+	}
+
 	err := s.store.BanFriend(ctx, userID, friendID)
 	if err != nil {
 		return err
@@ -70,3 +113,181 @@ func (s *Service) UnblockFriend(ctx context.Context, userID, friendID uuid.UUID)
 
 	return nil
 }
+
+func (s *Service) ListBlockedUsers(ctx context.Context, userID uuid.UUID) (*profile.BlockedUsers, error) {
+	blocked, err := s.store.GetBlockedUsers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile.BlockedUsers{Users: blocked}, nil
+}
+
+func (s *Service) SearchPlayers(ctx context.Context, viewerID uuid.UUID, filter *profile.SearchPlayers) (*profile.PlayersPage, error) {
+	page, err := s.store.SearchPlayers(ctx, viewerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.redact(ctx, viewerID, page.Users...)
+
+	return page, nil
+}
+
+func (s *Service) friendLimits() profile.FriendLimits {
+	if s.cfg.Friends == nil {
+		return profile.FriendLimits{}
+	}
+
+	return profile.FriendLimits{
+		MaxFriends:         s.cfg.Friends.MaxFriends,
+		MaxPendingRequests: s.cfg.Friends.MaxPendingRequests,
+	}
+}
+
+// SuggestFriends returns candidates to befriend with the reasons they are suggested.
+func (s *Service) SuggestFriends(ctx context.Context, filter *profile.SuggestFriends) (*profile.FriendSuggestions, error) {
+	if s.cfg.Friends != nil {
+		filter.WithDefaults(s.cfg.Friends.SuggestionRatingRange, s.cfg.Friends.SuggestionMatchWindow, time.Now())
+	} else {
+		filter.WithDefaults(0, 0, time.Now())
+	}
+
+	suggestions, err := s.store.SuggestFriends(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*profile.User, len(suggestions))
+	for i, suggestion := range suggestions {
+		users[i] = suggestion.User
+	}
+
+	s.redact(ctx, filter.UserID, users...)
+
+	return &profile.FriendSuggestions{Suggestions: suggestions}, nil
+}
+
+// GetMutualFriends returns the friends the viewer and the user have in common. The friends of the user
+// must be visible to the viewer under their privacy setting, and neither of them may have blocked the other.
+func (s *Service) GetMutualFriends(ctx context.Context, filter *profile.GetMutualFriends) (*profile.MutualFriendsPage, error) {
+	if filter.ViewerID == filter.UserID {
+		return nil, apperrors.BadRequest(profile.ErrMutualFriendsSelf)
+	}
+
+	user, err := s.store.GetUserByID(ctx, filter.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := s.store.AreBlocked(ctx, filter.ViewerID, filter.UserID)
+	switch {
+	case err != nil:
+		return nil, err
+	case blocked:
+		return nil, apperrors.Forbidden(profile.ErrUserBlocked)
+	}
+
+	var isFriend bool
+	if user.Privacy == profile.Friends {
+		if isFriend, err = s.store.AreFriends(ctx, filter.ViewerID, filter.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if !user.Privacy.VisibleTo(false, isFriend) {
+		return nil, apperrors.Forbidden(profile.ErrFriendsHidden)
+	}
+
+	page, err := s.store.GetMutualFriends(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.redact(ctx, filter.ViewerID, page.Users...)
+
+	return page, nil
+}
+
+// ExpireFriendRequests deletes pending friend requests older than the request ttl batch by batch,
+// then reminds recipients of requests waiting longer than the reminder delay.
+func (s *Service) ExpireFriendRequests(ctx context.Context) error {
+	cfg := s.cfg.Friends
+	if cfg == nil {
+		return nil
+	}
+
+	batchSize := cfg.ExpiryBatchSize
+	if batchSize == 0 {
+		batchSize = defaultFriendExpiryBatchSize
+	}
+
+	now := time.Now()
+
+	if cfg.RequestTTL > 0 {
+		if err := s.expireFriendRequests(ctx, now.Add(-cfg.RequestTTL), batchSize); err != nil {
+			return err
+		}
+	}
+
+	if cfg.RemindAfter > 0 {
+		if err := s.remindFriendRequests(ctx, now.Add(-cfg.RemindAfter), batchSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) expireFriendRequests(ctx context.Context, createdBefore time.Time, batchSize uint64) error {
+	var total int
+
+	for ctx.Err() == nil {
+		expired, err := s.store.ExpireFriendRequests(ctx, createdBefore, batchSize)
+		if err != nil {
+			return err
+		}
+
+		total += expired
+
+		if uint64(expired) < batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		metrics.FriendRequestsExpiredTotalCounter.Add(float64(total))
+		s.logger.Info("expired friend requests deleted", zap.Int("amount", total))
+	}
+
+	return nil
+}
+
+// remindFriendRequests notifies the recipients of the requests that are due, once per waiting request.
+func (s *Service) remindFriendRequests(ctx context.Context, createdBefore time.Time, batchSize uint64) error {
+	for ctx.Err() == nil {
+		reminders, err := s.store.RemindFriendRequests(ctx, createdBefore, batchSize)
+		if err != nil {
+			return err
+		}
+
+		var notifications []*profile.Notification
+
+		for _, reminder := range reminders {
+			for _, requesterID := range reminder.RequesterIDs {
+				notifications = append(notifications,
+					profile.NewActorNotification(reminder.UserID, profile.NotificationFriendRequestReminder, requesterID))
+			}
+		}
+
+		s.notify(ctx, notifications...)
+
+		metrics.FriendRequestRemindersTotalCounter.Add(float64(len(reminders)))
+
+		if uint64(len(notifications)) < batchSize {
+			break
+		}
+	}
+
+	return nil
+}
@@ -2,19 +2,34 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/admin"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/auth"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/clan"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
 )
 
 type IStore interface {
 	IAuthStore
 	IProfileStore
 	ISocialStore
+	ICoinsStore
+	ILeaderboardStore
+	ISeasonStore
+	IAchievementStore
+	ILevelStore
+	IDailyRewardStore
+	ICategoryStatsStore
+	IMatchStore
+	IShopStore
 	IAdminStore
+	IClanStore
+	IReportStore
+	INotificationStore
 }
 
 type IAuthStore interface {
@@ -22,28 +37,92 @@ type IAuthStore interface {
 	GetProfileByUsername(ctx context.Context, username string) (*auth.ProfileWithCredentials, error)
 	GetProfileByEmail(ctx context.Context, email string) (*auth.ProfileWithCredentials, error)
 	SetLastLogin(ctx context.Context, userID uuid.UUID) error
+	RestoreProfile(ctx context.Context, userID uuid.UUID) error
 }
 
 type IProfileStore interface {
 	GetProfile(ctx context.Context, userID uuid.UUID) (*profile.Profile, error)
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*profile.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*profile.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*profile.User, error)
 	UpdateProfile(ctx context.Context, userID uuid.UUID, request *profile.UpdateProfile) error
 	UpdateProfileAvatar(ctx context.Context, userID uuid.UUID, avatarID int32) error
 	UpdateProfilePassword(ctx context.Context, userID uuid.UUID, password string) error
-	SetProfileRating(ctx context.Context, userID uuid.UUID, rating int32) error
-	SetProfileCoins(ctx context.Context, userID uuid.UUID, coins int64) error
+	ApplyMatchRatings(ctx context.Context, result *profile.MatchResult, algorithm string, rate profile.RateFunc) (*profile.MatchRatings, error)
 	DeleteProfile(ctx context.Context, userID uuid.UUID) error
+	PurgeDeletedProfiles(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error)
+	GetExportRecords(ctx context.Context, userID uuid.UUID) (*profile.ExportRecords, error)
 }
 
 type ISocialStore interface {
-	AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID) error
-	AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error
+	AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID, limits profile.FriendLimits) (bool, error)
+	AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID, limits profile.FriendLimits) error
 	RejectFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error
 	RemoveFriend(ctx context.Context, userID, friendID uuid.UUID) error
 	GetFriends(ctx context.Context, userID uuid.UUID) ([]*profile.Friend, error)
+	ListFriends(ctx context.Context, filter *profile.ListFriends) (*profile.FriendsPage, error)
+	AreFriends(ctx context.Context, userID, otherID uuid.UUID) (bool, error)
 	BanFriend(ctx context.Context, userID, friendID uuid.UUID) error
 	UnbanFriend(ctx context.Context, userID, friendID uuid.UUID) error
+	GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*profile.BlockedUser, error)
+	ExpireFriendRequests(ctx context.Context, createdBefore time.Time, limit uint64) (int, error)
+	RemindFriendRequests(ctx context.Context, createdBefore time.Time, limit uint64) ([]*profile.FriendRequestReminder, error)
+	SearchPlayers(ctx context.Context, viewerID uuid.UUID, filter *profile.SearchPlayers) (*profile.PlayersPage, error)
+	SuggestFriends(ctx context.Context, filter *profile.SuggestFriends) ([]*profile.FriendSuggestion, error)
+	GetMutualFriends(ctx context.Context, filter *profile.GetMutualFriends) (*profile.MutualFriendsPage, error)
+	AreBlocked(ctx context.Context, userID, otherID uuid.UUID) (bool, error)
+}
+
+type ICoinsStore interface {
+	ApplyCoinOperation(ctx context.Context, op *profile.CoinOperation) (*profile.CoinTransaction, error)
+	ListCoinTransactions(ctx context.Context, filter *profile.ListCoinTransactions) (*profile.CoinTransactionsPage, error)
+	FindCoinsMismatches(ctx context.Context, limit uint64) ([]*profile.CoinsMismatch, error)
+}
+
+type ILeaderboardStore interface {
+	GetLeaderboard(ctx context.Context, filter *profile.LeaderboardFilter) ([]*profile.LeaderboardEntry, error)
+	GetLeaderboardRank(ctx context.Context, userID uuid.UUID, country *string) (uint64, error)
+	GetFriendsLeaderboard(ctx context.Context, userID uuid.UUID) ([]*profile.LeaderboardEntry, error)
+	GetLeaderboardStandings(ctx context.Context) ([]*profile.User, error)
+}
+
+type ISeasonStore interface {
+	CreateSeason(ctx context.Context, season *profile.Season) (*profile.Season, error)
+	UpdateSeason(ctx context.Context, update *profile.UpdateSeason) (*profile.Season, error)
+	ListSeasons(ctx context.Context) ([]*profile.Season, error)
+	ArchiveEndedSeason(ctx context.Context, now time.Time) (*profile.Season, error)
+	GetSeasonHistory(ctx context.Context, userID uuid.UUID) ([]*profile.SeasonResult, error)
+}
+
+type IAchievementStore interface {
+	RecordLoginDay(ctx context.Context, userID uuid.UUID, day time.Time) error
+	GetAchievementProgress(ctx context.Context, userID uuid.UUID) (profile.AchievementProgress, error)
+	AwardAchievements(ctx context.Context, userID uuid.UUID, codes []string) ([]*profile.UserAchievement, error)
+	GetUserAchievements(ctx context.Context, userID uuid.UUID) ([]*profile.UserAchievement, error)
+}
+
+type ILevelStore interface {
+	GrantXP(ctx context.Context, grant *profile.GrantXP, curve profile.LevelCurve, coinsPerLevel int64) (*profile.XPGrant, error)
+}
+
+type IDailyRewardStore interface {
+	ClaimDailyReward(ctx context.Context, userID uuid.UUID, now time.Time, table profile.DailyRewardTable) (*profile.DailyRewardClaim, error)
+}
+
+type ICategoryStatsStore interface {
+	AddAnswerResults(ctx context.Context, results *profile.AnswerResults) error
+	GetCategoryStats(ctx context.Context, userID uuid.UUID) ([]*profile.CategoryStats, error)
+}
+
+type IMatchStore interface {
+	RecordMatch(ctx context.Context, match *profile.MatchRecord) error
+	ListMatchHistory(ctx context.Context, filter *profile.ListMatchHistory) (*profile.MatchHistoryPage, error)
+}
+
+type IShopStore interface {
+	PurchaseItem(ctx context.Context, request *profile.PurchaseItem, item *profile.ShopItem) (*profile.Purchase, error)
+	HasItem(ctx context.Context, userID uuid.UUID, itemCode string) (bool, error)
+	GetInventory(ctx context.Context, userID uuid.UUID) ([]*profile.InventoryItem, error)
 }
 
 type IAdminStore interface {
@@ -55,3 +134,37 @@ type IAdminStore interface {
 	AdminBanUser(ctx context.Context, userID uuid.UUID) error
 	AdminUnbanUser(ctx context.Context, userID uuid.UUID) error
 }
+
+type IClanStore interface {
+	CreateClan(ctx context.Context, request *clan.CreateClan) (*clan.Clan, error)
+	GetClan(ctx context.Context, clanID uuid.UUID) (*clan.Clan, error)
+	GetClanMembers(ctx context.Context, clanID uuid.UUID) ([]*clan.Member, error)
+	GetClanRole(ctx context.Context, clanID, userID uuid.UUID) (clan.Role, error)
+	ListClanJoinRequests(ctx context.Context, clanID uuid.UUID) ([]*clan.JoinRequest, error)
+	InviteToClan(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error
+	JoinClan(ctx context.Context, clanID, userID uuid.UUID, maxMembers int64) (bool, error)
+	AcceptClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID, maxMembers int64) error
+	DeclineClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID) error
+	CancelClanJoinRequest(ctx context.Context, clanID, userID uuid.UUID) error
+	DeclineClanInvite(ctx context.Context, clanID, userID uuid.UUID) error
+	CancelClanInvite(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error
+	LeaveClan(ctx context.Context, clanID, userID uuid.UUID) error
+	KickClanMember(ctx context.Context, clanID, actorID, memberID uuid.UUID) error
+	SetClanMemberRole(ctx context.Context, request *clan.SetMemberRole) error
+	TransferClanOwnership(ctx context.Context, clanID, ownerID, newOwnerID uuid.UUID) error
+}
+
+type IReportStore interface {
+	CreateReport(ctx context.Context, request *report.ReportUser, limits report.Limits) (*report.Report, error)
+	ListReports(ctx context.Context, filter *report.Filter) ([]*report.Report, int64, error)
+	ClaimReport(ctx context.Context, reportID, moderatorID uuid.UUID) (*report.Report, error)
+	CloseReport(ctx context.Context, reportID, moderatorID uuid.UUID, status report.Status, action *report.Action) (*report.Report, error)
+	AdminResetUsername(ctx context.Context, userID uuid.UUID, username string) error
+}
+
+type INotificationStore interface {
+	CreateNotifications(ctx context.Context, notifications []*profile.Notification) error
+	ListNotifications(ctx context.Context, filter *profile.ListNotifications) (*profile.NotificationsPage, error)
+	MarkNotificationsRead(ctx context.Context, request *profile.MarkRead) (int64, error)
+	PurgeNotifications(ctx context.Context, createdBefore time.Time, limit uint64) (int, error)
+}
@@ -0,0 +1,26 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) RecordLoginDay(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	return s.db.RecordLoginDay(ctx, userID, day)
+}
+
+func (s *Store) GetAchievementProgress(ctx context.Context, userID uuid.UUID) (profile.AchievementProgress, error) {
+	return s.db.GetAchievementProgress(ctx, userID)
+}
+
+func (s *Store) AwardAchievements(ctx context.Context, userID uuid.UUID, codes []string) ([]*profile.UserAchievement, error) {
+	return s.db.AwardAchievements(ctx, userID, codes)
+}
+
+func (s *Store) GetUserAchievements(ctx context.Context, userID uuid.UUID) ([]*profile.UserAchievement, error) {
+	return s.db.GetUserAchievements(ctx, userID)
+}
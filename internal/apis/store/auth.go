@@ -24,3 +24,7 @@ func (s *Store) GetProfileByEmail(ctx context.Context, email string) (*auth.Prof
 func (s *Store) SetLastLogin(ctx context.Context, userID uuid.UUID) error {
 	return s.db.SetLastLogin(ctx, userID)
 }
+
+func (s *Store) RestoreProfile(ctx context.Context, userID uuid.UUID) error {
+	return s.db.RestoreProfile(ctx, userID)
+}
@@ -0,0 +1,17 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) AddAnswerResults(ctx context.Context, results *profile.AnswerResults) error {
+	return s.db.AddAnswerResults(ctx, results)
+}
+
+func (s *Store) GetCategoryStats(ctx context.Context, userID uuid.UUID) ([]*profile.CategoryStats, error) {
+	return s.db.GetCategoryStats(ctx, userID)
+}
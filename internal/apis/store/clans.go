@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/clan"
+)
+
+func (s *Store) CreateClan(ctx context.Context, request *clan.CreateClan) (*clan.Clan, error) {
+	return s.db.CreateClan(ctx, request)
+}
+
+func (s *Store) GetClan(ctx context.Context, clanID uuid.UUID) (*clan.Clan, error) {
+	return s.db.GetClan(ctx, clanID)
+}
+
+func (s *Store) GetClanMembers(ctx context.Context, clanID uuid.UUID) ([]*clan.Member, error) {
+	return s.db.GetClanMembers(ctx, clanID)
+}
+
+func (s *Store) GetClanRole(ctx context.Context, clanID, userID uuid.UUID) (clan.Role, error) {
+	return s.db.GetClanRole(ctx, clanID, userID)
+}
+
+func (s *Store) ListClanJoinRequests(ctx context.Context, clanID uuid.UUID) ([]*clan.JoinRequest, error) {
+	return s.db.ListClanJoinRequests(ctx, clanID)
+}
+
+func (s *Store) InviteToClan(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error {
+	return s.db.InviteToClan(ctx, clanID, actorID, inviteeID)
+}
+
+func (s *Store) JoinClan(ctx context.Context, clanID, userID uuid.UUID, maxMembers int64) (bool, error) {
+	return s.db.JoinClan(ctx, clanID, userID, maxMembers)
+}
+
+func (s *Store) AcceptClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID, maxMembers int64) error {
+	return s.db.AcceptClanJoinRequest(ctx, clanID, actorID, requesterID, maxMembers)
+}
+
+func (s *Store) DeclineClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID) error {
+	return s.db.DeclineClanJoinRequest(ctx, clanID, actorID, requesterID)
+}
+
+func (s *Store) CancelClanJoinRequest(ctx context.Context, clanID, userID uuid.UUID) error {
+	return s.db.CancelClanJoinRequest(ctx, clanID, userID)
+}
+
+func (s *Store) DeclineClanInvite(ctx context.Context, clanID, userID uuid.UUID) error {
+	return s.db.DeclineClanInvite(ctx, clanID, userID)
+}
+
+func (s *Store) CancelClanInvite(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error {
+	return s.db.CancelClanInvite(ctx, clanID, actorID, inviteeID)
+}
+
+func (s *Store) LeaveClan(ctx context.Context, clanID, userID uuid.UUID) error {
+	return s.db.LeaveClan(ctx, clanID, userID)
+}
+
+func (s *Store) KickClanMember(ctx context.Context, clanID, actorID, memberID uuid.UUID) error {
+	return s.db.KickClanMember(ctx, clanID, actorID, memberID)
+}
+
+func (s *Store) SetClanMemberRole(ctx context.Context, request *clan.SetMemberRole) error {
+	return s.db.SetClanMemberRole(ctx, request)
+}
+
+func (s *Store) TransferClanOwnership(ctx context.Context, clanID, ownerID, newOwnerID uuid.UUID) error {
+	return s.db.TransferClanOwnership(ctx, clanID, ownerID, newOwnerID)
+}
@@ -0,0 +1,19 @@
+package store
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) ApplyCoinOperation(ctx context.Context, op *profile.CoinOperation) (*profile.CoinTransaction, error) {
+	return s.db.ApplyCoinOperation(ctx, op)
+}
+
+func (s *Store) ListCoinTransactions(ctx context.Context, filter *profile.ListCoinTransactions) (*profile.CoinTransactionsPage, error) {
+	return s.db.ListCoinTransactions(ctx, filter)
+}
+
+func (s *Store) FindCoinsMismatches(ctx context.Context, limit uint64) ([]*profile.CoinsMismatch, error) {
+	return s.db.FindCoinsMismatches(ctx, limit)
+}
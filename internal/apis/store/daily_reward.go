@@ -0,0 +1,14 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) ClaimDailyReward(ctx context.Context, userID uuid.UUID, now time.Time, table profile.DailyRewardTable) (*profile.DailyRewardClaim, error) {
+	return s.db.ClaimDailyReward(ctx, userID, now, table)
+}
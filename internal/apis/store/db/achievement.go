@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// loginStreakQuery counts consecutive login days ending at the latest one: days are unique,
+// so the distance from the latest day equals the position only within the streak.
+const loginStreakQuery = `(SELECT COUNT(*) FROM (
+	SELECT MAX(day) OVER () - day AS age, ROW_NUMBER() OVER (ORDER BY day DESC) - 1 AS pos
+	FROM user_login_days
+	WHERE user_id = ?
+) d WHERE d.age = d.pos)`
+
+// RecordLoginDay marks the day as one the user logged in on.
+func (db *Database) RecordLoginDay(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	builder := dbx.StatementBuilder.
+		Insert("user_login_days").
+		Columns("user_id", "day").
+		Values(userID, day).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	_, err = db.pool.Exec(ctx, query, args...)
+	switch {
+	case dbx.IsForeignKeyViolation(err, "user_id"):
+		return apperrors.NotFound("user", "id", userID)
+	case err != nil:
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+func (db *Database) GetAchievementProgress(ctx context.Context, userID uuid.UUID) (profile.AchievementProgress, error) {
+	builder := dbx.StatementBuilder.
+		Select().
+		Column("(SELECT COUNT(*) FROM rating_history WHERE user_id = ?)", userID).
+		Column("(SELECT COUNT(*) FROM rating_history WHERE user_id = ? AND placement = 1)", userID).
+		Column("(SELECT COUNT(*) FROM friends WHERE (user_id = ? OR friend_id = ?) AND status = 'accepted')", userID, userID).
+		Column("(SELECT COUNT(*) FROM user_login_days WHERE user_id = ?)", userID).
+		Column(loginStreakQuery, userID).
+		Column("(SELECT COALESCE(MAX(level), 0) FROM stats WHERE user_id = ?)", userID)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var played, won, friends, loginDays, loginStreak, level int64
+
+	err = db.pool.QueryRow(ctx, query, args...).Scan(&played, &won, &friends, &loginDays, &loginStreak, &level)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	return profile.AchievementProgress{
+		profile.MetricMatchesPlayed: played,
+		profile.MetricMatchesWon:    won,
+		profile.MetricFriends:       friends,
+		profile.MetricLoginDays:     loginDays,
+		profile.MetricLoginStreak:   loginStreak,
+		profile.MetricLevel:         level,
+	}, nil
+}
+
+// AwardAchievements awards the achievements the user does not hold yet and returns only the newly awarded ones.
+func (db *Database) AwardAchievements(ctx context.Context, userID uuid.UUID, codes []string) ([]*profile.UserAchievement, error) {
+	builder := dbx.StatementBuilder.
+		Insert("user_achievements").
+		Columns("user_id", "code")
+
+	for _, code := range codes {
+		builder = builder.Values(userID, code)
+	}
+
+	builder = builder.Suffix("ON CONFLICT DO NOTHING RETURNING code, awarded_at")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	return scanUserAchievements(rows)
+}
+
+func (db *Database) GetUserAchievements(ctx context.Context, userID uuid.UUID) ([]*profile.UserAchievement, error) {
+	builder := dbx.StatementBuilder.
+		Select("code", "awarded_at").
+		From("user_achievements").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("awarded_at", "code")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	return scanUserAchievements(rows)
+}
+
+func scanUserAchievements(rows pgx.Rows) ([]*profile.UserAchievement, error) {
+	defer rows.Close()
+
+	var achievements []*profile.UserAchievement
+
+	for rows.Next() {
+		var achievement profile.UserAchievement
+
+		if err := rows.Scan(&achievement.Code, &achievement.AwardedAt); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		achievements = append(achievements, &achievement)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return achievements, nil
+}
@@ -16,7 +16,7 @@ import (
 
 func (db *Database) AdminSearchUsers(ctx context.Context, filter *admin.SearchFilter) ([]*profile.UserAdmin, int, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at").
+		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at", "u.banned_at").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		OrderBy(filter.Order.String() + " " + filter.Sort.String()).
@@ -84,6 +84,7 @@ func (db *Database) AdminSearchUsers(ctx context.Context, filter *admin.SearchFi
 			&u.Profile.User.CreatedAt,
 			&u.Profile.User.LastLoginAt,
 			&u.DeletedAt,
+			&u.BannedAt,
 		); err != nil {
 			return nil, 0, apperrors.Internal(err)
 		}
@@ -105,7 +106,7 @@ func (db *Database) AdminSearchUsers(ctx context.Context, filter *admin.SearchFi
 
 func (db *Database) AdminGetUserByID(ctx context.Context, userID uuid.UUID) (*profile.UserAdmin, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at").
+		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at", "u.banned_at").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.id": userID})
@@ -132,6 +133,7 @@ func (db *Database) AdminGetUserByID(ctx context.Context, userID uuid.UUID) (*pr
 			&u.Profile.User.CreatedAt,
 			&u.Profile.User.LastLoginAt,
 			&u.DeletedAt,
+			&u.BannedAt,
 		)
 
 	switch {
@@ -146,7 +148,7 @@ func (db *Database) AdminGetUserByID(ctx context.Context, userID uuid.UUID) (*pr
 
 func (db *Database) AdminGetUserByUsername(ctx context.Context, username string) (*profile.UserAdmin, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at").
+		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at", "u.banned_at").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.username": username})
@@ -173,6 +175,7 @@ func (db *Database) AdminGetUserByUsername(ctx context.Context, username string)
 			&u.Profile.User.CreatedAt,
 			&u.Profile.User.LastLoginAt,
 			&u.DeletedAt,
+			&u.BannedAt,
 		)
 
 	switch {
@@ -187,7 +190,7 @@ func (db *Database) AdminGetUserByUsername(ctx context.Context, username string)
 
 func (db *Database) AdminGetUserByEmail(ctx context.Context, email string) (*profile.UserAdmin, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at").
+		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at", "u.deleted_at", "u.banned_at").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.email": email})
@@ -214,6 +217,7 @@ func (db *Database) AdminGetUserByEmail(ctx context.Context, email string) (*pro
 			&u.Profile.User.CreatedAt,
 			&u.Profile.User.LastLoginAt,
 			&u.DeletedAt,
+			&u.BannedAt,
 		)
 
 	switch {
@@ -254,7 +258,7 @@ func (db *Database) AdminUpdateUserRole(ctx context.Context, userID uuid.UUID, r
 func (db *Database) AdminBanUser(ctx context.Context, userID uuid.UUID) error {
 	builder := dbx.StatementBuilder.
 		Update("users").
-		Set("deleted_at", time.Now()).
+		Set("banned_at", time.Now()).
 		Where(squirrel.Eq{"id": userID})
 
 	query, args, err := builder.ToSql()
@@ -276,7 +280,7 @@ func (db *Database) AdminBanUser(ctx context.Context, userID uuid.UUID) error {
 func (db *Database) AdminUnbanUser(ctx context.Context, userID uuid.UUID) error {
 	builder := dbx.StatementBuilder.
 		Update("users").
-		Set("deleted_at", nil).
+		Set("banned_at", nil).
 		Where(squirrel.Eq{"id": userID})
 
 	query, args, err := builder.ToSql()
@@ -30,8 +30,8 @@ func (db *Database) SaveProfile(ctx context.Context, p *auth.ProfileWithCredenti
 	if err == nil {
 		builder = dbx.StatementBuilder.
 			Insert("stats").
-			Columns("user_id").
-			Values(p.Profile.User.ID).
+			Columns("user_id", "level", "level_xp", "next_level_xp").
+			Values(p.Profile.User.ID, p.Profile.User.Level, p.Profile.User.LevelXP, p.Profile.User.NextLevelXP).
 			Suffix("ON CONFLICT DO NOTHING")
 
 		query, args, err = builder.ToSql()
@@ -62,11 +62,11 @@ func (db *Database) SaveProfile(ctx context.Context, p *auth.ProfileWithCredenti
 
 func (db *Database) GetProfileByUsername(ctx context.Context, username string) (*auth.ProfileWithCredentials, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.pass_hash", "u.role", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at").
+		Select("u.id", "u.username", "u.email", "u.pass_hash", "u.role", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "s.coins", "u.created_at", "u.last_login_at", "u.privacy", "u.country", "u.time_zone", "u.deleted_at").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.username": username}).
-		Where(squirrel.Eq{"u.deleted_at": nil})
+		Where(squirrel.Eq{"u.banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -88,9 +88,17 @@ func (db *Database) GetProfileByUsername(ctx context.Context, username string) (
 			&p.Role,
 			&p.Profile.User.AvatarID,
 			&p.Profile.User.Rating,
+			&p.Profile.User.Level,
+			&p.Profile.User.XP,
+			&p.Profile.User.LevelXP,
+			&p.Profile.User.NextLevelXP,
 			&p.Profile.Coins,
 			&p.Profile.User.CreatedAt,
 			&p.Profile.User.LastLoginAt,
+			&p.Profile.User.Privacy,
+			&p.Profile.User.Country,
+			&p.Profile.TimeZone,
+			&p.DeletedAt,
 		)
 
 	switch {
@@ -105,11 +113,11 @@ func (db *Database) GetProfileByUsername(ctx context.Context, username string) (
 
 func (db *Database) GetProfileByEmail(ctx context.Context, email string) (*auth.ProfileWithCredentials, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.pass_hash", "u.role", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at").
+		Select("u.id", "u.username", "u.email", "u.pass_hash", "u.role", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "s.coins", "u.created_at", "u.last_login_at", "u.privacy", "u.country", "u.time_zone", "u.deleted_at").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.email": email}).
-		Where(squirrel.Eq{"u.deleted_at": nil})
+		Where(squirrel.Eq{"u.banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -131,9 +139,17 @@ func (db *Database) GetProfileByEmail(ctx context.Context, email string) (*auth.
 			&p.Role,
 			&p.Profile.User.AvatarID,
 			&p.Profile.User.Rating,
+			&p.Profile.User.Level,
+			&p.Profile.User.XP,
+			&p.Profile.User.LevelXP,
+			&p.Profile.User.NextLevelXP,
 			&p.Profile.Coins,
 			&p.Profile.User.CreatedAt,
 			&p.Profile.User.LastLoginAt,
+			&p.Profile.User.Privacy,
+			&p.Profile.User.Country,
+			&p.Profile.TimeZone,
+			&p.DeletedAt,
 		)
 
 	switch {
@@ -151,7 +167,32 @@ func (db *Database) SetLastLogin(ctx context.Context, userID uuid.UUID) error {
 		Update("users").
 		Set("last_login_at", time.Now()).
 		Where(squirrel.Eq{"id": userID}).
-		Where(squirrel.Eq{"deleted_at": nil})
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Eq{"banned_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	cmd, err := db.pool.Exec(ctx, query, args...)
+	switch {
+	case err != nil:
+		return apperrors.Internal(err)
+	case cmd.RowsAffected() == 0:
+		return apperrors.NotFound("user", "id", userID)
+	}
+
+	return nil
+}
+
+func (db *Database) RestoreProfile(ctx context.Context, userID uuid.UUID) error {
+	builder := dbx.StatementBuilder.
+		Update("users").
+		Set("deleted_at", nil).
+		Where(squirrel.Eq{"id": userID}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		Where(squirrel.Eq{"banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
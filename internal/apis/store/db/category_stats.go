@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// AddAnswerResults adds the batch to the category stats of the user, a batch that was already
+// added is skipped, so retries of the reporting service are not counted twice.
+func (db *Database) AddAnswerResults(ctx context.Context, results *profile.AnswerResults) error {
+	batch := dbx.StatementBuilder.
+		Insert("answer_batches").
+		Columns("user_id", "batch_id").
+		Values(results.UserID, results.BatchID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	batchQuery, batchArgs, err := batch.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	upsert := dbx.StatementBuilder.
+		Insert("category_stats").
+		Columns("user_id", "category_id", "answered", "correct", "answer_time_ms")
+
+	for _, totals := range results.Totals() {
+		upsert = upsert.Values(results.UserID, totals.CategoryID, totals.Answered, totals.Correct, totals.AnswerTimeMs)
+	}
+
+	upsert = upsert.Suffix(`ON CONFLICT (user_id, category_id) DO UPDATE SET
+		answered = category_stats.answered + EXCLUDED.answered,
+		correct = category_stats.correct + EXCLUDED.correct,
+		answer_time_ms = category_stats.answer_time_ms + EXCLUDED.answer_time_ms,
+		updated_at = now()`)
+
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		cmd, txErr := tx.Exec(ctx, batchQuery, batchArgs...)
+		switch {
+		case dbx.IsForeignKeyViolation(txErr, "user_id"):
+			return apperrors.NotFound("user", "id", results.UserID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		case cmd.RowsAffected() == 0:
+			return nil
+		}
+
+		return execAll(ctx, tx, upsert)
+	})
+}
+
+func (db *Database) GetCategoryStats(ctx context.Context, userID uuid.UUID) ([]*profile.CategoryStats, error) {
+	builder := dbx.StatementBuilder.
+		Select("category_id", "answered", "correct", "answer_time_ms").
+		From("category_stats").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("answered DESC", "category_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var stats []*profile.CategoryStats
+
+	for rows.Next() {
+		var s profile.CategoryStats
+
+		if err = rows.Scan(&s.CategoryID, &s.Answered, &s.Correct, &s.AnswerTimeMs); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		stats = append(stats, &s)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,789 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/clan"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// CreateClan creates the clan with the user as its owner. Pending invites and join requests of the
+// user are dropped since a user belongs to at most one clan.
+func (db *Database) CreateClan(ctx context.Context, request *clan.CreateClan) (*clan.Clan, error) {
+	insert := dbx.StatementBuilder.
+		Insert("clans").
+		Columns("tag", "name").
+		Values(request.Tag, request.Name).
+		Suffix("RETURNING id")
+
+	insertQuery, insertArgs, err := insert.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var clanID uuid.UUID
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		membership, txErr := clanMembership(ctx, tx, request.OwnerID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case membership != nil:
+			return apperrors.AlreadyExists("clan member", "user_id", request.OwnerID)
+		}
+
+		txErr = tx.QueryRow(ctx, insertQuery, insertArgs...).Scan(&clanID)
+		switch {
+		case dbx.IsUniqueViolation(txErr, "tag"):
+			return apperrors.AlreadyExists("clan", "tag", request.Tag)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		return insertClanMember(ctx, tx, clanID, request.OwnerID, clan.RoleOwner)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return db.GetClan(ctx, clanID)
+}
+
+// GetClan returns the clan with its rating averaged over the ratings of the members that are not deleted.
+func (db *Database) GetClan(ctx context.Context, clanID uuid.UUID) (*clan.Clan, error) {
+	builder := dbx.StatementBuilder.
+		Select("c.id", "c.tag", "c.name", "c.created_at").
+		Column("(SELECT o.user_id FROM clan_members o WHERE o.clan_id = c.id AND o.role = 'owner') AS owner_id").
+		Column("count(u.id) AS members_count").
+		Column("COALESCE(round(avg(s.rating)), 0)::int AS rating").
+		From("clans c").
+		LeftJoin("clan_members m ON m.clan_id = c.id").
+		LeftJoin("users u ON u.id = m.user_id AND u.deleted_at IS NULL").
+		LeftJoin("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"c.id": clanID}).
+		GroupBy("c.id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var (
+		c       clan.Clan
+		ownerID *uuid.UUID
+	)
+
+	err = db.pool.QueryRow(ctx, query, args...).Scan(&c.ID, &c.Tag, &c.Name, &c.CreatedAt, &ownerID, &c.MembersCount, &c.Rating)
+	switch {
+	case dbx.IsNoRows(err):
+		return nil, apperrors.NotFound("clan", "id", clanID)
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	}
+
+	if ownerID != nil {
+		c.OwnerID = *ownerID
+	}
+
+	return &c, nil
+}
+
+// GetClanMembers lists the members that are not deleted, the owner first, then officers and members.
+func (db *Database) GetClanMembers(ctx context.Context, clanID uuid.UUID) ([]*clan.Member, error) {
+	builder := dbx.StatementBuilder.
+		Select("u.id", "u.avatar_id", "u.username", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "m.role", "m.joined_at").
+		From("clan_members m").
+		Join("users u ON u.id = m.user_id").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"m.clan_id": clanID}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		OrderBy("m.role", "m.joined_at", "u.id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var members []*clan.Member
+
+	for rows.Next() {
+		m := clan.Member{
+			User: &profile.User{},
+		}
+
+		if err = rows.Scan(
+			&m.User.ID,
+			&m.User.AvatarID,
+			&m.User.Username,
+			&m.User.Rating,
+			&m.User.Level,
+			&m.User.XP,
+			&m.User.LevelXP,
+			&m.User.NextLevelXP,
+			&m.User.CreatedAt,
+			&m.User.LastLoginAt,
+			&m.User.Privacy,
+			&m.Role,
+			&m.JoinedAt,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		members = append(members, &m)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return members, nil
+}
+
+// GetClanRole returns the role of the user in the clan or clan.RoleUnknown when the user is not a member.
+func (db *Database) GetClanRole(ctx context.Context, clanID, userID uuid.UUID) (clan.Role, error) {
+	return clanRole(ctx, db.pool, clanID, userID)
+}
+
+func (db *Database) ListClanJoinRequests(ctx context.Context, clanID uuid.UUID) ([]*clan.JoinRequest, error) {
+	builder := dbx.StatementBuilder.
+		Select("u.id", "u.avatar_id", "u.username", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "a.created_at").
+		From("clan_applications a").
+		Join("users u ON u.id = a.user_id").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"a.clan_id": clanID}).
+		Where(squirrel.Eq{"a.kind": "request"}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		OrderBy("a.created_at", "u.id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var requests []*clan.JoinRequest
+
+	for rows.Next() {
+		r := clan.JoinRequest{
+			User: &profile.User{},
+		}
+
+		if err = rows.Scan(
+			&r.User.ID,
+			&r.User.AvatarID,
+			&r.User.Username,
+			&r.User.Rating,
+			&r.User.Level,
+			&r.User.XP,
+			&r.User.LevelXP,
+			&r.User.NextLevelXP,
+			&r.User.CreatedAt,
+			&r.User.LastLoginAt,
+			&r.User.Privacy,
+			&r.CreatedAt,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		requests = append(requests, &r)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return requests, nil
+}
+
+// InviteToClan invites the user on behalf of an officer or the owner, inviting again refreshes the invite
+// and turns a join request of the user into an invite.
+func (db *Database) InviteToClan(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error {
+	invite := dbx.StatementBuilder.
+		Insert("clan_applications").
+		Columns("clan_id", "user_id", "kind").
+		Values(clanID, inviteeID, "invite").
+		Suffix("ON CONFLICT (clan_id, user_id) DO UPDATE SET kind = EXCLUDED.kind, created_at = now()")
+
+	inviteQuery, inviteArgs, err := invite.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		role, txErr := clanRole(ctx, tx, clanID, actorID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case !role.CanManage():
+			return apperrors.Forbidden(clan.ErrNotOfficer)
+		}
+
+		membership, txErr := clanMembership(ctx, tx, inviteeID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case membership != nil:
+			return apperrors.AlreadyExists("clan member", "user_id", inviteeID)
+		}
+
+		_, txErr = tx.Exec(ctx, inviteQuery, inviteArgs...)
+		switch {
+		case dbx.IsForeignKeyViolation(txErr, "user_id"):
+			return apperrors.NotFound("user", "id", inviteeID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+}
+
+// JoinClan accepts the invite of the user, the user is added once the clan is below the member cap.
+// Without an invite a join request is sent instead and false is returned.
+func (db *Database) JoinClan(ctx context.Context, clanID, userID uuid.UUID, maxMembers int64) (bool, error) {
+	request := dbx.StatementBuilder.
+		Insert("clan_applications").
+		Columns("clan_id", "user_id", "kind").
+		Values(clanID, userID, "request")
+
+	var joined bool
+
+	err := db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		kind, txErr := clanApplication(ctx, tx, clanID, userID)
+		if txErr != nil {
+			return txErr
+		}
+
+		switch kind {
+		case "invite":
+			joined = true
+			return addClanMember(ctx, tx, clanID, userID, maxMembers)
+		case "request":
+			return apperrors.AlreadyExists("clan join request", "user_id", userID)
+		}
+
+		membership, txErr := clanMembership(ctx, tx, userID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case membership != nil:
+			return apperrors.AlreadyExists("clan member", "user_id", userID)
+		}
+
+		return execAll(ctx, tx, request)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return joined, nil
+}
+
+func (db *Database) AcceptClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID, maxMembers int64) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		role, txErr := clanRole(ctx, tx, clanID, actorID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case !role.CanManage():
+			return apperrors.Forbidden(clan.ErrNotOfficer)
+		}
+
+		kind, txErr := clanApplication(ctx, tx, clanID, requesterID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case kind != "request":
+			return apperrors.NotFound("clan join request", "user_id", requesterID)
+		}
+
+		return addClanMember(ctx, tx, clanID, requesterID, maxMembers)
+	})
+}
+
+// LeaveClan removes the member, the owner can only leave as the last member and the clan is disbanded then.
+func (db *Database) LeaveClan(ctx context.Context, clanID, userID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		role, txErr := clanRole(ctx, tx, clanID, userID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case role == clan.RoleUnknown:
+			return apperrors.NotFound("clan member", "user_id", userID)
+		case role != clan.RoleOwner:
+			return deleteClanMember(ctx, tx, clanID, userID)
+		}
+
+		members, txErr := countClanMembers(ctx, tx, clanID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case members > 1:
+			return apperrors.Forbidden(clan.ErrOwnerMustTransfer)
+		}
+
+		return execAll(ctx, tx, dbx.StatementBuilder.Delete("clans").Where(squirrel.Eq{"id": clanID}))
+	})
+}
+
+// KickClanMember removes a member with a lower role than the officer or the owner kicking them.
+func (db *Database) KickClanMember(ctx context.Context, clanID, actorID, memberID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		actorRole, txErr := clanRole(ctx, tx, clanID, actorID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case !actorRole.CanManage():
+			return apperrors.Forbidden(clan.ErrNotOfficer)
+		}
+
+		memberRole, txErr := clanRole(ctx, tx, clanID, memberID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case memberRole == clan.RoleUnknown:
+			return apperrors.NotFound("clan member", "user_id", memberID)
+		case !actorRole.Outranks(memberRole):
+			return apperrors.Forbidden(clan.ErrOutranked)
+		}
+
+		return deleteClanMember(ctx, tx, clanID, memberID)
+	})
+}
+
+// SetClanMemberRole promotes a member to an officer or demotes an officer, only the owner can change roles.
+func (db *Database) SetClanMemberRole(ctx context.Context, request *clan.SetMemberRole) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, request.ClanID); txErr != nil {
+			return txErr
+		}
+
+		actorRole, txErr := clanRole(ctx, tx, request.ClanID, request.ActorID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case actorRole != clan.RoleOwner:
+			return apperrors.Forbidden(clan.ErrNotOwner)
+		}
+
+		memberRole, txErr := clanRole(ctx, tx, request.ClanID, request.MemberID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case memberRole == clan.RoleUnknown:
+			return apperrors.NotFound("clan member", "user_id", request.MemberID)
+		}
+
+		return execAll(ctx, tx, updateClanRole(request.ClanID, request.MemberID, request.Role))
+	})
+}
+
+// TransferClanOwnership makes the member the owner, the previous owner stays as an officer.
+func (db *Database) TransferClanOwnership(ctx context.Context, clanID, ownerID, newOwnerID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		ownerRole, txErr := clanRole(ctx, tx, clanID, ownerID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case ownerRole != clan.RoleOwner:
+			return apperrors.Forbidden(clan.ErrNotOwner)
+		}
+
+		memberRole, txErr := clanRole(ctx, tx, clanID, newOwnerID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case memberRole == clan.RoleUnknown:
+			return apperrors.NotFound("clan member", "user_id", newOwnerID)
+		}
+
+		// The owner is demoted first, a clan cannot have two owners at any point.
+		return execAll(ctx, tx,
+			updateClanRole(clanID, ownerID, clan.RoleOfficer),
+			updateClanRole(clanID, newOwnerID, clan.RoleOwner),
+		)
+	})
+}
+
+// DeclineClanInvite drops the invite the user received from the clan.
+func (db *Database) DeclineClanInvite(ctx context.Context, clanID, userID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		return deleteClanApplication(ctx, tx, clanID, userID, "invite", "clan invite")
+	})
+}
+
+// CancelClanJoinRequest withdraws the join request the user sent to the clan.
+func (db *Database) CancelClanJoinRequest(ctx context.Context, clanID, userID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		return deleteClanApplication(ctx, tx, clanID, userID, "request", "clan join request")
+	})
+}
+
+// CancelClanInvite withdraws the invite of the user on behalf of an officer or the owner.
+func (db *Database) CancelClanInvite(ctx context.Context, clanID, actorID, inviteeID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		role, txErr := clanRole(ctx, tx, clanID, actorID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case !role.CanManage():
+			return apperrors.Forbidden(clan.ErrNotOfficer)
+		}
+
+		return deleteClanApplication(ctx, tx, clanID, inviteeID, "invite", "clan invite")
+	})
+}
+
+// DeclineClanJoinRequest drops the join request of the user on behalf of an officer or the owner.
+func (db *Database) DeclineClanJoinRequest(ctx context.Context, clanID, actorID, requesterID uuid.UUID) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockClan(ctx, tx, clanID); txErr != nil {
+			return txErr
+		}
+
+		role, txErr := clanRole(ctx, tx, clanID, actorID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case !role.CanManage():
+			return apperrors.Forbidden(clan.ErrNotOfficer)
+		}
+
+		return deleteClanApplication(ctx, tx, clanID, requesterID, "request", "clan join request")
+	})
+}
+
+// lockClan serializes membership changes of the clan, so the member cap and the roles are checked
+// against the current members.
+func lockClan(ctx context.Context, tx pgx.Tx, clanID uuid.UUID) error {
+	builder := dbx.StatementBuilder.
+		Select("id").
+		From("clans").
+		Where(squirrel.Eq{"id": clanID}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	var id uuid.UUID
+
+	err = tx.QueryRow(ctx, query, args...).Scan(&id)
+	switch {
+	case dbx.IsNoRows(err):
+		return apperrors.NotFound("clan", "id", clanID)
+	case err != nil:
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+// clanRole returns the role of the user in the clan or clan.RoleUnknown when the user is not a member.
+func clanRole(ctx context.Context, q rowQuerier, clanID, userID uuid.UUID) (clan.Role, error) {
+	builder := dbx.StatementBuilder.
+		Select("role").
+		From("clan_members").
+		Where(squirrel.Eq{"clan_id": clanID}).
+		Where(squirrel.Eq{"user_id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return clan.RoleUnknown, apperrors.Internal(err)
+	}
+
+	var role clan.Role
+
+	err = q.QueryRow(ctx, query, args...).Scan(&role)
+	switch {
+	case dbx.IsNoRows(err):
+		return clan.RoleUnknown, nil
+	case err != nil:
+		return clan.RoleUnknown, apperrors.Internal(err)
+	}
+
+	return role, nil
+}
+
+// clanMembership returns nil when the user is not a member of any clan.
+func clanMembership(ctx context.Context, q rowQuerier, userID uuid.UUID) (*clan.Membership, error) {
+	builder := dbx.StatementBuilder.
+		Select("clan_id", "role").
+		From("clan_members").
+		Where(squirrel.Eq{"user_id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var membership clan.Membership
+
+	err = q.QueryRow(ctx, query, args...).Scan(&membership.ClanID, &membership.Role)
+	switch {
+	case dbx.IsNoRows(err):
+		return nil, nil
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	}
+
+	return &membership, nil
+}
+
+// clanApplication returns the kind of the pending invite or join request, empty when there is none.
+func clanApplication(ctx context.Context, tx pgx.Tx, clanID, userID uuid.UUID) (string, error) {
+	builder := dbx.StatementBuilder.
+		Select("kind").
+		From("clan_applications").
+		Where(squirrel.Eq{"clan_id": clanID}).
+		Where(squirrel.Eq{"user_id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return "", apperrors.Internal(err)
+	}
+
+	var kind string
+
+	err = tx.QueryRow(ctx, query, args...).Scan(&kind)
+	switch {
+	case dbx.IsNoRows(err):
+		return "", nil
+	case err != nil:
+		return "", apperrors.Internal(err)
+	}
+
+	return kind, nil
+}
+
+// addClanMember adds the user once they are in no clan and the clan is below the member cap.
+func addClanMember(ctx context.Context, tx pgx.Tx, clanID, userID uuid.UUID, maxMembers int64) error {
+	membership, err := clanMembership(ctx, tx, userID)
+	switch {
+	case err != nil:
+		return err
+	case membership != nil:
+		return apperrors.AlreadyExists("clan member", "user_id", userID)
+	}
+
+	members, err := countClanMembers(ctx, tx, clanID)
+	switch {
+	case err != nil:
+		return err
+	case members >= maxMembers:
+		return apperrors.Forbidden(clan.ErrClanFull)
+	}
+
+	return insertClanMember(ctx, tx, clanID, userID, clan.RoleMember)
+}
+
+// insertClanMember adds the user and drops their other invites and join requests.
+func insertClanMember(ctx context.Context, tx pgx.Tx, clanID, userID uuid.UUID, role clan.Role) error {
+	insert := dbx.StatementBuilder.
+		Insert("clan_members").
+		Columns("user_id", "clan_id", "role").
+		Values(userID, clanID, role)
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	switch {
+	case dbx.IsUniqueViolation(err, "user_id"):
+		return apperrors.AlreadyExists("clan member", "user_id", userID)
+	case dbx.IsForeignKeyViolation(err, "user_id"):
+		return apperrors.NotFound("user", "id", userID)
+	case err != nil:
+		return apperrors.Internal(err)
+	}
+
+	return execAll(ctx, tx, dbx.StatementBuilder.
+		Delete("clan_applications").
+		Where(squirrel.Eq{"user_id": userID}))
+}
+
+// deleteClanApplication deletes the invite or join request of the given kind, entity names it in the NotFound error.
+func deleteClanApplication(ctx context.Context, tx pgx.Tx, clanID, userID uuid.UUID, kind, entity string) error {
+	builder := dbx.StatementBuilder.
+		Delete("clan_applications").
+		Where(squirrel.Eq{"clan_id": clanID}).
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"kind": kind})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	cmd, err := tx.Exec(ctx, query, args...)
+	switch {
+	case err != nil:
+		return apperrors.Internal(err)
+	case cmd.RowsAffected() == 0:
+		return apperrors.NotFound(entity, "user_id", userID)
+	}
+
+	return nil
+}
+
+// handOverClans keeps the clans owned by the users, who are being deleted, alive. The ownership goes to the
+// longest serving officer, then to the longest serving member, deleted members are skipped. A clan left
+// without such a member is disbanded. The previous owner stays as a member.
+func handOverClans(ctx context.Context, tx pgx.Tx, userIDs []uuid.UUID) error {
+	owned := dbx.StatementBuilder.
+		Select("clan_id").
+		From("clan_members").
+		Where(squirrel.Eq{"user_id": userIDs}).
+		Where(squirrel.Eq{"role": clan.RoleOwner}).
+		OrderBy("clan_id")
+
+	query, args, err := owned.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	clanIDs, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	for _, clanID := range clanIDs {
+		if err = lockClan(ctx, tx, clanID); err != nil {
+			return err
+		}
+
+		successor := dbx.StatementBuilder.
+			Select("m.user_id").
+			From("clan_members m").
+			Join("users u ON u.id = m.user_id").
+			Where(squirrel.Eq{"m.clan_id": clanID}).
+			Where(squirrel.NotEq{"m.user_id": userIDs}).
+			Where(squirrel.Eq{"u.deleted_at": nil}).
+			OrderBy("m.role = 'officer' DESC", "m.joined_at", "m.user_id").
+			Limit(1)
+
+		if query, args, err = successor.ToSql(); err != nil {
+			return apperrors.Internal(err)
+		}
+
+		var successorID uuid.UUID
+
+		err = tx.QueryRow(ctx, query, args...).Scan(&successorID)
+		switch {
+		case dbx.IsNoRows(err):
+			err = execAll(ctx, tx, dbx.StatementBuilder.Delete("clans").Where(squirrel.Eq{"id": clanID}))
+		case err != nil:
+			return apperrors.Internal(err)
+		default:
+			// The owner is demoted first, a clan cannot have two owners at any point.
+			err = execAll(ctx, tx,
+				dbx.StatementBuilder.
+					Update("clan_members").
+					Set("role", clan.RoleMember).
+					Where(squirrel.Eq{"clan_id": clanID}).
+					Where(squirrel.Eq{"role": clan.RoleOwner}),
+				updateClanRole(clanID, successorID, clan.RoleOwner),
+			)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteClanMember(ctx context.Context, tx pgx.Tx, clanID, userID uuid.UUID) error {
+	return execAll(ctx, tx, dbx.StatementBuilder.
+		Delete("clan_members").
+		Where(squirrel.Eq{"clan_id": clanID}).
+		Where(squirrel.Eq{"user_id": userID}))
+}
+
+func updateClanRole(clanID, userID uuid.UUID, role clan.Role) squirrel.Sqlizer {
+	return dbx.StatementBuilder.
+		Update("clan_members").
+		Set("role", role).
+		Where(squirrel.Eq{"clan_id": clanID}).
+		Where(squirrel.Eq{"user_id": userID})
+}
+
+// countClanMembers counts the members that are not deleted, deleted members keep their seat until they are purged
+// but do not count towards the member cap.
+func countClanMembers(ctx context.Context, tx pgx.Tx, clanID uuid.UUID) (int64, error) {
+	builder := dbx.StatementBuilder.
+		Select("count(*)").
+		From("clan_members m").
+		Join("users u ON u.id = m.user_id").
+		Where(squirrel.Eq{"m.clan_id": clanID}).
+		Where(squirrel.Eq{"u.deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	var count int64
+
+	if err = tx.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return count, nil
+}
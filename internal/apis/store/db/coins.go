@@ -0,0 +1,229 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+var coinTransactionColumns = []string{
+	"id", "user_id", "amount", "reason", "source", "idempotency_key", "balance_after", "created_at",
+}
+
+// ApplyCoinOperation changes the balance and appends the ledger entry in one transaction.
+func (db *Database) ApplyCoinOperation(ctx context.Context, op *profile.CoinOperation) (*profile.CoinTransaction, error) {
+	var transaction *profile.CoinTransaction
+
+	err := db.inTx(ctx, func(tx pgx.Tx) error {
+		var txErr error
+
+		transaction, txErr = applyCoinOperation(ctx, tx, op)
+
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// applyCoinOperation changes the balance within the transaction. The stats row is locked first,
+// so concurrent operations of a user are serialized and a repeated idempotency key returns
+// the entry recorded by the first operation.
+func applyCoinOperation(ctx context.Context, tx pgx.Tx, op *profile.CoinOperation) (*profile.CoinTransaction, error) {
+	lock := dbx.StatementBuilder.
+		Select("s.coins").
+		From("stats s").
+		Join("users u ON u.id = s.user_id").
+		Where(squirrel.Eq{"s.user_id": op.UserID}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		Suffix("FOR UPDATE OF s")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var balance int64
+
+	err = tx.QueryRow(ctx, lockQuery, lockArgs...).Scan(&balance)
+	switch {
+	case dbx.IsNoRows(err):
+		return nil, apperrors.NotFound("user", "id", op.UserID)
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	}
+
+	existing := dbx.StatementBuilder.
+		Select(coinTransactionColumns...).
+		From("coin_transactions").
+		Where(squirrel.Eq{"user_id": op.UserID}).
+		Where(squirrel.Eq{"idempotency_key": op.IdempotencyKey})
+
+	existingQuery, existingArgs, err := existing.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	transaction, err := scanCoinTransaction(tx.QueryRow(ctx, existingQuery, existingArgs...))
+	switch {
+	case dbx.IsNoRows(err):
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	case transaction.Amount != op.Amount:
+		return nil, apperrors.AlreadyExists("coin transaction", "idempotency_key", op.IdempotencyKey)
+	default:
+		return transaction, nil
+	}
+
+	balance += op.Amount
+	if balance < 0 {
+		return nil, apperrors.BadRequest(errors.New("insufficient coins"))
+	}
+
+	update := dbx.StatementBuilder.
+		Update("stats").
+		Set("coins", balance).
+		Where(squirrel.Eq{"user_id": op.UserID})
+
+	insert := dbx.StatementBuilder.
+		Insert("coin_transactions").
+		Columns("user_id", "amount", "reason", "source", "idempotency_key", "balance_after").
+		Values(op.UserID, op.Amount, op.Reason, op.Source, op.IdempotencyKey, balance).
+		Suffix("RETURNING " + strings.Join(coinTransactionColumns, ", "))
+
+	if err = execAll(ctx, tx, update); err != nil {
+		return nil, err
+	}
+
+	insertQuery, insertArgs, err := insert.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	transaction, err = scanCoinTransaction(tx.QueryRow(ctx, insertQuery, insertArgs...))
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	return transaction, nil
+}
+
+func (db *Database) ListCoinTransactions(ctx context.Context, filter *profile.ListCoinTransactions) (*profile.CoinTransactionsPage, error) {
+	builder := dbx.StatementBuilder.
+		Select(coinTransactionColumns...).
+		From("coin_transactions").
+		Where(squirrel.Eq{"user_id": filter.UserID}).
+		OrderBy("id DESC").
+		Limit(filter.Size + 1)
+
+	if filter.Cursor != nil {
+		builder = builder.Where(squirrel.Lt{"id": *filter.Cursor})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	page := &profile.CoinTransactionsPage{
+		Transactions: make([]*profile.CoinTransaction, 0, filter.Size),
+	}
+
+	for rows.Next() {
+		if uint64(len(page.Transactions)) == filter.Size {
+			last := page.Transactions[len(page.Transactions)-1].ID
+			page.NextCursor = &last
+			break
+		}
+
+		var transaction *profile.CoinTransaction
+		if transaction, err = scanCoinTransaction(rows); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		page.Transactions = append(page.Transactions, transaction)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return page, nil
+}
+
+// FindCoinsMismatches returns users whose balance differs from the sum of their ledger entries.
+func (db *Database) FindCoinsMismatches(ctx context.Context, limit uint64) ([]*profile.CoinsMismatch, error) {
+	builder := dbx.StatementBuilder.
+		Select("s.user_id", "s.coins", "COALESCE(l.total, 0)").
+		From("stats s").
+		LeftJoin("(SELECT user_id, SUM(amount)::BIGINT AS total FROM coin_transactions GROUP BY user_id) l ON l.user_id = s.user_id").
+		Where("s.coins <> COALESCE(l.total, 0)").
+		OrderBy("s.user_id").
+		Limit(limit)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var mismatches []*profile.CoinsMismatch
+
+	for rows.Next() {
+		var m profile.CoinsMismatch
+
+		if err = rows.Scan(&m.UserID, &m.Balance, &m.LedgerSum); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		mismatches = append(mismatches, &m)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return mismatches, nil
+}
+
+func scanCoinTransaction(row pgx.Row) (*profile.CoinTransaction, error) {
+	var t profile.CoinTransaction
+
+	if err := row.Scan(
+		&t.ID,
+		&t.UserID,
+		&t.Amount,
+		&t.Reason,
+		&t.Source,
+		&t.IdempotencyKey,
+		&t.BalanceAfter,
+		&t.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
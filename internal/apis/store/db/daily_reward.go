@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// ClaimDailyReward claims the reward of the current day in the time zone of the user.
+// The streak row is locked first, so of concurrent claims of a day only one succeeds.
+func (db *Database) ClaimDailyReward(ctx context.Context, userID uuid.UUID, now time.Time, table profile.DailyRewardTable) (*profile.DailyRewardClaim, error) {
+	user := dbx.StatementBuilder.
+		Select("time_zone").
+		From("users").
+		Where(squirrel.Eq{"id": userID}).
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Eq{"banned_at": nil})
+
+	userQuery, userArgs, err := user.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	lock := dbx.StatementBuilder.
+		Select("streak", "streak_freezes", "last_claimed_on").
+		From("daily_rewards").
+		Where(squirrel.Eq{"user_id": userID}).
+		Suffix("FOR UPDATE")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	claim := &profile.DailyRewardClaim{UserID: userID}
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		var timeZone string
+
+		txErr := tx.QueryRow(ctx, userQuery, userArgs...).Scan(&timeZone)
+		switch {
+		case dbx.IsNoRows(txErr):
+			return apperrors.NotFound("user", "id", userID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		create := dbx.StatementBuilder.
+			Insert("daily_rewards").
+			Columns("user_id").
+			Values(userID).
+			Suffix("ON CONFLICT DO NOTHING")
+
+		if txErr = execAll(ctx, tx, create); txErr != nil {
+			return txErr
+		}
+
+		var state profile.DailyRewardState
+
+		if txErr = tx.QueryRow(ctx, lockQuery, lockArgs...).Scan(&state.Streak, &state.StreakFreezes, &state.LastClaimedOn); txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		claim.Day = profile.LocalDay(now, timeZone)
+
+		freezeUsed, ok := state.Claim(claim.Day)
+		if !ok {
+			return apperrors.AlreadyExists("daily reward", "day", claim.Day.Format(time.DateOnly))
+		}
+
+		reward := table.For(state.Streak)
+		state.StreakFreezes += reward.StreakFreezes
+
+		claim.Streak = state.Streak
+		claim.Coins = reward.Coins
+		claim.StreakFreezesEarned = reward.StreakFreezes
+		claim.StreakFreezeUsed = freezeUsed
+		claim.StreakFreezes = state.StreakFreezes
+
+		update := dbx.StatementBuilder.
+			Update("daily_rewards").
+			Set("streak", state.Streak).
+			Set("streak_freezes", state.StreakFreezes).
+			Set("last_claimed_on", claim.Day).
+			Set("updated_at", squirrel.Expr("now()")).
+			Where(squirrel.Eq{"user_id": userID})
+
+		if txErr = execAll(ctx, tx, update); txErr != nil {
+			return txErr
+		}
+
+		if claim.Coins > 0 {
+			_, txErr = applyCoinOperation(ctx, tx, &profile.CoinOperation{
+				UserID:         userID,
+				Amount:         claim.Coins,
+				Reason:         "daily_reward",
+				Source:         "daily_rewards",
+				IdempotencyKey: profile.DailyRewardKey(claim.Day),
+			})
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		insert := dbx.StatementBuilder.
+			Insert("daily_reward_claims").
+			Columns("user_id", "day", "streak", "coins", "streak_freezes_earned", "streak_freeze_used").
+			Values(userID, claim.Day, claim.Streak, claim.Coins, claim.StreakFreezesEarned, claim.StreakFreezeUsed).
+			Suffix("RETURNING claimed_at")
+
+		insertQuery, insertArgs, txErr := insert.ToSql()
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		if txErr = tx.QueryRow(ctx, insertQuery, insertArgs...).Scan(&claim.ClaimedAt); txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
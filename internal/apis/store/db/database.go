@@ -1,18 +1,80 @@
 package db
 
 import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
 
 type Database struct {
 	pool   *pgxpool.Pool
+	leader *leader
 	logger *zap.Logger
 }
 
 func NewDatabase(pool *pgxpool.Pool, logger *zap.Logger) *Database {
 	return &Database{
 		pool:   pool,
+		leader: newLeader(pool, leaderLockName),
 		logger: logger,
 	}
 }
+
+// inTx runs fn inside a single transaction, committing only when fn succeeds.
+// Errors returned by fn are passed through untouched.
+func (db *Database) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+// execAll executes the statements one by one within tx.
+func execAll(ctx context.Context, tx pgx.Tx, statements ...squirrel.Sqlizer) error {
+	for _, statement := range statements {
+		query, args, err := statement.ToSql()
+		if err != nil {
+			return apperrors.Internal(err)
+		}
+
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return apperrors.Internal(err)
+		}
+	}
+
+	return nil
+}
+
+// RunLocked runs fn when this replica is the leader elected to run scheduled jobs, one lock covers
+// every job. It reports false without running fn when another replica is the leader.
+func (db *Database) RunLocked(ctx context.Context, _ string, fn func(ctx context.Context) error) (bool, error) {
+	elected, err := db.leader.elect(ctx)
+	if err != nil || !elected {
+		return false, err
+	}
+
+	return true, fn(ctx)
+}
+
+// Close gives up the leadership of the replica.
+func (db *Database) Close(ctx context.Context) error {
+	return db.leader.close(ctx)
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// GetExportRecords reads the rows every table other than users and stats holds about the user.
+// Each table is aggregated into a JSON array in a single batch.
+func (db *Database) GetExportRecords(ctx context.Context, userID uuid.UUID) (*profile.ExportRecords, error) {
+	var records profile.ExportRecords
+
+	sections := []struct {
+		rows squirrel.SelectBuilder
+		dest *json.RawMessage
+	}{
+		{
+			rows: dbx.StatementBuilder.
+				Select("user_id AS requester_id", "friend_id AS recipient_id", "status", "created_at", "updated_at").
+				From("friends").
+				Where(squirrel.Or{
+					squirrel.Eq{"user_id": userID},
+					squirrel.Eq{"friend_id": userID},
+				}).
+				OrderBy("created_at"),
+			dest: &records.Friends,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("id", "amount", "reason", "source", "idempotency_key", "balance_after", "created_at").
+				From("coin_transactions").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("id"),
+			dest: &records.CoinTransactions,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("match_id", "placement", "rating_before", "rating_after", "deviation_before", "deviation_after", "created_at").
+				From("rating_history").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("created_at"),
+			dest: &records.RatingHistory,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("sr.season_id", "s.name", "sr.rating", "sr.rank", "sr.reset_rating").
+				From("season_results sr").
+				Join("seasons s ON s.id = sr.season_id").
+				Where(squirrel.Eq{"sr.user_id": userID}).
+				OrderBy("sr.season_id"),
+			dest: &records.SeasonResults,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("code", "awarded_at").
+				From("user_achievements").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("awarded_at"),
+			dest: &records.Achievements,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("day").
+				From("user_login_days").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("day"),
+			dest: &records.LoginDays,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("event_id", "amount", "source", "level_before", "level_after", "coins_rewarded", "created_at").
+				From("xp_grants").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("created_at"),
+			dest: &records.XPGrants,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("streak", "streak_freezes", "last_claimed_on", "updated_at").
+				From("daily_rewards").
+				Where(squirrel.Eq{"user_id": userID}),
+			dest: &records.DailyRewards,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("day", "streak", "coins", "streak_freezes_earned", "streak_freeze_used", "claimed_at").
+				From("daily_reward_claims").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("day"),
+			dest: &records.DailyRewardClaims,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("category_id", "answered", "correct", "answer_time_ms", "updated_at").
+				From("category_stats").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("category_id"),
+			dest: &records.CategoryStats,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("mp.match_id", "m.mode", "m.category_ids", "m.started_at", "mp.ended_at", "mp.score", "mp.placement", "mp.rating_delta").
+				From("match_participants mp").
+				Join("matches m ON m.id = mp.match_id").
+				Where(squirrel.Eq{"mp.user_id": userID}).
+				OrderBy("mp.ended_at", "mp.match_id"),
+			dest: &records.Matches,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("item_code", "kind", "acquired_at").
+				From("user_inventory").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("acquired_at"),
+			dest: &records.Inventory,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("idempotency_key", "item_code", "price", "balance_after", "purchased_at").
+				From("item_purchases").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("purchased_at"),
+			dest: &records.Purchases,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("blocked_id", "created_at").
+				From("user_blocks").
+				Where(squirrel.Eq{"blocker_id": userID}).
+				OrderBy("created_at"),
+			dest: &records.Blocks,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("cm.clan_id", "c.tag", "c.name", "cm.role", "cm.joined_at").
+				From("clan_members cm").
+				Join("clans c ON c.id = cm.clan_id").
+				Where(squirrel.Eq{"cm.user_id": userID}),
+			dest: &records.ClanMembership,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("clan_id", "kind", "created_at").
+				From("clan_applications").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("created_at"),
+			dest: &records.ClanApplications,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("id", "reported_id", "category", "text", "match_id", "status", "created_at", "closed_at").
+				From("user_reports").
+				Where(squirrel.Eq{"reporter_id": userID}).
+				OrderBy("created_at"),
+			dest: &records.ReportsFiled,
+		},
+		{
+			rows: dbx.StatementBuilder.
+				Select("id", "kind", "actor_id", "party_id", "achievement_code", "level", "created_at", "read_at").
+				From("notifications").
+				Where(squirrel.Eq{"user_id": userID}).
+				OrderBy("created_at", "id"),
+			dest: &records.Notifications,
+		},
+	}
+
+	b := &pgx.Batch{}
+
+	for _, section := range sections {
+		builder := dbx.StatementBuilder.
+			Select("coalesce(json_agg(t), '[]'::json)").
+			FromSelect(section.rows, "t")
+
+		if err := dbx.QueryBatch(b, builder); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+	}
+
+	br := db.pool.SendBatch(ctx, b)
+	defer func() {
+		_ = br.Close()
+	}()
+
+	for _, section := range sections {
+		if err := br.QueryRow().Scan(section.dest); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+	}
+
+	return &records, nil
+}
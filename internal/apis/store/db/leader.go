@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// leaderLockName names the advisory lock held by the replica that runs scheduled jobs.
+const leaderLockName = "scheduler"
+
+// leader elects the replica that runs scheduled jobs. The elected replica holds a session advisory
+// lock on a dedicated connection for as long as it lives, the lock goes away with the connection,
+// so another replica takes over on its next run once the leader stops or loses the connection.
+type leader struct {
+	pool *pgxpool.Pool
+	key  int64
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+func newLeader(pool *pgxpool.Pool, name string) *leader {
+	key := fnv.New64a()
+	_, _ = key.Write([]byte(name))
+
+	return &leader{
+		pool: pool,
+		key:  int64(key.Sum64()),
+	}
+}
+
+// elect reports whether this replica is the leader, taking the lock when no replica holds it.
+// A held lock is checked on its connection first, a lost connection means the lock was released.
+func (l *leader) elect(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err == nil {
+			return true, nil
+		}
+
+		_ = l.drop(ctx)
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, apperrors.Internal(err)
+	}
+
+	l.conn = conn
+
+	var locked bool
+
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+		_ = l.drop(ctx)
+		return false, apperrors.Internal(err)
+	}
+
+	if !locked {
+		l.conn = nil
+		conn.Release()
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// close gives up the leadership, another replica takes it over on its next run.
+func (l *leader) close(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	return l.drop(ctx)
+}
+
+// drop closes the connection of the lock instead of returning it to the pool, which releases the lock.
+func (l *leader) drop(ctx context.Context) error {
+	conn := l.conn.Hijack()
+	l.conn = nil
+
+	return conn.Close(ctx)
+}
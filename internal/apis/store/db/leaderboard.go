@@ -0,0 +1,180 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// rankedUsers ranks active users by rating, ties are ordered by id the same way Redis orders sorted set members.
+func rankedUsers(where ...squirrel.Sqlizer) squirrel.SelectBuilder {
+	builder := dbx.StatementBuilder.
+		Select("u.id", "u.username", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "u.country").
+		Column("ROW_NUMBER() OVER (ORDER BY s.rating DESC, u.id DESC) AS rank").
+		From("users u").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
+
+	for _, w := range where {
+		builder = builder.Where(w)
+	}
+
+	return builder
+}
+
+func countryFilter(country *string) []squirrel.Sqlizer {
+	if country == nil {
+		return nil
+	}
+
+	return []squirrel.Sqlizer{squirrel.Eq{"u.country": *country}}
+}
+
+func (db *Database) GetLeaderboard(ctx context.Context, filter *profile.LeaderboardFilter) ([]*profile.LeaderboardEntry, error) {
+	builder := dbx.StatementBuilder.
+		Select("r.id", "r.username", "r.avatar_id", "r.rating", "r.level", "r.xp", "r.level_xp", "r.next_level_xp", "r.created_at", "r.last_login_at", "r.privacy", "r.country", "r.rank").
+		FromSelect(rankedUsers(countryFilter(filter.Country)...), "r").
+		OrderBy("r.rank").
+		Offset(filter.Offset).
+		Limit(filter.Limit)
+
+	return db.queryLeaderboard(ctx, builder)
+}
+
+func (db *Database) GetFriendsLeaderboard(ctx context.Context, userID uuid.UUID) ([]*profile.LeaderboardEntry, error) {
+	friends := squirrel.Or{
+		squirrel.Eq{"u.id": userID},
+		squirrel.Expr(`u.id IN (
+			SELECT CASE WHEN f.user_id = ? THEN f.friend_id ELSE f.user_id END
+			FROM friends f
+			WHERE (f.user_id = ? OR f.friend_id = ?) AND f.status = 'accepted'
+		)`, userID, userID, userID),
+	}
+
+	builder := dbx.StatementBuilder.
+		Select("r.id", "r.username", "r.avatar_id", "r.rating", "r.level", "r.xp", "r.level_xp", "r.next_level_xp", "r.created_at", "r.last_login_at", "r.privacy", "r.country", "r.rank").
+		FromSelect(rankedUsers(friends), "r").
+		OrderBy("r.rank")
+
+	return db.queryLeaderboard(ctx, builder)
+}
+
+// GetLeaderboardRank returns the one-based rank of the user within the whole or a country ranking.
+func (db *Database) GetLeaderboardRank(ctx context.Context, userID uuid.UUID, country *string) (uint64, error) {
+	builder := dbx.StatementBuilder.
+		Select("r.rank").
+		FromSelect(rankedUsers(countryFilter(country)...), "r").
+		Where(squirrel.Eq{"r.id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	var rank int64
+
+	err = db.pool.QueryRow(ctx, query, args...).Scan(&rank)
+	switch {
+	case dbx.IsNoRows(err):
+		return 0, apperrors.NotFound("user", "id", userID)
+	case err != nil:
+		return 0, apperrors.Internal(err)
+	}
+
+	return uint64(rank), nil
+}
+
+// GetLeaderboardStandings returns rating and country of every active user.
+func (db *Database) GetLeaderboardStandings(ctx context.Context) ([]*profile.User, error) {
+	builder := dbx.StatementBuilder.
+		Select("u.id", "s.rating", "u.country").
+		From("users u").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var users []*profile.User
+
+	for rows.Next() {
+		var user profile.User
+
+		if err = rows.Scan(&user.ID, &user.Rating, &user.Country); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		users = append(users, &user)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return users, nil
+}
+
+func (db *Database) queryLeaderboard(ctx context.Context, builder squirrel.SelectBuilder) ([]*profile.LeaderboardEntry, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var entries []*profile.LeaderboardEntry
+
+	for rows.Next() {
+		var (
+			user profile.User
+			rank int64
+		)
+
+		if err = rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.AvatarID,
+			&user.Rating,
+			&user.Level,
+			&user.XP,
+			&user.LevelXP,
+			&user.NextLevelXP,
+			&user.CreatedAt,
+			&user.LastLoginAt,
+			&user.Privacy,
+			&user.Country,
+			&rank,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		entries = append(entries, &profile.LeaderboardEntry{Rank: uint64(rank), User: &user})
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return entries, nil
+}
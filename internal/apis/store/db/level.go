@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// GrantXP adds the XP, advances the level on the curve and credits coinsPerLevel for every level gained
+// in one transaction. The stats row is locked first, so a repeated event id returns the recorded grant.
+func (db *Database) GrantXP(ctx context.Context, grant *profile.GrantXP, curve profile.LevelCurve, coinsPerLevel int64) (*profile.XPGrant, error) {
+	lock := dbx.StatementBuilder.
+		Select("s.level", "s.xp", "s.level_xp", "s.next_level_xp").
+		From("stats s").
+		Join("users u ON u.id = s.user_id").
+		Where(squirrel.Eq{"s.user_id": grant.UserID}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		Suffix("FOR UPDATE OF s")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	existing := dbx.StatementBuilder.
+		Select("amount", "source", "level_before", "level_after", "coins_rewarded", "created_at").
+		From("xp_grants").
+		Where(squirrel.Eq{"user_id": grant.UserID}).
+		Where(squirrel.Eq{"event_id": grant.EventID})
+
+	existingQuery, existingArgs, err := existing.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	result := &profile.XPGrant{
+		UserID:  grant.UserID,
+		EventID: grant.EventID,
+	}
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		var progress profile.LevelProgress
+
+		txErr := tx.QueryRow(ctx, lockQuery, lockArgs...).
+			Scan(&progress.Level, &progress.XP, &progress.LevelXP, &progress.NextLevelXP)
+		switch {
+		case dbx.IsNoRows(txErr):
+			return apperrors.NotFound("user", "id", grant.UserID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		txErr = tx.QueryRow(ctx, existingQuery, existingArgs...).
+			Scan(&result.Amount, &result.Source, &result.LevelBefore, &result.LevelAfter, &result.CoinsRewarded, &result.CreatedAt)
+		switch {
+		case dbx.IsNoRows(txErr):
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		case result.Amount != grant.Amount:
+			return apperrors.AlreadyExists("xp grant", "event_id", grant.EventID)
+		default:
+			result.Progress = progress
+			result.Replayed = true
+
+			return nil
+		}
+
+		result.Amount = grant.Amount
+		result.Source = grant.Source
+		result.LevelBefore = progress.Level
+		result.Progress = curve.Advance(progress, grant.Amount)
+		result.LevelAfter = result.Progress.Level
+		result.CoinsRewarded = int64(result.LevelAfter-result.LevelBefore) * coinsPerLevel
+
+		update := dbx.StatementBuilder.
+			Update("stats").
+			Set("level", result.Progress.Level).
+			Set("xp", result.Progress.XP).
+			Set("level_xp", result.Progress.LevelXP).
+			Set("next_level_xp", result.Progress.NextLevelXP).
+			Where(squirrel.Eq{"user_id": grant.UserID})
+
+		if txErr = execAll(ctx, tx, update); txErr != nil {
+			return txErr
+		}
+
+		if result.CoinsRewarded > 0 {
+			_, txErr = applyCoinOperation(ctx, tx, &profile.CoinOperation{
+				UserID:         grant.UserID,
+				Amount:         result.CoinsRewarded,
+				Reason:         "level_up",
+				Source:         grant.Source,
+				IdempotencyKey: profile.LevelUpKey(grant.EventID),
+			})
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		insert := dbx.StatementBuilder.
+			Insert("xp_grants").
+			Columns("user_id", "event_id", "amount", "source", "level_before", "level_after", "coins_rewarded").
+			Values(grant.UserID, grant.EventID, result.Amount, result.Source, result.LevelBefore, result.LevelAfter, result.CoinsRewarded).
+			Suffix("RETURNING created_at")
+
+		insertQuery, insertArgs, txErr := insert.ToSql()
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		if txErr = tx.QueryRow(ctx, insertQuery, insertArgs...).Scan(&result.CreatedAt); txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// RecordMatch stores a finished match with its participants, a match that was already recorded is skipped.
+// When the match was rated before it was recorded, the rating changes are copied to the participants.
+func (db *Database) RecordMatch(ctx context.Context, match *profile.MatchRecord) error {
+	userIDs := make([]uuid.UUID, len(match.Participants))
+	for i, p := range match.Participants {
+		userIDs[i] = p.UserID
+	}
+
+	record := dbx.StatementBuilder.
+		Insert("matches").
+		Columns("id", "mode", "category_ids", "started_at", "ended_at").
+		Values(match.MatchID, match.Mode, match.CategoryIDs, match.StartedAt, match.EndedAt).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	recordQuery, recordArgs, err := record.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	users := dbx.StatementBuilder.
+		Select("id").
+		From("users").
+		Where("id = ANY(?)", userIDs)
+
+	usersQuery, usersArgs, err := users.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	participants := dbx.StatementBuilder.
+		Insert("match_participants").
+		Columns("match_id", "user_id", "score", "placement", "ended_at")
+
+	for _, p := range match.Participants {
+		participants = participants.Values(match.MatchID, p.UserID, p.Score, p.Placement, match.EndedAt)
+	}
+
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		cmd, txErr := tx.Exec(ctx, recordQuery, recordArgs...)
+		switch {
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		case cmd.RowsAffected() == 0:
+			return nil
+		}
+
+		rows, txErr := tx.Query(ctx, usersQuery, usersArgs...)
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(userIDs))
+		for rows.Next() {
+			var id uuid.UUID
+			if txErr = rows.Scan(&id); txErr != nil {
+				rows.Close()
+				return apperrors.Internal(txErr)
+			}
+
+			found[id] = struct{}{}
+		}
+
+		rows.Close()
+
+		if rows.Err() != nil {
+			return apperrors.Internal(rows.Err())
+		}
+
+		for _, id := range userIDs {
+			if _, ok := found[id]; !ok {
+				return apperrors.NotFound("user", "id", id)
+			}
+		}
+
+		return execAll(ctx, tx, participants, matchRatingDeltas(match.MatchID))
+	})
+}
+
+// matchRatingDeltas copies the rating changes of a rated match to its recorded participants.
+func matchRatingDeltas(matchID uuid.UUID) squirrel.Sqlizer {
+	return dbx.StatementBuilder.
+		Update("match_participants mp").
+		Set("rating_delta", squirrel.Expr("rh.rating_after - rh.rating_before")).
+		From("rating_history rh").
+		Where("rh.match_id = mp.match_id").
+		Where("rh.user_id = mp.user_id").
+		Where(squirrel.Eq{"mp.match_id": matchID})
+}
+
+func (db *Database) ListMatchHistory(ctx context.Context, filter *profile.ListMatchHistory) (*profile.MatchHistoryPage, error) {
+	builder := dbx.StatementBuilder.
+		Select("m.id", "m.mode", "m.category_ids", "mp.score", "mp.placement", "mp.rating_delta", "m.started_at", "mp.ended_at").
+		From("match_participants mp").
+		Join("matches m ON m.id = mp.match_id").
+		Where(squirrel.Eq{"mp.user_id": filter.UserID}).
+		OrderBy("mp.ended_at DESC", "mp.match_id DESC").
+		Limit(filter.Size + 1)
+
+	if filter.Mode != nil {
+		builder = builder.Where(squirrel.Eq{"m.mode": *filter.Mode})
+	}
+
+	if filter.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"mp.ended_at": *filter.From})
+	}
+
+	if filter.To != nil {
+		builder = builder.Where(squirrel.Lt{"mp.ended_at": *filter.To})
+	}
+
+	if filter.Cursor != nil {
+		builder = builder.Where("(mp.ended_at, mp.match_id) < (?, ?)", filter.Cursor.EndedAt, filter.Cursor.MatchID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	page := &profile.MatchHistoryPage{
+		Matches: make([]*profile.MatchHistoryEntry, 0, filter.Size),
+	}
+
+	for rows.Next() {
+		if uint64(len(page.Matches)) == filter.Size {
+			last := page.Matches[len(page.Matches)-1]
+			page.NextCursor = &profile.MatchCursor{EndedAt: last.EndedAt, MatchID: last.MatchID}
+			break
+		}
+
+		var entry profile.MatchHistoryEntry
+
+		if err = rows.Scan(
+			&entry.MatchID,
+			&entry.Mode,
+			&entry.CategoryIDs,
+			&entry.Score,
+			&entry.Placement,
+			&entry.RatingDelta,
+			&entry.StartedAt,
+			&entry.EndedAt,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		page.Matches = append(page.Matches, &entry)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return page, nil
+}
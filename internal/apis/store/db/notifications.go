@@ -0,0 +1,187 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// CreateNotifications stores the notifications, their ids and creation times are assigned here
+// so that they can be published as stored.
+func (db *Database) CreateNotifications(ctx context.Context, notifications []*profile.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	builder := dbx.StatementBuilder.
+		Insert("notifications").
+		Columns("id", "user_id", "kind", "actor_id", "party_id", "achievement_code", "level", "created_at")
+
+	for _, n := range notifications {
+		n.ID = uuid.New()
+		n.CreatedAt = now
+
+		builder = builder.Values(n.ID, n.UserID, n.Kind, n.ActorID, n.PartyID, n.AchievementCode, n.Level, n.CreatedAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	if _, err = db.pool.Exec(ctx, query, args...); err != nil {
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+// ListNotifications returns a page of the notifications of the user, newest first, with the amount of unread ones.
+func (db *Database) ListNotifications(ctx context.Context, filter *profile.ListNotifications) (*profile.NotificationsPage, error) {
+	builder := dbx.StatementBuilder.
+		Select("id", "user_id", "kind", "actor_id", "party_id", "achievement_code", "level", "created_at", "read_at").
+		From("notifications").
+		Where(squirrel.Eq{"user_id": filter.UserID}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(filter.Size + 1)
+
+	if filter.UnreadOnly {
+		builder = builder.Where(squirrel.Eq{"read_at": nil})
+	}
+
+	if filter.Cursor != nil {
+		builder = builder.Where("(created_at, id) < (?, ?)", filter.Cursor.CreatedAt, filter.Cursor.ID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	page := &profile.NotificationsPage{
+		Notifications: make([]*profile.Notification, 0, filter.Size),
+	}
+
+	for rows.Next() {
+		if uint64(len(page.Notifications)) == filter.Size {
+			last := page.Notifications[len(page.Notifications)-1]
+			page.NextCursor = &profile.NotificationCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+			break
+		}
+
+		var n profile.Notification
+
+		if err = rows.Scan(
+			&n.ID,
+			&n.UserID,
+			&n.Kind,
+			&n.ActorID,
+			&n.PartyID,
+			&n.AchievementCode,
+			&n.Level,
+			&n.CreatedAt,
+			&n.ReadAt,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		page.Notifications = append(page.Notifications, &n)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	if page.UnreadCount, err = countUnreadNotifications(ctx, db.pool, filter.UserID); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// MarkNotificationsRead marks the notifications of the user as read and returns the amount still unread.
+// Every notification of the user is marked when no ids are given, ids of other users are ignored.
+func (db *Database) MarkNotificationsRead(ctx context.Context, request *profile.MarkRead) (int64, error) {
+	builder := dbx.StatementBuilder.
+		Update("notifications").
+		Set("read_at", time.Now()).
+		Where(squirrel.Eq{"user_id": request.UserID}).
+		Where(squirrel.Eq{"read_at": nil})
+
+	if len(request.IDs) > 0 {
+		builder = builder.Where(squirrel.Eq{"id": request.IDs})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	if _, err = db.pool.Exec(ctx, query, args...); err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return countUnreadNotifications(ctx, db.pool, request.UserID)
+}
+
+// PurgeNotifications deletes at most limit notifications created before createdBefore, oldest first.
+func (db *Database) PurgeNotifications(ctx context.Context, createdBefore time.Time, limit uint64) (int, error) {
+	expired := dbx.StatementBuilder.
+		Select("id").
+		From("notifications").
+		Where(squirrel.Lt{"created_at": createdBefore}).
+		OrderBy("created_at ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builder := dbx.StatementBuilder.
+		Delete("notifications").
+		Where(squirrel.Expr("id IN (?)", expired))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	cmd, err := db.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return int(cmd.RowsAffected()), nil
+}
+
+func countUnreadNotifications(ctx context.Context, q rowQuerier, userID uuid.UUID) (int64, error) {
+	builder := dbx.StatementBuilder.
+		Select("count(*)").
+		From("notifications").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"read_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	var count int64
+	if err = q.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return count, nil
+}
@@ -10,15 +10,17 @@ import (
 	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
 	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/jackc/pgx/v5"
 )
 
 func (db *Database) GetProfile(ctx context.Context, userID uuid.UUID) (*profile.Profile, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.coins", "u.created_at", "u.last_login_at").
+		Select("u.id", "u.username", "u.email", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "s.coins", "u.created_at", "u.last_login_at", "u.privacy", "u.country", "u.time_zone").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.id": userID}).
-		Where(squirrel.Eq{"u.deleted_at": nil})
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -36,9 +38,16 @@ func (db *Database) GetProfile(ctx context.Context, userID uuid.UUID) (*profile.
 			&prof.Email,
 			&prof.User.AvatarID,
 			&prof.User.Rating,
+			&prof.User.Level,
+			&prof.User.XP,
+			&prof.User.LevelXP,
+			&prof.User.NextLevelXP,
 			&prof.Coins,
 			&prof.User.CreatedAt,
 			&prof.User.LastLoginAt,
+			&prof.User.Privacy,
+			&prof.User.Country,
+			&prof.TimeZone,
 		)
 
 	switch {
@@ -53,11 +62,12 @@ func (db *Database) GetProfile(ctx context.Context, userID uuid.UUID) (*profile.
 
 func (db *Database) GetUserByID(ctx context.Context, userID uuid.UUID) (*profile.User, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.avatar_id", "s.rating", "u.created_at", "u.last_login_at").
+		Select("u.id", "u.username", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "u.country").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.id": userID}).
-		Where(squirrel.Eq{"u.deleted_at": nil})
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -72,8 +82,14 @@ func (db *Database) GetUserByID(ctx context.Context, userID uuid.UUID) (*profile
 			&user.Username,
 			&user.AvatarID,
 			&user.Rating,
+			&user.Level,
+			&user.XP,
+			&user.LevelXP,
+			&user.NextLevelXP,
 			&user.CreatedAt,
 			&user.LastLoginAt,
+			&user.Privacy,
+			&user.Country,
 		)
 
 	switch {
@@ -88,11 +104,12 @@ func (db *Database) GetUserByID(ctx context.Context, userID uuid.UUID) (*profile
 
 func (db *Database) GetUserByUsername(ctx context.Context, username string) (*profile.User, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.username", "u.avatar_id", "s.rating", "u.created_at", "u.last_login_at").
+		Select("u.id", "u.username", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "u.country").
 		From("users u").
 		Join("stats s ON s.user_id = u.id").
 		Where(squirrel.Eq{"u.username": username}).
-		Where(squirrel.Eq{"u.deleted_at": nil})
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -107,8 +124,14 @@ func (db *Database) GetUserByUsername(ctx context.Context, username string) (*pr
 			&user.Username,
 			&user.AvatarID,
 			&user.Rating,
+			&user.Level,
+			&user.XP,
+			&user.LevelXP,
+			&user.NextLevelXP,
 			&user.CreatedAt,
 			&user.LastLoginAt,
+			&user.Privacy,
+			&user.Country,
 		)
 
 	switch {
@@ -121,16 +144,86 @@ func (db *Database) GetUserByUsername(ctx context.Context, username string) (*pr
 	return &user, nil
 }
 
+func (db *Database) GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*profile.User, error) {
+	builder := dbx.StatementBuilder.
+		Select("u.id", "u.username", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "u.country").
+		From("users u").
+		Join("stats s ON s.user_id = u.id").
+		Where("u.id = ANY(?)", userIDs).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	users := make([]*profile.User, 0, len(userIDs))
+
+	for rows.Next() {
+		var user profile.User
+
+		if err = rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.AvatarID,
+			&user.Rating,
+			&user.Level,
+			&user.XP,
+			&user.LevelXP,
+			&user.NextLevelXP,
+			&user.CreatedAt,
+			&user.LastLoginAt,
+			&user.Privacy,
+			&user.Country,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		users = append(users, &user)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return users, nil
+}
+
 func (db *Database) UpdateProfile(ctx context.Context, userID uuid.UUID, request *profile.UpdateProfile) error {
 	builder := dbx.StatementBuilder.
 		Update("users").
 		Where(squirrel.Eq{"id": userID}).
-		Where(squirrel.Eq{"deleted_at": nil})
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Eq{"banned_at": nil})
 
 	if request.Username != nil {
 		builder = builder.Set("username", *request.Username)
 	}
 
+	if request.Privacy != nil {
+		builder = builder.Set("privacy", request.Privacy.String())
+	}
+
+	if request.Country != nil {
+		if *request.Country == "" {
+			builder = builder.Set("country", nil)
+		} else {
+			builder = builder.Set("country", *request.Country)
+		}
+	}
+
+	if request.TimeZone != nil {
+		builder = builder.Set("time_zone", *request.TimeZone)
+	}
+
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return apperrors.Internal(err)
@@ -155,7 +248,8 @@ func (db *Database) UpdateProfileAvatar(ctx context.Context, userID uuid.UUID, a
 		Update("users").
 		Set("avatar_id", avatarID).
 		Where(squirrel.Eq{"id": userID}).
-		Where(squirrel.Eq{"deleted_at": nil})
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Eq{"banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -179,7 +273,8 @@ func (db *Database) UpdateProfilePassword(ctx context.Context, userID uuid.UUID,
 		Update("users").
 		Set("pass_hash", password).
 		Where(squirrel.Eq{"id": userID}).
-		Where(squirrel.Eq{"deleted_at": nil})
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Where(squirrel.Eq{"banned_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -198,69 +293,95 @@ func (db *Database) UpdateProfilePassword(ctx context.Context, userID uuid.UUID,
 	return nil
 }
 
-func (db *Database) SetProfileRating(ctx context.Context, userID uuid.UUID, rating int32) error {
+// DeleteProfile soft deletes the user and hands the clan the user owns over to another member.
+func (db *Database) DeleteProfile(ctx context.Context, userID uuid.UUID) error {
 	builder := dbx.StatementBuilder.
-		Update("stats").
-		Set("rating", rating).
-		Where(squirrel.Eq{"user_id": userID})
+		Update("users").
+		Set("deleted_at", time.Now()).
+		Where(squirrel.Eq{"id": userID}).
+		Where(squirrel.Eq{"deleted_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return apperrors.Internal(err)
 	}
 
-	cmd, err := db.pool.Exec(ctx, query, args...)
-	switch {
-	case err != nil:
-		return apperrors.Internal(err)
-	case cmd.RowsAffected() == 0:
-		return apperrors.NotFound("user", "id", userID)
-	}
-
-	return nil
-}
-
-func (db *Database) SetProfileCoins(ctx context.Context, userID uuid.UUID, coins int64) error {
-	builder := dbx.StatementBuilder.
-		Update("stats").
-		Set("coins", coins).
-		Where(squirrel.Eq{"user_id": userID})
-
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return apperrors.Internal(err)
-	}
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		cmd, txErr := tx.Exec(ctx, query, args...)
 
-	cmd, err := db.pool.Exec(ctx, query, args...)
-	switch {
-	case err != nil:
-		return apperrors.Internal(err)
-	case cmd.RowsAffected() == 0:
-		return apperrors.NotFound("user", "id", userID)
-	}
+		switch {
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		case cmd.RowsAffected() == 0:
+			return apperrors.NotFound("user", "id", userID)
+		}
 
-	return nil
+		return handOverClans(ctx, tx, []uuid.UUID{userID})
+	})
 }
 
-func (db *Database) DeleteProfile(ctx context.Context, userID uuid.UUID) error {
+func (db *Database) PurgeDeletedProfiles(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
 	builder := dbx.StatementBuilder.
-		Update("users").
-		Set("deleted_at", time.Now()).
-		Where(squirrel.Eq{"id": userID})
+		Select("id").
+		From("users").
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		Where(squirrel.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return apperrors.Internal(err)
+		return 0, apperrors.Internal(err)
 	}
 
-	cmd, err := db.pool.Exec(ctx, query, args...)
-
-	switch {
-	case err != nil:
-		return apperrors.Internal(err)
-	case cmd.RowsAffected() == 0:
-		return apperrors.NotFound("user", "id", userID)
+	var purged int
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		rows, txErr := tx.Query(ctx, query, args...)
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		ids, txErr := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if txErr = handOverClans(ctx, tx, ids); txErr != nil {
+			return txErr
+		}
+
+		statements := []squirrel.Sqlizer{
+			dbx.StatementBuilder.
+				Delete("friends").
+				Where(squirrel.Or{
+					squirrel.Eq{"user_id": ids},
+					squirrel.Eq{"friend_id": ids},
+				}),
+			dbx.StatementBuilder.
+				Delete("stats").
+				Where(squirrel.Eq{"user_id": ids}),
+			dbx.StatementBuilder.
+				Delete("users").
+				Where(squirrel.Eq{"id": ids}),
+		}
+
+		if txErr = execAll(ctx, tx, statements...); txErr != nil {
+			return txErr
+		}
+
+		purged = len(ids)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	return purged, nil
 }
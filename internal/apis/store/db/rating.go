@@ -0,0 +1,169 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// ApplyMatchRatings records the match and updates ratings of its participants in one transaction.
+// A match that was already rated is not rated again, its recorded changes are returned instead.
+// The rating changes of a match already in the match history are copied to its participants.
+func (db *Database) ApplyMatchRatings(ctx context.Context, result *profile.MatchResult, algorithm string, rate profile.RateFunc) (*profile.MatchRatings, error) {
+	userIDs := make([]uuid.UUID, len(result.Participants))
+	for i, p := range result.Participants {
+		userIDs[i] = p.UserID
+	}
+
+	record := dbx.StatementBuilder.
+		Insert("rated_matches").
+		Columns("match_id", "algorithm").
+		Values(result.MatchID, algorithm).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	recordQuery, recordArgs, err := record.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	lock := dbx.StatementBuilder.
+		Select("s.user_id", "s.rating", "s.rating_deviation", "s.rating_volatility").
+		From("stats s").
+		Join("users u ON u.id = s.user_id").
+		Where("s.user_id = ANY(?)", userIDs).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		OrderBy("s.user_id").
+		Suffix("FOR UPDATE OF s")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	ratings := &profile.MatchRatings{MatchID: result.MatchID}
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		cmd, txErr := tx.Exec(ctx, recordQuery, recordArgs...)
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		if cmd.RowsAffected() == 0 {
+			ratings.Changes, txErr = matchRatingChanges(ctx, tx, result.MatchID)
+			return txErr
+		}
+
+		states, txErr := lockRatingStates(ctx, tx, lockQuery, lockArgs)
+		if txErr != nil {
+			return txErr
+		}
+
+		ordered := make([]*profile.RatingState, len(result.Participants))
+		for i, p := range result.Participants {
+			state, ok := states[p.UserID]
+			if !ok {
+				return apperrors.NotFound("user", "id", p.UserID)
+			}
+
+			ordered[i] = state
+		}
+
+		ratings.Changes = rate(result.Participants, ordered)
+
+		statements := make([]squirrel.Sqlizer, 0, len(ratings.Changes)+1)
+		history := dbx.StatementBuilder.
+			Insert("rating_history").
+			Columns("match_id", "user_id", "placement", "rating_before", "rating_after", "deviation_before", "deviation_after")
+
+		for _, c := range ratings.Changes {
+			statements = append(statements, dbx.StatementBuilder.
+				Update("stats").
+				Set("rating", c.RatingAfter).
+				Set("rating_deviation", c.DeviationAfter).
+				Set("rating_volatility", c.Volatility).
+				Where(squirrel.Eq{"user_id": c.UserID}),
+			)
+
+			history = history.Values(result.MatchID, c.UserID, c.Placement, c.RatingBefore, c.RatingAfter, c.DeviationBefore, c.DeviationAfter)
+		}
+
+		return execAll(ctx, tx, append(statements, history, matchRatingDeltas(result.MatchID))...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ratings, nil
+}
+
+func lockRatingStates(ctx context.Context, tx pgx.Tx, query string, args []any) (map[uuid.UUID]*profile.RatingState, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	states := make(map[uuid.UUID]*profile.RatingState)
+
+	for rows.Next() {
+		var state profile.RatingState
+
+		if err = rows.Scan(&state.UserID, &state.Rating, &state.Deviation, &state.Volatility); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		states[state.UserID] = &state
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return states, nil
+}
+
+func matchRatingChanges(ctx context.Context, tx pgx.Tx, matchID uuid.UUID) ([]*profile.RatingChange, error) {
+	builder := dbx.StatementBuilder.
+		Select("user_id", "placement", "rating_before", "rating_after", "deviation_before", "deviation_after").
+		From("rating_history").
+		Where(squirrel.Eq{"match_id": matchID}).
+		OrderBy("placement", "user_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var changes []*profile.RatingChange
+
+	for rows.Next() {
+		var c profile.RatingChange
+
+		if err = rows.Scan(&c.UserID, &c.Placement, &c.RatingBefore, &c.RatingAfter, &c.DeviationBefore, &c.DeviationAfter); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		changes = append(changes, &c)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,391 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
+)
+
+var reportColumns = []string{
+	"id", "reporter_id", "reported_id", "category", "text", "match_id",
+	"status", "moderator_id", "action", "created_at", "claimed_at", "closed_at",
+}
+
+// CreateReport files the report. It returns AlreadyExists when the reporter already has a report
+// of the category about the user waiting for a moderator, and Forbidden when the reporter filed
+// too many reports within the window of the limits.
+func (db *Database) CreateReport(ctx context.Context, request *report.ReportUser, limits report.Limits) (*report.Report, error) {
+	insert := dbx.StatementBuilder.
+		Insert("user_reports").
+		Columns("reporter_id", "reported_id", "category", "text", "match_id").
+		Values(request.ReporterID, request.ReportedID, request.Category, request.Text, request.MatchID).
+		Suffix("ON CONFLICT (reporter_id, reported_id, category) WHERE status IN ('open', 'claimed') DO NOTHING RETURNING " +
+			strings.Join(reportColumns, ", "))
+
+	insertQuery, insertArgs, err := insert.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var created *report.Report
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockReporter(ctx, tx, request.ReporterID); txErr != nil {
+			return txErr
+		}
+
+		if limits.MaxPerWindow > 0 {
+			filed, txErr := countReports(ctx, tx, request.ReporterID, time.Now().Add(-limits.Window))
+			switch {
+			case txErr != nil:
+				return txErr
+			case filed >= limits.MaxPerWindow:
+				return apperrors.Forbidden(report.ErrReportLimitReached)
+			}
+		}
+
+		if request.MatchID != nil {
+			shared, txErr := sharedMatch(ctx, tx, *request.MatchID, request.ReporterID, request.ReportedID)
+			switch {
+			case txErr != nil:
+				return txErr
+			case !shared:
+				return apperrors.BadRequest(report.ErrMatchNotShared)
+			}
+		}
+
+		r, txErr := scanReport(tx.QueryRow(ctx, insertQuery, insertArgs...))
+		switch {
+		case dbx.IsNoRows(txErr):
+			return apperrors.AlreadyExists("report", "reported_id", request.ReportedID)
+		case dbx.IsForeignKeyViolation(txErr, "reported_id"):
+			return apperrors.NotFound("user", "id", request.ReportedID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		created = r
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ListReports returns a page of the moderation queue, oldest reports first, and the amount of matching reports.
+func (db *Database) ListReports(ctx context.Context, filter *report.Filter) ([]*report.Report, int64, error) {
+	where := squirrel.And{}
+
+	if filter.Status != nil {
+		where = append(where, squirrel.Eq{"status": *filter.Status})
+	}
+
+	if filter.Category != nil {
+		where = append(where, squirrel.Eq{"category": *filter.Category})
+	}
+
+	if filter.ReportedID != nil {
+		where = append(where, squirrel.Eq{"reported_id": *filter.ReportedID})
+	}
+
+	builder := dbx.StatementBuilder.
+		Select(reportColumns...).
+		From("user_reports").
+		Where(where).
+		OrderBy("created_at", "id").
+		Limit(filter.Limit).
+		Offset(filter.Offset)
+
+	countQuery := dbx.StatementBuilder.
+		Select("COUNT(*)").
+		From("user_reports").
+		Where(where)
+
+	b := &pgx.Batch{}
+
+	if err := dbx.QueryBatch(b, builder); err != nil {
+		return nil, 0, apperrors.Internal(err)
+	}
+
+	if err := dbx.QueryBatch(b, countQuery); err != nil {
+		return nil, 0, apperrors.Internal(err)
+	}
+
+	br := db.pool.SendBatch(ctx, b)
+	defer func() {
+		_ = br.Close()
+	}()
+
+	rows, err := br.Query()
+	if err != nil {
+		return nil, 0, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var reports []*report.Report
+
+	for rows.Next() {
+		r, scanErr := scanReport(rows)
+		if scanErr != nil {
+			return nil, 0, apperrors.Internal(scanErr)
+		}
+
+		reports = append(reports, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, apperrors.Internal(err)
+	}
+
+	var total int64
+	if err = br.QueryRow().Scan(&total); err != nil {
+		return nil, 0, apperrors.Internal(err)
+	}
+
+	return reports, total, nil
+}
+
+// ClaimReport assigns an open report to the moderator, claiming a report the moderator already claimed is a no-op.
+// It returns Forbidden when the report is closed or claimed by another moderator.
+func (db *Database) ClaimReport(ctx context.Context, reportID, moderatorID uuid.UUID) (*report.Report, error) {
+	update := dbx.StatementBuilder.
+		Update("user_reports").
+		Set("status", report.StatusClaimed).
+		Set("moderator_id", moderatorID).
+		Set("claimed_at", time.Now()).
+		Where(squirrel.Eq{"id": reportID}).
+		Suffix("RETURNING " + strings.Join(reportColumns, ", "))
+
+	updateQuery, updateArgs, err := update.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var claimed *report.Report
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		r, txErr := lockReport(ctx, tx, reportID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = r.CheckModerator(moderatorID); txErr != nil {
+			return apperrors.Forbidden(txErr)
+		}
+
+		if r.Status == report.StatusClaimed {
+			claimed = r
+			return nil
+		}
+
+		claimed, txErr = scanReport(tx.QueryRow(ctx, updateQuery, updateArgs...))
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// CloseReport resolves or dismisses a report on behalf of the moderator, an open report is claimed on the way.
+// It returns Forbidden when the report is already closed or claimed by another moderator.
+func (db *Database) CloseReport(ctx context.Context, reportID, moderatorID uuid.UUID, status report.Status, action *report.Action) (*report.Report, error) {
+	now := time.Now()
+
+	update := dbx.StatementBuilder.
+		Update("user_reports").
+		Set("status", status).
+		Set("moderator_id", moderatorID).
+		Set("action", action).
+		Set("claimed_at", squirrel.Expr("COALESCE(claimed_at, ?)", now)).
+		Set("closed_at", now).
+		Where(squirrel.Eq{"id": reportID}).
+		Suffix("RETURNING " + strings.Join(reportColumns, ", "))
+
+	updateQuery, updateArgs, err := update.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var closed *report.Report
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		r, txErr := lockReport(ctx, tx, reportID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = r.CheckModerator(moderatorID); txErr != nil {
+			return apperrors.Forbidden(txErr)
+		}
+
+		closed, txErr = scanReport(tx.QueryRow(ctx, updateQuery, updateArgs...))
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closed, nil
+}
+
+// AdminResetUsername replaces the username of the user, it is used when a report about an abusive username is resolved.
+func (db *Database) AdminResetUsername(ctx context.Context, userID uuid.UUID, username string) error {
+	builder := dbx.StatementBuilder.
+		Update("users").
+		Set("username", username).
+		Where(squirrel.Eq{"id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	cmd, err := db.pool.Exec(ctx, query, args...)
+	switch {
+	case dbx.IsUniqueViolation(err, "username"):
+		return apperrors.AlreadyExists("user", "username", username)
+	case err != nil:
+		return apperrors.Internal(err)
+	case cmd.RowsAffected() == 0:
+		return apperrors.NotFound("user", "id", userID)
+	}
+
+	return nil
+}
+
+// lockReporter serializes the reports of the reporter so that concurrent reports cannot exceed the rate limit.
+func lockReporter(ctx context.Context, tx pgx.Tx, reporterID uuid.UUID) error {
+	builder := dbx.StatementBuilder.
+		Select("id").
+		From("users").
+		Where(squirrel.Eq{"id": reporterID}).
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Suffix("FOR NO KEY UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	var id uuid.UUID
+
+	err = tx.QueryRow(ctx, query, args...).Scan(&id)
+	switch {
+	case dbx.IsNoRows(err):
+		return apperrors.NotFound("user", "id", reporterID)
+	case err != nil:
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+func lockReport(ctx context.Context, tx pgx.Tx, reportID uuid.UUID) (*report.Report, error) {
+	builder := dbx.StatementBuilder.
+		Select(reportColumns...).
+		From("user_reports").
+		Where(squirrel.Eq{"id": reportID}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	r, err := scanReport(tx.QueryRow(ctx, query, args...))
+	switch {
+	case dbx.IsNoRows(err):
+		return nil, apperrors.NotFound("report", "id", reportID)
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	}
+
+	return r, nil
+}
+
+func countReports(ctx context.Context, q rowQuerier, reporterID uuid.UUID, since time.Time) (int64, error) {
+	builder := dbx.StatementBuilder.
+		Select("count(*)").
+		From("user_reports").
+		Where(squirrel.Eq{"reporter_id": reporterID}).
+		Where(squirrel.GtOrEq{"created_at": since})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	var count int64
+	if err = q.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return count, nil
+}
+
+// sharedMatch reports whether both users played the match.
+func sharedMatch(ctx context.Context, q rowQuerier, matchID, userID, otherID uuid.UUID) (bool, error) {
+	builder := dbx.StatementBuilder.
+		Select("count(*)").
+		From("match_participants").
+		Where(squirrel.Eq{"match_id": matchID}).
+		Where(squirrel.Eq{"user_id": []uuid.UUID{userID, otherID}})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, apperrors.Internal(err)
+	}
+
+	var count int
+	if err = q.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return false, apperrors.Internal(err)
+	}
+
+	return count == 2, nil
+}
+
+func scanReport(row pgx.Row) (*report.Report, error) {
+	var r report.Report
+
+	err := row.Scan(
+		&r.ID,
+		&r.ReporterID,
+		&r.ReportedID,
+		&r.Category,
+		&r.Text,
+		&r.MatchID,
+		&r.Status,
+		&r.ModeratorID,
+		&r.Action,
+		&r.CreatedAt,
+		&r.ClaimedAt,
+		&r.ClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
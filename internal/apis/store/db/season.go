@@ -0,0 +1,328 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+var (
+	errSeasonOverlaps = errors.New("season overlaps another season")
+	errSeasonArchived = errors.New("season is already archived")
+)
+
+var seasonColumns = []string{"id", "name", "starts_at", "ends_at", "reset_base", "reset_factor", "archived_at"}
+
+func (db *Database) CreateSeason(ctx context.Context, season *profile.Season) (*profile.Season, error) {
+	builder := dbx.StatementBuilder.
+		Insert("seasons").
+		Columns("name", "starts_at", "ends_at", "reset_base", "reset_factor").
+		Values(season.Name, season.StartsAt, season.EndsAt, season.ResetBase, season.ResetFactor).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	created := *season
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := checkSeasonOverlap(ctx, tx, season); txErr != nil {
+			return txErr
+		}
+
+		txErr := tx.QueryRow(ctx, query, args...).Scan(&created.ID)
+		switch {
+		case dbx.IsUniqueViolation(txErr, "name"):
+			return apperrors.AlreadyExists("season", "name", season.Name)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// UpdateSeason applies the update to a season that is not archived yet and returns the updated season.
+func (db *Database) UpdateSeason(ctx context.Context, update *profile.UpdateSeason) (*profile.Season, error) {
+	lock := dbx.StatementBuilder.
+		Select(seasonColumns...).
+		From("seasons").
+		Where(squirrel.Eq{"id": update.ID}).
+		Suffix("FOR UPDATE")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var updated *profile.Season
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		current, txErr := scanSeason(tx.QueryRow(ctx, lockQuery, lockArgs...))
+		switch {
+		case dbx.IsNoRows(txErr):
+			return apperrors.NotFound("season", "id", update.ID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		case current.ArchivedAt != nil:
+			return apperrors.BadRequest(errSeasonArchived)
+		}
+
+		updated = update.Apply(*current)
+
+		if txErr = updated.Validate(); txErr != nil {
+			return txErr
+		}
+
+		if txErr = checkSeasonOverlap(ctx, tx, updated); txErr != nil {
+			return txErr
+		}
+
+		builder := dbx.StatementBuilder.
+			Update("seasons").
+			Set("name", updated.Name).
+			Set("starts_at", updated.StartsAt).
+			Set("ends_at", updated.EndsAt).
+			Set("reset_base", updated.ResetBase).
+			Set("reset_factor", updated.ResetFactor).
+			Where(squirrel.Eq{"id": updated.ID})
+
+		txErr = execAll(ctx, tx, builder)
+		if dbx.IsUniqueViolation(txErr, "name") {
+			return apperrors.AlreadyExists("season", "name", updated.Name)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (db *Database) ListSeasons(ctx context.Context) ([]*profile.Season, error) {
+	builder := dbx.StatementBuilder.
+		Select(seasonColumns...).
+		From("seasons").
+		OrderBy("starts_at DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var seasons []*profile.Season
+
+	for rows.Next() {
+		season, scanErr := scanSeason(rows)
+		if scanErr != nil {
+			return nil, apperrors.Internal(scanErr)
+		}
+
+		seasons = append(seasons, season)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return seasons, nil
+}
+
+// ArchiveEndedSeason archives the earliest season that ended before now: the final rating and rank of every
+// active user is stored in season_results and ratings are soft reset, all in one transaction.
+// It returns nil when there is no season to archive.
+func (db *Database) ArchiveEndedSeason(ctx context.Context, now time.Time) (*profile.Season, error) {
+	lock := dbx.StatementBuilder.
+		Select(seasonColumns...).
+		From("seasons").
+		Where(squirrel.Eq{"archived_at": nil}).
+		Where(squirrel.LtOrEq{"ends_at": now}).
+		OrderBy("ends_at").
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	var season *profile.Season
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		locked, txErr := scanSeason(tx.QueryRow(ctx, lockQuery, lockArgs...))
+		switch {
+		case dbx.IsNoRows(txErr):
+			return nil
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		results := dbx.StatementBuilder.
+			Insert("season_results").
+			Columns("season_id", "user_id", "rating", "rank", "reset_rating").
+			// The nested select keeps question placeholders, the insert numbers them.
+			Select(squirrel.
+				Select().
+				Column("?::INT", locked.ID).
+				Columns("s.user_id", "s.rating").
+				Column("ROW_NUMBER() OVER (ORDER BY s.rating DESC, u.id DESC)").
+				Column("(?::INT + ROUND((s.rating - ?::INT) * ?::FLOAT8))::SMALLINT", locked.ResetBase, locked.ResetBase, locked.ResetFactor).
+				From("stats s").
+				Join("users u ON u.id = s.user_id").
+				Where(squirrel.Eq{"u.deleted_at": nil}).
+				Where(squirrel.Eq{"u.banned_at": nil}),
+			)
+
+		reset := dbx.StatementBuilder.
+			Update("stats s").
+			Set("rating", squirrel.Expr("r.reset_rating")).
+			From("season_results r").
+			Where("r.user_id = s.user_id").
+			Where(squirrel.Eq{"r.season_id": locked.ID})
+
+		archive := dbx.StatementBuilder.
+			Update("seasons").
+			Set("archived_at", now).
+			Where(squirrel.Eq{"id": locked.ID})
+
+		if txErr = execAll(ctx, tx, results, reset, archive); txErr != nil {
+			return txErr
+		}
+
+		locked.ArchivedAt = &now
+		season = locked
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return season, nil
+}
+
+func (db *Database) GetSeasonHistory(ctx context.Context, userID uuid.UUID) ([]*profile.SeasonResult, error) {
+	builder := dbx.StatementBuilder.
+		Select("s.id", "s.name", "s.starts_at", "s.ends_at", "s.reset_base", "s.reset_factor", "s.archived_at").
+		Columns("r.rating", "r.rank", "r.reset_rating").
+		From("season_results r").
+		Join("seasons s ON s.id = r.season_id").
+		Where(squirrel.Eq{"r.user_id": userID}).
+		OrderBy("s.ends_at DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	results := make([]*profile.SeasonResult, 0)
+
+	for rows.Next() {
+		var (
+			season profile.Season
+			result = profile.SeasonResult{Season: &season}
+			rank   int64
+		)
+
+		if err = rows.Scan(
+			&season.ID,
+			&season.Name,
+			&season.StartsAt,
+			&season.EndsAt,
+			&season.ResetBase,
+			&season.ResetFactor,
+			&season.ArchivedAt,
+			&result.Rating,
+			&rank,
+			&result.ResetRating,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		result.Rank = uint64(rank)
+		results = append(results, &result)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return results, nil
+}
+
+func checkSeasonOverlap(ctx context.Context, tx pgx.Tx, season *profile.Season) error {
+	builder := dbx.StatementBuilder.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From("seasons").
+		Where(squirrel.Lt{"starts_at": season.EndsAt}).
+		Where(squirrel.Gt{"ends_at": season.StartsAt}).
+		Where(squirrel.NotEq{"id": season.ID}).
+		Suffix(")")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	var overlaps bool
+	if err = tx.QueryRow(ctx, query, args...).Scan(&overlaps); err != nil {
+		return apperrors.Internal(err)
+	}
+
+	if overlaps {
+		return apperrors.BadRequest(errSeasonOverlaps)
+	}
+
+	return nil
+}
+
+func scanSeason(row pgx.Row) (*profile.Season, error) {
+	var season profile.Season
+
+	err := row.Scan(
+		&season.ID,
+		&season.Name,
+		&season.StartsAt,
+		&season.EndsAt,
+		&season.ResetBase,
+		&season.ResetFactor,
+		&season.ArchivedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &season, nil
+}
@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// PurchaseItem debits the price and hands out the item in one transaction. The stats row of the
+// user is locked first, so a purchase repeated with the same idempotency key returns the first one
+// and an item that is kept in the inventory cannot be bought twice.
+func (db *Database) PurchaseItem(ctx context.Context, request *profile.PurchaseItem, item *profile.ShopItem) (*profile.Purchase, error) {
+	lock := dbx.StatementBuilder.
+		Select("s.coins").
+		From("stats s").
+		Join("users u ON u.id = s.user_id").
+		Where(squirrel.Eq{"s.user_id": request.UserID}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		Suffix("FOR UPDATE OF s")
+
+	lockQuery, lockArgs, err := lock.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	existing := dbx.StatementBuilder.
+		Select("item_code", "price", "balance_after", "purchased_at").
+		From("item_purchases").
+		Where(squirrel.Eq{"user_id": request.UserID}).
+		Where(squirrel.Eq{"idempotency_key": request.IdempotencyKey})
+
+	existingQuery, existingArgs, err := existing.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	owned := dbx.StatementBuilder.
+		Select("1").
+		From("user_inventory").
+		Where(squirrel.Eq{"user_id": request.UserID}).
+		Where(squirrel.Eq{"item_code": item.Code})
+
+	ownedQuery, ownedArgs, err := owned.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	purchase := &profile.Purchase{UserID: request.UserID, Item: item, Price: item.Price}
+
+	err = db.inTx(ctx, func(tx pgx.Tx) error {
+		txErr := tx.QueryRow(ctx, lockQuery, lockArgs...).Scan(&purchase.BalanceAfter)
+		switch {
+		case dbx.IsNoRows(txErr):
+			return apperrors.NotFound("user", "id", request.UserID)
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		}
+
+		var code string
+
+		txErr = tx.QueryRow(ctx, existingQuery, existingArgs...).Scan(&code, &purchase.Price, &purchase.BalanceAfter, &purchase.PurchasedAt)
+		switch {
+		case dbx.IsNoRows(txErr):
+		case txErr != nil:
+			return apperrors.Internal(txErr)
+		case code != item.Code:
+			return apperrors.AlreadyExists("purchase", "idempotency_key", request.IdempotencyKey)
+		default:
+			return nil
+		}
+
+		if !item.Kind.Consumable() {
+			var one int
+
+			txErr = tx.QueryRow(ctx, ownedQuery, ownedArgs...).Scan(&one)
+			switch {
+			case dbx.IsNoRows(txErr):
+			case txErr != nil:
+				return apperrors.Internal(txErr)
+			default:
+				return apperrors.AlreadyExists("item", "code", item.Code)
+			}
+		}
+
+		if item.Price > 0 {
+			transaction, opErr := applyCoinOperation(ctx, tx, &profile.CoinOperation{
+				UserID:         request.UserID,
+				Amount:         -item.Price,
+				Reason:         "purchase",
+				Source:         "shop",
+				IdempotencyKey: profile.PurchaseKey(request.IdempotencyKey),
+			})
+			if opErr != nil {
+				return opErr
+			}
+
+			purchase.BalanceAfter = transaction.BalanceAfter
+		}
+
+		var grant squirrel.Sqlizer
+
+		if item.Kind == profile.ItemStreakFreeze {
+			grant = dbx.StatementBuilder.
+				Insert("daily_rewards").
+				Columns("user_id", "streak_freezes").
+				Values(request.UserID, 1).
+				Suffix("ON CONFLICT (user_id) DO UPDATE SET streak_freezes = daily_rewards.streak_freezes + EXCLUDED.streak_freezes, updated_at = now()")
+		} else {
+			grant = dbx.StatementBuilder.
+				Insert("user_inventory").
+				Columns("user_id", "item_code", "kind").
+				Values(request.UserID, item.Code, item.Kind.String())
+		}
+
+		if txErr = execAll(ctx, tx, grant); txErr != nil {
+			return txErr
+		}
+
+		insert := dbx.StatementBuilder.
+			Insert("item_purchases").
+			Columns("user_id", "idempotency_key", "item_code", "price", "balance_after").
+			Values(request.UserID, request.IdempotencyKey, item.Code, item.Price, purchase.BalanceAfter).
+			Suffix("RETURNING purchased_at")
+
+		insertQuery, insertArgs, txErr := insert.ToSql()
+		if txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		if txErr = tx.QueryRow(ctx, insertQuery, insertArgs...).Scan(&purchase.PurchasedAt); txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
+
+func (db *Database) HasItem(ctx context.Context, userID uuid.UUID, itemCode string) (bool, error) {
+	builder := dbx.StatementBuilder.
+		Select("1").
+		From("user_inventory").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(squirrel.Eq{"item_code": itemCode})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, apperrors.Internal(err)
+	}
+
+	var one int
+
+	err = db.pool.QueryRow(ctx, query, args...).Scan(&one)
+	switch {
+	case dbx.IsNoRows(err):
+		return false, nil
+	case err != nil:
+		return false, apperrors.Internal(err)
+	}
+
+	return true, nil
+}
+
+func (db *Database) GetInventory(ctx context.Context, userID uuid.UUID) ([]*profile.InventoryItem, error) {
+	builder := dbx.StatementBuilder.
+		Select("item_code", "kind", "acquired_at").
+		From("user_inventory").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("acquired_at", "item_code")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var items []*profile.InventoryItem
+
+	for rows.Next() {
+		var item profile.InventoryItem
+
+		if err = rows.Scan(&item.ItemCode, &item.Kind, &item.AcquiredAt); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		items = append(items, &item)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return items, nil
+}
@@ -2,8 +2,11 @@ package db
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/dbx"
@@ -11,78 +14,391 @@ import (
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
 )
 
-func (db *Database) AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID) error {
+// AddFriend sends a friend request and reports whether it accepted a pending request of the recipient
+// instead. A block in either direction, an existing friendship or request and the friend and pending
+// request limits are checked in the same transaction, with both users locked.
+func (db *Database) AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID, limits profile.FriendLimits) (bool, error) {
+	var accepted bool
+
+	err := db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockFriendPair(ctx, tx, requesterID, recipientID); txErr != nil {
+			return txErr
+		}
+
+		blocked, txErr := isBlockedPair(ctx, tx, requesterID, recipientID)
+		switch {
+		case txErr != nil:
+			return txErr
+		case blocked:
+			return apperrors.Forbidden(profile.ErrUserBlocked)
+		}
+
+		statuses, txErr := friendPairStatuses(ctx, tx, requesterID, recipientID)
+		if txErr != nil {
+			return txErr
+		}
+
+		switch {
+		case statuses[requesterID] == profile.Accepted || statuses[recipientID] == profile.Accepted:
+			return apperrors.AlreadyExists("friend", "id", recipientID)
+		case statuses[requesterID] == profile.Pending:
+			return apperrors.AlreadyExists("friend request", "recipient_id", recipientID)
+		case statuses[recipientID] == profile.Pending:
+			accepted = true
+			return acceptFriendRequest(ctx, tx, recipientID, requesterID, limits)
+		}
+
+		if txErr = checkFriendCapacity(ctx, tx, limits, requesterID); txErr != nil {
+			return txErr
+		}
+
+		if limits.MaxPendingRequests > 0 {
+			pending, countErr := countFriends(ctx, tx, dbx.StatementBuilder.
+				Select("count(*)").
+				From("friends").
+				Where(squirrel.Eq{"user_id": requesterID}).
+				Where(squirrel.Eq{"status": "pending"}))
+			switch {
+			case countErr != nil:
+				return countErr
+			case pending >= limits.MaxPendingRequests:
+				return apperrors.Forbidden(profile.ErrPendingLimitReached)
+			}
+		}
+
+		insert := dbx.StatementBuilder.
+			Insert("friends").
+			Columns("user_id", "friend_id").
+			Values(requesterID, recipientID)
+
+		return execAll(ctx, tx, insert)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return accepted, nil
+}
+
+// AcceptFriend accepts the pending request of the requester, it returns NotFound when there is none.
+func (db *Database) AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID, limits profile.FriendLimits) error {
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockFriendPair(ctx, tx, recipientID, requesterID); txErr != nil {
+			return txErr
+		}
+
+		statuses, txErr := friendPairStatuses(ctx, tx, recipientID, requesterID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if statuses[requesterID] != profile.Pending {
+			return apperrors.NotFound("friend request", "requester_id", requesterID)
+		}
+
+		return acceptFriendRequest(ctx, tx, recipientID, requesterID, limits)
+	})
+}
+
+// RejectFriend deletes the pending request of the requester, it returns NotFound when there is none.
+func (db *Database) RejectFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error {
 	builder := dbx.StatementBuilder.
-		Insert("friends").
-		Columns("user_id", "friend_id").
-		Values(requesterID, recipientID).
-		Suffix("ON CONFLICT DO NOTHING")
+		Delete("friends").
+		Where(squirrel.Eq{"friend_id": recipientID}).
+		Where(squirrel.Eq{"user_id": requesterID}).
+		Where(squirrel.Eq{"status": "pending"})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return apperrors.Internal(err)
 	}
 
-	_, err = db.pool.Exec(ctx, query, args...)
-
+	cmd, err := db.pool.Exec(ctx, query, args...)
 	switch {
-	case dbx.IsForeignKeyViolation(err, "user_id"):
-		return apperrors.NotFound("user", "id", requesterID)
-	case dbx.IsForeignKeyViolation(err, "friend_id"):
-		return apperrors.NotFound("user", "id", requesterID)
 	case err != nil:
 		return apperrors.Internal(err)
+	case cmd.RowsAffected() == 0:
+		return apperrors.NotFound("friend request", "requester_id", requesterID)
 	}
 
 	return nil
 }
 
-func (db *Database) AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error {
+// ExpireFriendRequests deletes at most limit pending requests sent before createdBefore, oldest first.
+func (db *Database) ExpireFriendRequests(ctx context.Context, createdBefore time.Time, limit uint64) (int, error) {
+	expired := dbx.StatementBuilder.
+		Select("user_id", "friend_id").
+		From("friends").
+		Where(squirrel.Eq{"status": "pending"}).
+		Where(squirrel.Lt{"created_at": createdBefore}).
+		OrderBy("created_at ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	builder := dbx.StatementBuilder.
+		Delete("friends").
+		Where(squirrel.Expr("(user_id, friend_id) IN (?)", expired))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	cmd, err := db.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return int(cmd.RowsAffected()), nil
+}
+
+// RemindFriendRequests marks at most limit pending requests sent before createdBefore as reminded
+// and returns them grouped by recipient, a request is reminded only once.
+func (db *Database) RemindFriendRequests(ctx context.Context, createdBefore time.Time, limit uint64) ([]*profile.FriendRequestReminder, error) {
+	due := dbx.StatementBuilder.
+		Select("user_id", "friend_id").
+		From("friends").
+		Where(squirrel.Eq{"status": "pending"}).
+		Where(squirrel.Eq{"reminded_at": nil}).
+		Where(squirrel.Lt{"created_at": createdBefore}).
+		OrderBy("created_at ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
 	builder := dbx.StatementBuilder.
 		Update("friends").
-		Set("status", "accepted").
-		Where(squirrel.Eq{"friend_id": recipientID}).
-		Where(squirrel.Eq{"user_id": requesterID})
+		Set("reminded_at", squirrel.Expr("now()")).
+		Where(squirrel.Expr("(user_id, friend_id) IN (?)", due)).
+		Suffix("RETURNING friend_id, user_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var reminders []*profile.FriendRequestReminder
+
+	byRecipient := make(map[uuid.UUID]*profile.FriendRequestReminder)
+
+	for rows.Next() {
+		var recipientID, requesterID uuid.UUID
+
+		if err = rows.Scan(&recipientID, &requesterID); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		reminder, ok := byRecipient[recipientID]
+		if !ok {
+			reminder = &profile.FriendRequestReminder{UserID: recipientID}
+			byRecipient[recipientID] = reminder
+			reminders = append(reminders, reminder)
+		}
+
+		reminder.RequesterIDs = append(reminder.RequesterIDs, requesterID)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return reminders, nil
+}
+
+// lockFriendPair locks both users in id order, so concurrent friend operations on either of them
+// see each other's rows and counts. It returns NotFound for a missing or deleted user.
+func lockFriendPair(ctx context.Context, tx pgx.Tx, userID, otherID uuid.UUID) error {
+	builder := dbx.StatementBuilder.
+		Select("id").
+		From("users").
+		Where(squirrel.Eq{"id": []uuid.UUID{userID, otherID}}).
+		Where(squirrel.Eq{"deleted_at": nil}).
+		OrderBy("id").
+		Suffix("FOR NO KEY UPDATE")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return apperrors.Internal(err)
 	}
 
-	_, err = db.pool.Exec(ctx, query, args...)
+	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
 		return apperrors.Internal(err)
 	}
 
+	defer rows.Close()
+
+	found := make(map[uuid.UUID]struct{}, 2)
+
+	for rows.Next() {
+		var id uuid.UUID
+
+		if err = rows.Scan(&id); err != nil {
+			return apperrors.Internal(err)
+		}
+
+		found[id] = struct{}{}
+	}
+
+	if rows.Err() != nil {
+		return apperrors.Internal(rows.Err())
+	}
+
+	for _, id := range []uuid.UUID{userID, otherID} {
+		if _, ok := found[id]; !ok {
+			return apperrors.NotFound("user", "id", id)
+		}
+	}
+
 	return nil
 }
 
-func (db *Database) RejectFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error {
+// AreBlocked reports whether either user blocked the other.
+func (db *Database) AreBlocked(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+	return isBlockedPair(ctx, db.pool, userID, otherID)
+}
+
+// rowQuerier is implemented by both the pool and a transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func isBlockedPair(ctx context.Context, q rowQuerier, userID, otherID uuid.UUID) (bool, error) {
 	builder := dbx.StatementBuilder.
-		Delete("friends").
-		Where(squirrel.Eq{"friend_id": recipientID}).
-		Where(squirrel.Eq{"user_id": requesterID})
+		Select("1").
+		From("user_blocks").
+		Where(
+			squirrel.Or{
+				squirrel.And{squirrel.Eq{"blocker_id": userID}, squirrel.Eq{"blocked_id": otherID}},
+				squirrel.And{squirrel.Eq{"blocker_id": otherID}, squirrel.Eq{"blocked_id": userID}},
+			},
+		).
+		Limit(1)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return apperrors.Internal(err)
+		return false, apperrors.Internal(err)
 	}
 
-	cmd, err := db.pool.Exec(ctx, query, args...)
+	var one int
+
+	err = q.QueryRow(ctx, query, args...).Scan(&one)
 	switch {
-	case dbx.IsForeignKeyViolation(err, "user_id"):
-		return apperrors.NotFound("user", "id", recipientID)
-	case dbx.IsForeignKeyViolation(err, "friend_id"):
-		return apperrors.NotFound("user", "id", requesterID)
-	case cmd.RowsAffected() == 0:
-		return apperrors.NotFound("user", "id", requesterID)
+	case dbx.IsNoRows(err):
+		return false, nil
 	case err != nil:
-		return apperrors.Internal(err)
+		return false, apperrors.Internal(err)
+	}
+
+	return true, nil
+}
+
+// friendPairStatuses returns the status of the friends rows between the users keyed by the requester of the row.
+func friendPairStatuses(ctx context.Context, tx pgx.Tx, userID, otherID uuid.UUID) (map[uuid.UUID]profile.Status, error) {
+	builder := dbx.StatementBuilder.
+		Select("user_id", "status").
+		From("friends").
+		Where(
+			squirrel.Or{
+				squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.Eq{"friend_id": otherID}},
+				squirrel.And{squirrel.Eq{"user_id": otherID}, squirrel.Eq{"friend_id": userID}},
+			},
+		)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	statuses := make(map[uuid.UUID]profile.Status, 2)
+
+	for rows.Next() {
+		var (
+			requesterID uuid.UUID
+			status      profile.Status
+		)
+
+		if err = rows.Scan(&requesterID, &status); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		statuses[requesterID] = status
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return statuses, nil
+}
+
+// acceptFriendRequest turns the pending request into a friendship once both users are below the friend limit.
+func acceptFriendRequest(ctx context.Context, tx pgx.Tx, recipientID, requesterID uuid.UUID, limits profile.FriendLimits) error {
+	if err := checkFriendCapacity(ctx, tx, limits, recipientID, requesterID); err != nil {
+		return err
+	}
+
+	accept := dbx.StatementBuilder.
+		Update("friends").
+		Set("status", "accepted").
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"friend_id": recipientID}).
+		Where(squirrel.Eq{"user_id": requesterID}).
+		Where(squirrel.Eq{"status": "pending"})
+
+	return execAll(ctx, tx, accept)
+}
+
+func checkFriendCapacity(ctx context.Context, tx pgx.Tx, limits profile.FriendLimits, userIDs ...uuid.UUID) error {
+	if limits.MaxFriends <= 0 {
+		return nil
+	}
+
+	for _, userID := range userIDs {
+		friends, err := countFriends(ctx, tx, dbx.StatementBuilder.
+			Select("count(*)").
+			From("friends").
+			Where(squirrel.Or{squirrel.Eq{"user_id": userID}, squirrel.Eq{"friend_id": userID}}).
+			Where(squirrel.Eq{"status": "accepted"}))
+		switch {
+		case err != nil:
+			return err
+		case friends >= limits.MaxFriends:
+			return apperrors.Forbidden(profile.ErrFriendLimitReached)
+		}
 	}
 
 	return nil
 }
 
+func countFriends(ctx context.Context, tx pgx.Tx, builder squirrel.SelectBuilder) (int64, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	var count int64
+
+	if err = tx.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, apperrors.Internal(err)
+	}
+
+	return count, nil
+}
+
 func (db *Database) RemoveFriend(ctx context.Context, userID, friendID uuid.UUID) error {
 	builder := dbx.StatementBuilder.
 		Delete("friends").
@@ -115,11 +431,12 @@ func (db *Database) RemoveFriend(ctx context.Context, userID, friendID uuid.UUID
 
 func (db *Database) GetFriends(ctx context.Context, userID uuid.UUID) ([]*profile.Friend, error) {
 	builder := dbx.StatementBuilder.
-		Select("u.id", "u.avatar_id", "u.username", "db.rating", "u.created_at", "u.last_login_at", "f.status").
+		Select("u.id", "u.avatar_id", "u.username", "db.rating", "db.level", "db.xp", "db.level_xp", "db.next_level_xp", "u.created_at", "u.last_login_at", "f.status", "f.updated_at").
 		From("users u").
 		JoinClause("JOIN friends f ON (f.user_id = ? AND u.id = f.friend_id) OR (f.friend_id = ? AND u.id = f.user_id)", userID, userID).
 		Join("stats db ON db.user_id = u.id").
 		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
 		Where(squirrel.NotEq{"u.id": userID}).
 		Where(squirrel.Or{
 			squirrel.NotEq{"f.status": "pending"},
@@ -154,9 +471,14 @@ func (db *Database) GetFriends(ctx context.Context, userID uuid.UUID) ([]*profil
 			&f.User.AvatarID,
 			&f.User.Username,
 			&f.User.Rating,
+			&f.User.Level,
+			&f.User.XP,
+			&f.User.LevelXP,
+			&f.User.NextLevelXP,
 			&f.User.CreatedAt,
 			&f.User.LastLoginAt,
 			&f.Status,
+			&f.Since,
 		); err != nil {
 			return nil, apperrors.Internal(err)
 		}
@@ -174,41 +496,55 @@ func (db *Database) GetFriends(ctx context.Context, userID uuid.UUID) ([]*profil
 	return friends, nil
 }
 
-func (db *Database) BanFriend(ctx context.Context, userID, friendID uuid.UUID) error {
+// AreFriends reports whether the users are accepted friends, in either direction of the request.
+func (db *Database) AreFriends(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
 	builder := dbx.StatementBuilder.
-		Update("friends").
-		Set("status", "blocked").
+		Select("1").
+		From("friends").
+		Where(squirrel.Eq{"status": "accepted"}).
 		Where(
 			squirrel.Or{
-				squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.Eq{"friend_id": friendID}},
-				squirrel.And{squirrel.Eq{"user_id": friendID}, squirrel.Eq{"friend_id": userID}},
+				squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.Eq{"friend_id": otherID}},
+				squirrel.And{squirrel.Eq{"user_id": otherID}, squirrel.Eq{"friend_id": userID}},
 			},
-		)
+		).
+		Limit(1)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return apperrors.Internal(err)
+		return false, apperrors.Internal(err)
 	}
 
-	cmd, err := db.pool.Exec(ctx, query, args...)
+	var one int
+
+	err = db.pool.QueryRow(ctx, query, args...).Scan(&one)
 	switch {
-	case dbx.IsForeignKeyViolation(err, "user_id"):
-		return apperrors.NotFound("user", "id", userID)
-	case dbx.IsForeignKeyViolation(err, "friend_id"):
-		return apperrors.NotFound("user", "id", friendID)
-	case cmd.RowsAffected() == 0:
-		return apperrors.NotFound("user", "id", friendID)
+	case dbx.IsNoRows(err):
+		return false, nil
 	case err != nil:
-		return apperrors.Internal(err)
+		return false, apperrors.Internal(err)
 	}
 
-	return nil
+	return true, nil
 }
 
-func (db *Database) UnbanFriend(ctx context.Context, userID, friendID uuid.UUID) error {
-	builder := dbx.StatementBuilder.
-		Update("friends").
-		Set("status", "accepted").
+// BanFriend blocks the user, ending any friendship or pending friend request between the two.
+// Blocking is directional, the blocked user does not block the blocker back. Both users are locked
+// first, so a friend request racing the block cannot slip in after it.
+func (db *Database) BanFriend(ctx context.Context, userID, friendID uuid.UUID) error {
+	block := dbx.StatementBuilder.
+		Insert("user_blocks").
+		Columns("blocker_id", "blocked_id").
+		Values(userID, friendID).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	blockQuery, blockArgs, err := block.ToSql()
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	unfriend := dbx.StatementBuilder.
+		Delete("friends").
 		Where(
 			squirrel.Or{
 				squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.Eq{"friend_id": friendID}},
@@ -216,6 +552,26 @@ func (db *Database) UnbanFriend(ctx context.Context, userID, friendID uuid.UUID)
 			},
 		)
 
+	return db.inTx(ctx, func(tx pgx.Tx) error {
+		if txErr := lockFriendPair(ctx, tx, userID, friendID); txErr != nil {
+			return txErr
+		}
+
+		if _, txErr := tx.Exec(ctx, blockQuery, blockArgs...); txErr != nil {
+			return apperrors.Internal(txErr)
+		}
+
+		return execAll(ctx, tx, unfriend)
+	})
+}
+
+// UnbanFriend removes the block, a friendship ended by the block is not restored.
+func (db *Database) UnbanFriend(ctx context.Context, userID, friendID uuid.UUID) error {
+	builder := dbx.StatementBuilder.
+		Delete("user_blocks").
+		Where(squirrel.Eq{"blocker_id": userID}).
+		Where(squirrel.Eq{"blocked_id": friendID})
+
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return apperrors.Internal(err)
@@ -223,15 +579,500 @@ func (db *Database) UnbanFriend(ctx context.Context, userID, friendID uuid.UUID)
 
 	cmd, err := db.pool.Exec(ctx, query, args...)
 	switch {
-	case dbx.IsForeignKeyViolation(err, "user_id"):
-		return apperrors.NotFound("user", "id", userID)
-	case dbx.IsForeignKeyViolation(err, "friend_id"):
-		return apperrors.NotFound("user", "id", friendID)
-	case cmd.RowsAffected() == 0:
-		return apperrors.NotFound("user", "id", friendID)
 	case err != nil:
 		return apperrors.Internal(err)
+	case cmd.RowsAffected() == 0:
+		return apperrors.NotFound("block", "id", friendID)
 	}
 
 	return nil
 }
+
+// GetBlockedUsers returns the users blocked by the user, most recently blocked first.
+func (db *Database) GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*profile.BlockedUser, error) {
+	builder := dbx.StatementBuilder.
+		Select("u.id", "u.avatar_id", "u.username", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "b.created_at").
+		From("user_blocks b").
+		Join("users u ON u.id = b.blocked_id").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"b.blocker_id": userID}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		OrderBy("b.created_at DESC", "u.id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	var blocked []*profile.BlockedUser
+
+	for rows.Next() {
+		b := profile.BlockedUser{
+			User: &profile.User{},
+		}
+
+		if err = rows.Scan(
+			&b.User.ID,
+			&b.User.AvatarID,
+			&b.User.Username,
+			&b.User.Rating,
+			&b.User.Level,
+			&b.User.XP,
+			&b.User.LevelXP,
+			&b.User.NextLevelXP,
+			&b.User.CreatedAt,
+			&b.User.LastLoginAt,
+			&b.User.Privacy,
+			&b.BlockedAt,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		blocked = append(blocked, &b)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return blocked, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (db *Database) SearchPlayers(ctx context.Context, viewerID uuid.UUID, filter *profile.SearchPlayers) (*profile.PlayersPage, error) {
+	prefix := likeEscaper.Replace(filter.Query) + "%"
+
+	matches := dbx.StatementBuilder.
+		Select("u.id", "u.username", "u.avatar_id", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "u.country").
+		Column("((CASE WHEN u.username ILIKE ? THEN 1 ELSE 0 END) + similarity(u.username, ?))::real AS score", prefix, filter.Query).
+		From("users u").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Or{
+			squirrel.Expr("u.username ILIKE ?", prefix),
+			squirrel.Expr("u.username % ?", filter.Query),
+		}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		Where(squirrel.NotEq{"u.id": viewerID}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id = ? AND b.blocked_id = u.id) OR (b.blocked_id = ? AND b.blocker_id = u.id)
+		)`, viewerID, viewerID)
+
+	builder := dbx.StatementBuilder.
+		Select("m.id", "m.username", "m.avatar_id", "m.rating", "m.level", "m.xp", "m.level_xp", "m.next_level_xp", "m.created_at", "m.last_login_at", "m.privacy", "m.country", "m.score").
+		FromSelect(matches, "m").
+		OrderBy("m.score DESC", "m.rating DESC", "m.id DESC").
+		Limit(filter.Size + 1)
+
+	if filter.Cursor != nil {
+		builder = builder.Where("(m.score, m.rating, m.id) < (?::real, ?, ?)", filter.Cursor.Score, filter.Cursor.Rating, filter.Cursor.ID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	page := &profile.PlayersPage{
+		Users: make([]*profile.User, 0, filter.Size),
+	}
+
+	var last profile.SearchCursor
+
+	for rows.Next() {
+		if uint64(len(page.Users)) == filter.Size {
+			page.NextCursor = &last
+			break
+		}
+
+		var user profile.User
+
+		if err = rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.AvatarID,
+			&user.Rating,
+			&user.Level,
+			&user.XP,
+			&user.LevelXP,
+			&user.NextLevelXP,
+			&user.CreatedAt,
+			&user.LastLoginAt,
+			&user.Privacy,
+			&user.Country,
+			&last.Score,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		last.Rating = user.Rating
+		last.ID = user.ID
+
+		page.Users = append(page.Users, &user)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return page, nil
+}
+
+// SuggestFriends ranks users the user is not related to by mutual friends, matches played together,
+// a similar rating and the same country. Only friends of friends, recent opponents and the capped pools of
+// users with the closest ratings and from the same country are ranked. Friends, pending requests and blocks
+// in either direction are excluded.
+func (db *Database) SuggestFriends(ctx context.Context, filter *profile.SuggestFriends) ([]*profile.FriendSuggestion, error) {
+	candidates := dbx.StatementBuilder.
+		Select("u.id", "u.avatar_id", "u.username", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy", "u.country").
+		Column("COALESCE(m.friends, 0) AS mutual_friends").
+		Column("COALESCE(p.matches, 0) AS matches_together").
+		Column("abs(s.rating - me.rating) <= ? AS similar_rating", filter.RatingRange).
+		Column("COALESCE(u.country = me.country, false) AS same_country").
+		From("pool").
+		Join("users u ON u.id = pool.id").
+		Join("stats s ON s.user_id = u.id").
+		CrossJoin("me").
+		LeftJoin("mutual m ON m.id = u.id").
+		LeftJoin("played p ON p.id = u.id").
+		Where(squirrel.NotEq{"u.id": filter.UserID}).
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM friends f
+			WHERE (f.user_id = ? AND f.friend_id = u.id) OR (f.friend_id = ? AND f.user_id = u.id)
+		)`, filter.UserID, filter.UserID).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id = ? AND b.blocked_id = u.id) OR (b.blocked_id = ? AND b.blocker_id = u.id)
+		)`, filter.UserID, filter.UserID)
+
+	builder := dbx.StatementBuilder.
+		Select("c.id", "c.avatar_id", "c.username", "c.rating", "c.level", "c.xp", "c.level_xp", "c.next_level_xp", "c.created_at", "c.last_login_at", "c.privacy", "c.country", "c.mutual_friends", "c.matches_together", "c.similar_rating", "c.same_country").
+		Prefix(`WITH me AS (
+			SELECT s.rating, u.country FROM users u JOIN stats s ON s.user_id = u.id WHERE u.id = ?
+		), my_friends AS (
+			SELECT CASE WHEN f.user_id = ? THEN f.friend_id ELSE f.user_id END AS id
+			FROM friends f
+			WHERE f.status = 'accepted' AND (f.user_id = ? OR f.friend_id = ?)
+		), mutual AS (
+			SELECT CASE WHEN f.user_id = mf.id THEN f.friend_id ELSE f.user_id END AS id, count(*) AS friends
+			FROM friends f
+			JOIN my_friends mf ON f.user_id = mf.id OR f.friend_id = mf.id
+			WHERE f.status = 'accepted'
+			GROUP BY 1
+		), played AS (
+			SELECT other.user_id AS id, count(*) AS matches
+			FROM match_participants mine
+			JOIN match_participants other ON other.match_id = mine.match_id AND other.user_id <> mine.user_id
+			WHERE mine.user_id = ? AND mine.ended_at >= ?
+			GROUP BY 1
+		), pool AS (
+			SELECT id FROM mutual
+			UNION
+			SELECT id FROM played
+			UNION (
+				SELECT s.user_id FROM stats s CROSS JOIN me
+				WHERE s.rating BETWEEN me.rating - ? AND me.rating + ?
+				ORDER BY abs(s.rating - me.rating), s.user_id
+				LIMIT ?
+			)
+			UNION (
+				SELECT u.id FROM users u CROSS JOIN me
+				WHERE u.country = me.country
+				ORDER BY u.last_login_at DESC NULLS LAST, u.id
+				LIMIT ?
+			)
+		)`, filter.UserID, filter.UserID, filter.UserID, filter.UserID, filter.UserID, filter.PlayedSince,
+			filter.RatingRange, filter.RatingRange, filter.PoolSize, filter.PoolSize).
+		FromSelect(candidates, "c").
+		Where("c.mutual_friends > 0 OR c.matches_together > 0 OR c.similar_rating OR c.same_country").
+		OrderBy("c.mutual_friends * 3 + c.matches_together * 2 + c.similar_rating::int + c.same_country::int DESC", "c.mutual_friends DESC", "c.id").
+		Limit(filter.Size)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	suggestions := make([]*profile.FriendSuggestion, 0, filter.Size)
+
+	for rows.Next() {
+		suggestion := profile.FriendSuggestion{
+			User: &profile.User{},
+		}
+
+		if err = rows.Scan(
+			&suggestion.User.ID,
+			&suggestion.User.AvatarID,
+			&suggestion.User.Username,
+			&suggestion.User.Rating,
+			&suggestion.User.Level,
+			&suggestion.User.XP,
+			&suggestion.User.LevelXP,
+			&suggestion.User.NextLevelXP,
+			&suggestion.User.CreatedAt,
+			&suggestion.User.LastLoginAt,
+			&suggestion.User.Privacy,
+			&suggestion.User.Country,
+			&suggestion.MutualFriends,
+			&suggestion.MatchesTogether,
+			&suggestion.SimilarRating,
+			&suggestion.SameCountry,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		suggestions = append(suggestions, &suggestion)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return suggestions, nil
+}
+
+// GetMutualFriends intersects the accepted friendships of the viewer and the user. The count is a separate
+// query over every mutual friend, so it does not depend on the cursor or on the page being empty.
+// Friends blocked by or blocking the viewer are left out.
+func (db *Database) GetMutualFriends(ctx context.Context, filter *profile.GetMutualFriends) (*profile.MutualFriendsPage, error) {
+	friendsOf := `WITH viewer_friends AS (
+			SELECT CASE WHEN f.user_id = ? THEN f.friend_id ELSE f.user_id END AS id
+			FROM friends f
+			WHERE f.status = 'accepted' AND (f.user_id = ? OR f.friend_id = ?)
+		), user_friends AS (
+			SELECT CASE WHEN f.user_id = ? THEN f.friend_id ELSE f.user_id END AS id
+			FROM friends f
+			WHERE f.status = 'accepted' AND (f.user_id = ? OR f.friend_id = ?)
+		)`
+
+	mutual := dbx.StatementBuilder.
+		Select("u.id", "u.avatar_id", "u.username", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "u.privacy").
+		From("users u").
+		Join("stats s ON s.user_id = u.id").
+		Join("viewer_friends vf ON vf.id = u.id").
+		Join("user_friends uf ON uf.id = u.id").
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil}).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id = ? AND b.blocked_id = u.id) OR (b.blocked_id = ? AND b.blocker_id = u.id)
+		)`, filter.ViewerID, filter.ViewerID)
+
+	builder := dbx.StatementBuilder.
+		Select("m.id", "m.avatar_id", "m.username", "m.rating", "m.level", "m.xp", "m.level_xp", "m.next_level_xp", "m.created_at", "m.last_login_at", "m.privacy").
+		Prefix(friendsOf, filter.ViewerID, filter.ViewerID, filter.ViewerID, filter.UserID, filter.UserID, filter.UserID).
+		FromSelect(mutual, "m").
+		OrderBy("m.username", "m.id").
+		Limit(filter.Size + 1)
+
+	if filter.Cursor != nil {
+		builder = builder.Where("(m.username, m.id) > (?, ?)", filter.Cursor.Username, filter.Cursor.ID)
+	}
+
+	countQuery := dbx.StatementBuilder.
+		Select("COUNT(*)").
+		Prefix(friendsOf, filter.ViewerID, filter.ViewerID, filter.ViewerID, filter.UserID, filter.UserID, filter.UserID).
+		FromSelect(mutual, "m")
+
+	b := &pgx.Batch{}
+
+	if err := dbx.QueryBatch(b, builder); err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	if err := dbx.QueryBatch(b, countQuery); err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	br := db.pool.SendBatch(ctx, b)
+	defer func() {
+		_ = br.Close()
+	}()
+
+	rows, err := br.Query()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	page := &profile.MutualFriendsPage{
+		Users: make([]*profile.User, 0, filter.Size),
+	}
+
+	for rows.Next() {
+		if uint64(len(page.Users)) == filter.Size {
+			last := page.Users[len(page.Users)-1]
+			page.NextCursor = &profile.FriendsCursor{Sort: profile.SortByUsername, Username: last.Username, ID: last.ID}
+			break
+		}
+
+		var user profile.User
+
+		if err = rows.Scan(
+			&user.ID,
+			&user.AvatarID,
+			&user.Username,
+			&user.Rating,
+			&user.Level,
+			&user.XP,
+			&user.LevelXP,
+			&user.NextLevelXP,
+			&user.CreatedAt,
+			&user.LastLoginAt,
+			&user.Privacy,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		page.Users = append(page.Users, &user)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	rows.Close()
+
+	if err = br.QueryRow().Scan(&page.Count); err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	return page, nil
+}
+
+var friendsSortColumns = map[profile.FriendsSort]string{
+	profile.SortByUsername: "l.username",
+	profile.SortByRating:   "l.rating",
+	profile.SortBySince:    "l.since",
+}
+
+// ListFriends returns a page of accepted friends or pending requests of the user, an empty page when there are none.
+func (db *Database) ListFriends(ctx context.Context, filter *profile.ListFriends) (*profile.FriendsPage, error) {
+	friends := dbx.StatementBuilder.
+		Select("u.id", "u.avatar_id", "u.username", "s.rating", "s.level", "s.xp", "s.level_xp", "s.next_level_xp", "u.created_at", "u.last_login_at", "f.status", "f.updated_at AS since").
+		From("users u").
+		Join("stats s ON s.user_id = u.id").
+		Where(squirrel.Eq{"u.deleted_at": nil}).
+		Where(squirrel.Eq{"u.banned_at": nil})
+
+	switch filter.Relation {
+	case profile.RelationIncoming:
+		friends = friends.
+			JoinClause("JOIN friends f ON f.friend_id = ? AND u.id = f.user_id", filter.UserID).
+			Where(squirrel.Eq{"f.status": "pending"})
+	case profile.RelationOutgoing:
+		friends = friends.
+			JoinClause("JOIN friends f ON f.user_id = ? AND u.id = f.friend_id", filter.UserID).
+			Where(squirrel.Eq{"f.status": "pending"})
+	default:
+		friends = friends.
+			JoinClause("JOIN friends f ON (f.user_id = ? AND u.id = f.friend_id) OR (f.friend_id = ? AND u.id = f.user_id)", filter.UserID, filter.UserID).
+			Where(squirrel.Eq{"f.status": "accepted"})
+	}
+
+	column := friendsSortColumns[filter.Sort]
+	order, compare := "ASC", ">"
+
+	if filter.Descending {
+		order, compare = "DESC", "<"
+	}
+
+	builder := dbx.StatementBuilder.
+		Select("l.id", "l.avatar_id", "l.username", "l.rating", "l.level", "l.xp", "l.level_xp", "l.next_level_xp", "l.created_at", "l.last_login_at", "l.status", "l.since").
+		FromSelect(friends, "l").
+		OrderBy(column+" "+order, "l.id "+order).
+		Limit(filter.Size + 1)
+
+	if filter.Cursor != nil {
+		var value any
+
+		switch filter.Sort {
+		case profile.SortByRating:
+			value = filter.Cursor.Rating
+		case profile.SortBySince:
+			value = filter.Cursor.Since
+		default:
+			value = filter.Cursor.Username
+		}
+
+		builder = builder.Where("("+column+", l.id) "+compare+" (?, ?)", value, filter.Cursor.ID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	defer rows.Close()
+
+	page := &profile.FriendsPage{
+		Friends: make([]*profile.Friend, 0, filter.Size),
+	}
+
+	for rows.Next() {
+		if uint64(len(page.Friends)) == filter.Size {
+			page.NextCursor = filter.CursorOf(page.Friends[len(page.Friends)-1])
+			break
+		}
+
+		f := profile.Friend{
+			User: &profile.User{},
+		}
+
+		if err = rows.Scan(
+			&f.User.ID,
+			&f.User.AvatarID,
+			&f.User.Username,
+			&f.User.Rating,
+			&f.User.Level,
+			&f.User.XP,
+			&f.User.LevelXP,
+			&f.User.NextLevelXP,
+			&f.User.CreatedAt,
+			&f.User.LastLoginAt,
+			&f.Status,
+			&f.Since,
+		); err != nil {
+			return nil, apperrors.Internal(err)
+		}
+
+		page.Friends = append(page.Friends, &f)
+	}
+
+	if rows.Err() != nil {
+		return nil, apperrors.Internal(rows.Err())
+	}
+
+	return page, nil
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) GetLeaderboard(ctx context.Context, filter *profile.LeaderboardFilter) ([]*profile.LeaderboardEntry, error) {
+	return s.db.GetLeaderboard(ctx, filter)
+}
+
+func (s *Store) GetLeaderboardRank(ctx context.Context, userID uuid.UUID, country *string) (uint64, error) {
+	return s.db.GetLeaderboardRank(ctx, userID, country)
+}
+
+func (s *Store) GetFriendsLeaderboard(ctx context.Context, userID uuid.UUID) ([]*profile.LeaderboardEntry, error) {
+	return s.db.GetFriendsLeaderboard(ctx, userID)
+}
+
+func (s *Store) GetLeaderboardStandings(ctx context.Context) ([]*profile.User, error) {
+	return s.db.GetLeaderboardStandings(ctx)
+}
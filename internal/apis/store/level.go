@@ -0,0 +1,11 @@
+package store
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) GrantXP(ctx context.Context, grant *profile.GrantXP, curve profile.LevelCurve, coinsPerLevel int64) (*profile.XPGrant, error) {
+	return s.db.GrantXP(ctx, grant, curve, coinsPerLevel)
+}
@@ -0,0 +1,15 @@
+package store
+
+import (
+	"context"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) RecordMatch(ctx context.Context, match *profile.MatchRecord) error {
+	return s.db.RecordMatch(ctx, match)
+}
+
+func (s *Store) ListMatchHistory(ctx context.Context, filter *profile.ListMatchHistory) (*profile.MatchHistoryPage, error) {
+	return s.db.ListMatchHistory(ctx, filter)
+}
@@ -0,0 +1,24 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) CreateNotifications(ctx context.Context, notifications []*profile.Notification) error {
+	return s.db.CreateNotifications(ctx, notifications)
+}
+
+func (s *Store) ListNotifications(ctx context.Context, filter *profile.ListNotifications) (*profile.NotificationsPage, error) {
+	return s.db.ListNotifications(ctx, filter)
+}
+
+func (s *Store) MarkNotificationsRead(ctx context.Context, request *profile.MarkRead) (int64, error) {
+	return s.db.MarkNotificationsRead(ctx, request)
+}
+
+func (s *Store) PurgeNotifications(ctx context.Context, createdBefore time.Time, limit uint64) (int, error) {
+	return s.db.PurgeNotifications(ctx, createdBefore, limit)
+}
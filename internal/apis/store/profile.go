@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -20,6 +21,10 @@ func (s *Store) GetUserByUsername(ctx context.Context, username string) (*profil
 	return s.db.GetUserByUsername(ctx, username)
 }
 
+func (s *Store) GetUsersByIDs(ctx context.Context, userIDs []uuid.UUID) ([]*profile.User, error) {
+	return s.db.GetUsersByIDs(ctx, userIDs)
+}
+
 func (s *Store) UpdateProfile(ctx context.Context, userID uuid.UUID, request *profile.UpdateProfile) error {
 	return s.db.UpdateProfile(ctx, userID, request)
 }
@@ -32,14 +37,18 @@ func (s *Store) UpdateProfilePassword(ctx context.Context, userID uuid.UUID, pas
 	return s.db.UpdateProfilePassword(ctx, userID, password)
 }
 
-func (s *Store) SetProfileRating(ctx context.Context, userID uuid.UUID, rating int32) error {
-	return s.db.SetProfileRating(ctx, userID, rating)
-}
-
-func (s *Store) SetProfileCoins(ctx context.Context, userID uuid.UUID, coins int64) error {
-	return s.db.SetProfileCoins(ctx, userID, coins)
+func (s *Store) ApplyMatchRatings(ctx context.Context, result *profile.MatchResult, algorithm string, rate profile.RateFunc) (*profile.MatchRatings, error) {
+	return s.db.ApplyMatchRatings(ctx, result, algorithm, rate)
 }
 
 func (s *Store) DeleteProfile(ctx context.Context, userID uuid.UUID) error {
 	return s.db.DeleteProfile(ctx, userID)
 }
+
+func (s *Store) PurgeDeletedProfiles(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
+	return s.db.PurgeDeletedProfiles(ctx, deletedBefore, limit)
+}
+
+func (s *Store) GetExportRecords(ctx context.Context, userID uuid.UUID) (*profile.ExportRecords, error) {
+	return s.db.GetExportRecords(ctx, userID)
+}
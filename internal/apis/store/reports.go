@@ -0,0 +1,29 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
+)
+
+func (s *Store) CreateReport(ctx context.Context, request *report.ReportUser, limits report.Limits) (*report.Report, error) {
+	return s.db.CreateReport(ctx, request, limits)
+}
+
+func (s *Store) ListReports(ctx context.Context, filter *report.Filter) ([]*report.Report, int64, error) {
+	return s.db.ListReports(ctx, filter)
+}
+
+func (s *Store) ClaimReport(ctx context.Context, reportID, moderatorID uuid.UUID) (*report.Report, error) {
+	return s.db.ClaimReport(ctx, reportID, moderatorID)
+}
+
+func (s *Store) CloseReport(ctx context.Context, reportID, moderatorID uuid.UUID, status report.Status, action *report.Action) (*report.Report, error) {
+	return s.db.CloseReport(ctx, reportID, moderatorID, status, action)
+}
+
+func (s *Store) AdminResetUsername(ctx context.Context, userID uuid.UUID, username string) error {
+	return s.db.AdminResetUsername(ctx, userID, username)
+}
@@ -0,0 +1,30 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) CreateSeason(ctx context.Context, season *profile.Season) (*profile.Season, error) {
+	return s.db.CreateSeason(ctx, season)
+}
+
+func (s *Store) UpdateSeason(ctx context.Context, update *profile.UpdateSeason) (*profile.Season, error) {
+	return s.db.UpdateSeason(ctx, update)
+}
+
+func (s *Store) ListSeasons(ctx context.Context) ([]*profile.Season, error) {
+	return s.db.ListSeasons(ctx)
+}
+
+func (s *Store) ArchiveEndedSeason(ctx context.Context, now time.Time) (*profile.Season, error) {
+	return s.db.ArchiveEndedSeason(ctx, now)
+}
+
+func (s *Store) GetSeasonHistory(ctx context.Context, userID uuid.UUID) ([]*profile.SeasonResult, error) {
+	return s.db.GetSeasonHistory(ctx, userID)
+}
@@ -0,0 +1,21 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+func (s *Store) PurchaseItem(ctx context.Context, request *profile.PurchaseItem, item *profile.ShopItem) (*profile.Purchase, error) {
+	return s.db.PurchaseItem(ctx, request, item)
+}
+
+func (s *Store) HasItem(ctx context.Context, userID uuid.UUID, itemCode string) (bool, error) {
+	return s.db.HasItem(ctx, userID, itemCode)
+}
+
+func (s *Store) GetInventory(ctx context.Context, userID uuid.UUID) ([]*profile.InventoryItem, error) {
+	return s.db.GetInventory(ctx, userID)
+}
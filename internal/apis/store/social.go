@@ -2,18 +2,19 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
 )
 
-func (s *Store) AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID) error {
-	return s.db.AddFriend(ctx, requesterID, recipientID)
+func (s *Store) AddFriend(ctx context.Context, requesterID, recipientID uuid.UUID, limits profile.FriendLimits) (bool, error) {
+	return s.db.AddFriend(ctx, requesterID, recipientID, limits)
 }
 
-func (s *Store) AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error {
-	return s.db.AcceptFriend(ctx, recipientID, requesterID)
+func (s *Store) AcceptFriend(ctx context.Context, recipientID, requesterID uuid.UUID, limits profile.FriendLimits) error {
+	return s.db.AcceptFriend(ctx, recipientID, requesterID, limits)
 }
 
 func (s *Store) RejectFriend(ctx context.Context, recipientID, requesterID uuid.UUID) error {
@@ -28,6 +29,14 @@ func (s *Store) GetFriends(ctx context.Context, userID uuid.UUID) ([]*profile.Fr
 	return s.db.GetFriends(ctx, userID)
 }
 
+func (s *Store) ListFriends(ctx context.Context, filter *profile.ListFriends) (*profile.FriendsPage, error) {
+	return s.db.ListFriends(ctx, filter)
+}
+
+func (s *Store) AreFriends(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+	return s.db.AreFriends(ctx, userID, otherID)
+}
+
 func (s *Store) BanFriend(ctx context.Context, userID, friendID uuid.UUID) error {
 	return s.db.BanFriend(ctx, userID, friendID)
 }
@@ -35,3 +44,31 @@ func (s *Store) BanFriend(ctx context.Context, userID, friendID uuid.UUID) error
 func (s *Store) UnbanFriend(ctx context.Context, userID, friendID uuid.UUID) error {
 	return s.db.UnbanFriend(ctx, userID, friendID)
 }
+
+func (s *Store) GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*profile.BlockedUser, error) {
+	return s.db.GetBlockedUsers(ctx, userID)
+}
+
+func (s *Store) ExpireFriendRequests(ctx context.Context, createdBefore time.Time, limit uint64) (int, error) {
+	return s.db.ExpireFriendRequests(ctx, createdBefore, limit)
+}
+
+func (s *Store) RemindFriendRequests(ctx context.Context, createdBefore time.Time, limit uint64) ([]*profile.FriendRequestReminder, error) {
+	return s.db.RemindFriendRequests(ctx, createdBefore, limit)
+}
+
+func (s *Store) SearchPlayers(ctx context.Context, viewerID uuid.UUID, filter *profile.SearchPlayers) (*profile.PlayersPage, error) {
+	return s.db.SearchPlayers(ctx, viewerID, filter)
+}
+
+func (s *Store) SuggestFriends(ctx context.Context, filter *profile.SuggestFriends) ([]*profile.FriendSuggestion, error) {
+	return s.db.SuggestFriends(ctx, filter)
+}
+
+func (s *Store) GetMutualFriends(ctx context.Context, filter *profile.GetMutualFriends) (*profile.MutualFriendsPage, error) {
+	return s.db.GetMutualFriends(ctx, filter)
+}
+
+func (s *Store) AreBlocked(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+	return s.db.AreBlocked(ctx, userID, otherID)
+}
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"context"
+
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/store/db"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
@@ -19,3 +21,13 @@ func NewStore(pool *pgxpool.Pool, logger *zap.Logger) *Store {
 		logger: logger,
 	}
 }
+
+// RunLocked runs fn only on the replica elected to run scheduled jobs, see scheduler.Locker.
+func (s *Store) RunLocked(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	return s.db.RunLocked(ctx, name, fn)
+}
+
+// Close gives up the leadership of the replica so another one runs the scheduled jobs.
+func (s *Store) Close(ctx context.Context) error {
+	return s.db.Close(ctx)
+}
@@ -0,0 +1,96 @@
+package categories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	questionsv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/questions/v1"
+)
+
+const (
+	defaultCacheTTL      = time.Minute * 10
+	defaultRetryInterval = time.Second * 30
+	defaultTimeout       = time.Second * 2
+)
+
+// Directory resolves quiz category names from the questions service and caches them locally.
+// When the service is unavailable the last known names are served, possibly stale, the fetch
+// is retried after the retry interval and categories never resolved are left without a name.
+type Directory struct {
+	client        questionsv1.QuestionsClientServiceClient
+	ttl           time.Duration
+	retryInterval time.Duration
+	timeout       time.Duration
+	logger        *zap.Logger
+
+	mu        sync.RWMutex
+	names     map[int32]string
+	refreshAt time.Time
+}
+
+// NewDirectory returns a directory, a nil client serves no names. Zero durations fall back to defaults.
+func NewDirectory(client questionsv1.QuestionsClientServiceClient, ttl, retryInterval, timeout time.Duration, logger *zap.Logger) *Directory {
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+
+	if retryInterval <= 0 {
+		retryInterval = defaultRetryInterval
+	}
+
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Directory{
+		client:        client,
+		ttl:           ttl,
+		retryInterval: retryInterval,
+		timeout:       timeout,
+		logger:        logger,
+		names:         make(map[int32]string),
+	}
+}
+
+// Names returns the known category names by id, the cache is refreshed once it expires.
+func (d *Directory) Names(ctx context.Context) map[int32]string {
+	d.mu.RLock()
+	names, fresh := d.names, time.Now().Before(d.refreshAt)
+	d.mu.RUnlock()
+
+	if fresh || d.client == nil {
+		return names
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if time.Now().Before(d.refreshAt) {
+		return d.names
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	res, err := d.client.GetCategories(ctx, &emptypb.Empty{})
+	if err != nil {
+		d.logger.Warn("failed to fetch categories, serving cached names", zap.Int("cached", len(d.names)), zap.Error(err))
+		d.refreshAt = time.Now().Add(d.retryInterval)
+
+		return d.names
+	}
+
+	names = make(map[int32]string, len(res.GetCategories()))
+	for _, category := range res.GetCategories() {
+		names[category.GetId()] = category.GetName()
+	}
+
+	d.names = names
+	d.refreshAt = time.Now().Add(d.ttl)
+
+	return names
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"errors"
+	"time"
+
 	"github.com/QuizWars-Ecosystem/go-common/pkg/config"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/log"
@@ -8,10 +11,43 @@ import (
 
 type Config struct {
 	*config.ServiceConfig `mapstructure:"service"`
-	Logger                *log.Config     `mapstructure:"logger"`
-	JWT                   *jwt.Config     `mapstructure:"jwt"`
-	Postgres              *PostgresConfig `mapstructure:"postgres"`
-	Redis                 *RedisConfig    `mapstructure:"redis"`
+	Logger                *log.Config          `mapstructure:"logger"`
+	JWT                   *jwt.Config          `mapstructure:"jwt"`
+	Postgres              *PostgresConfig      `mapstructure:"postgres"`
+	Redis                 *RedisConfig         `mapstructure:"redis"`
+	Account               *AccountConfig       `mapstructure:"account"`
+	Presence              *PresenceConfig      `mapstructure:"presence"`
+	Coins                 *CoinsConfig         `mapstructure:"coins"`
+	Rating                *RatingConfig        `mapstructure:"rating"`
+	Leaderboard           *LeaderboardConfig   `mapstructure:"leaderboard"`
+	Seasons               *SeasonsConfig       `mapstructure:"seasons"`
+	Achievements          *AchievementsConfig  `mapstructure:"achievements"`
+	Levels                *LevelsConfig        `mapstructure:"levels"`
+	DailyRewards          *DailyRewardsConfig  `mapstructure:"daily_rewards"`
+	Questions             *QuestionsConfig     `mapstructure:"questions"`
+	Shop                  *ShopConfig          `mapstructure:"shop"`
+	Friends               *FriendsConfig       `mapstructure:"friends"`
+	Clans                 *ClansConfig         `mapstructure:"clans"`
+	Parties               *PartiesConfig       `mapstructure:"parties"`
+	Reports               *ReportsConfig       `mapstructure:"reports"`
+	Notifications         *NotificationsConfig `mapstructure:"notifications"`
+}
+
+// Validate reports the sections whose settings cannot work together.
+func (c *Config) Validate() error {
+	if c.Friends != nil {
+		if err := c.Friends.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Notifications != nil {
+		if err := c.Notifications.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 type PostgresConfig struct {
@@ -21,3 +57,155 @@ type PostgresConfig struct {
 type RedisConfig struct {
 	URL string `mapstructure:"url"`
 }
+
+// QuestionsConfig points to the questions service quiz category names are resolved from,
+// names are not resolved when the url is empty.
+type QuestionsConfig struct {
+	URL           string        `mapstructure:"url"`
+	Timeout       time.Duration `mapstructure:"timeout"`
+	CacheTTL      time.Duration `mapstructure:"cache_ttl"`
+	RetryInterval time.Duration `mapstructure:"retry_interval"`
+}
+
+type AccountConfig struct {
+	DeletionGracePeriod time.Duration `mapstructure:"deletion_grace_period"`
+	PurgeInterval       time.Duration `mapstructure:"purge_interval"`
+	PurgeBatchSize      uint64        `mapstructure:"purge_batch_size"`
+}
+
+type PresenceConfig struct {
+	TTL            time.Duration `mapstructure:"ttl"`
+	ResyncInterval time.Duration `mapstructure:"resync_interval"`
+}
+
+type CoinsConfig struct {
+	ReconcileInterval  time.Duration `mapstructure:"reconcile_interval"`
+	ReconcileBatchSize uint64        `mapstructure:"reconcile_batch_size"`
+}
+
+type RatingConfig struct {
+	Algorithm string  `mapstructure:"algorithm"`
+	EloK      float64 `mapstructure:"elo_k"`
+	GlickoTau float64 `mapstructure:"glicko_tau"`
+}
+
+type LeaderboardConfig struct {
+	RebuildInterval time.Duration `mapstructure:"rebuild_interval"`
+}
+
+type SeasonsConfig struct {
+	RolloverInterval time.Duration `mapstructure:"rollover_interval"`
+}
+
+// AchievementsConfig is the achievement catalogue, it is reloaded when the config file changes.
+type AchievementsConfig struct {
+	Catalogue []*AchievementConfig `mapstructure:"catalogue"`
+}
+
+// AchievementConfig awards the achievement once the metric of a user reaches the threshold.
+type AchievementConfig struct {
+	Code        string `mapstructure:"code"`
+	Title       string `mapstructure:"title"`
+	Description string `mapstructure:"description"`
+	Metric      string `mapstructure:"metric"`
+	Threshold   int64  `mapstructure:"threshold"`
+}
+
+// LevelsConfig is the level curve: the first level requires BaseXP and every next one Growth times more.
+// Zero values fall back to the defaults of the curve.
+type LevelsConfig struct {
+	BaseXP        int64   `mapstructure:"base_xp"`
+	Growth        float64 `mapstructure:"growth"`
+	MaxLevel      int32   `mapstructure:"max_level"`
+	CoinsPerLevel int64   `mapstructure:"coins_per_level"`
+}
+
+// DailyRewardsConfig is the reward table of daily claims, the entry at index i is paid
+// on day i+1 of a streak and the last entry is repeated for longer streaks.
+type DailyRewardsConfig struct {
+	Rewards []*DailyRewardConfig `mapstructure:"rewards"`
+}
+
+type DailyRewardConfig struct {
+	Coins         int64 `mapstructure:"coins"`
+	StreakFreezes int32 `mapstructure:"streak_freezes"`
+}
+
+// ShopConfig is the item catalogue of the coin shop. Avatars listed as items must be bought
+// before they can be set, every other avatar id is free.
+type ShopConfig struct {
+	Items []*ShopItemConfig `mapstructure:"items"`
+}
+
+// ShopItemConfig is an item of the shop, kind is one of avatar, avatar_frame, title and streak_freeze.
+// AvatarID is only set for avatars.
+type ShopItemConfig struct {
+	Code     string `mapstructure:"code"`
+	Kind     string `mapstructure:"kind"`
+	Title    string `mapstructure:"title"`
+	Price    int64  `mapstructure:"price"`
+	AvatarID int32  `mapstructure:"avatar_id"`
+}
+
+// FriendsConfig caps the accepted friends and the outgoing pending friend requests of a user, zero is unlimited.
+// Pending requests older than RequestTTL are deleted every ExpiryInterval, and recipients of requests waiting
+// longer than RemindAfter are reminded once. A zero RequestTTL keeps requests and a zero RemindAfter sends no reminders.
+// Suggestions count ratings within SuggestionRatingRange as similar and matches played within SuggestionMatchWindow.
+type FriendsConfig struct {
+	MaxFriends         int64         `mapstructure:"max_friends"`
+	MaxPendingRequests int64         `mapstructure:"max_pending_requests"`
+	RequestTTL         time.Duration `mapstructure:"request_ttl"`
+	RemindAfter        time.Duration `mapstructure:"remind_after"`
+	ExpiryInterval     time.Duration `mapstructure:"expiry_interval"`
+	ExpiryBatchSize    uint64        `mapstructure:"expiry_batch_size"`
+
+	SuggestionRatingRange int32         `mapstructure:"suggestion_rating_range"`
+	SuggestionMatchWindow time.Duration `mapstructure:"suggestion_match_window"`
+}
+
+// Validate reports the friends settings that cannot work together.
+func (c *FriendsConfig) Validate() error {
+	if c.ExpiryInterval <= 0 && (c.RequestTTL > 0 || c.RemindAfter > 0) {
+		return errors.New("friends expiry interval must be positive when request ttl or remind after is set")
+	}
+
+	return nil
+}
+
+// ClansConfig caps the members of a clan, zero falls back to the default cap. Clan tags and names
+// containing any of BlockedWords are rejected.
+type ClansConfig struct {
+	MaxMembers   int64    `mapstructure:"max_members"`
+	BlockedWords []string `mapstructure:"blocked_words"`
+}
+
+// PartiesConfig bounds the members of a party, a party expires when it does not change within TTL.
+// Zero values fall back to the defaults.
+type PartiesConfig struct {
+	TTL     time.Duration `mapstructure:"ttl"`
+	MaxSize int           `mapstructure:"max_size"`
+}
+
+// ReportsConfig caps the reports a user files within Window, zero MaxPerWindow is unlimited
+// and a zero Window falls back to a day.
+type ReportsConfig struct {
+	MaxPerWindow int64         `mapstructure:"max_per_window"`
+	Window       time.Duration `mapstructure:"window"`
+}
+
+// NotificationsConfig keeps notifications for Retention, older ones are deleted every PurgeInterval
+// in batches of PurgeBatchSize. A zero Retention keeps notifications forever.
+type NotificationsConfig struct {
+	Retention      time.Duration `mapstructure:"retention"`
+	PurgeInterval  time.Duration `mapstructure:"purge_interval"`
+	PurgeBatchSize uint64        `mapstructure:"purge_batch_size"`
+}
+
+// Validate reports the notifications settings that cannot work together.
+func (c *NotificationsConfig) Validate() error {
+	if c.PurgeInterval <= 0 && c.Retention > 0 {
+		return errors.New("notifications purge interval must be positive when retention is set")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// DefaultRebuildInterval is how often the board is rebuilt from the database when no interval is configured.
+const DefaultRebuildInterval = time.Hour
+
+var (
+	// ErrNotReady is returned until the board has been rebuilt from the database at least once.
+	ErrNotReady = errors.New("leaderboard is not built yet")
+	// ErrNotRanked is returned when the user is not on the board.
+	ErrNotRanked = errors.New("user is not ranked")
+)
+
+// Board keeps players ranked by rating, globally and per country.
+// Players with equal rating are ordered by id descending.
+type Board interface {
+	// Set puts the users on the board with their current rating and country.
+	Set(ctx context.Context, users ...*profile.User) error
+	// Remove takes the users off the board.
+	Remove(ctx context.Context, userIDs ...uuid.UUID) error
+	// Range returns entries starting at the zero-based offset, the user of each entry only carries id and rating.
+	Range(ctx context.Context, country *string, offset, limit uint64) ([]*profile.LeaderboardEntry, error)
+	// Rank returns the one-based rank of the user.
+	Rank(ctx context.Context, country *string, userID uuid.UUID) (uint64, error)
+	// Rebuild replaces the whole board with the given users.
+	Rebuild(ctx context.Context, users []*profile.User) error
+}
@@ -0,0 +1,265 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const (
+	globalKey    = "leaderboard:global"
+	countriesKey = "leaderboard:countries"
+	readyKey     = "leaderboard:ready"
+	rebuildKey   = "leaderboard:rebuild:"
+)
+
+var _ Board = (*RedisBoard)(nil)
+
+// RedisBoard keeps rankings in sorted sets scored by rating, the country of every ranked user
+// is kept in a hash so country boards can be updated when it changes.
+type RedisBoard struct {
+	client *redis.Client
+}
+
+func NewRedisBoard(client *redis.Client) *RedisBoard {
+	return &RedisBoard{client: client}
+}
+
+func (b *RedisBoard) Set(ctx context.Context, users ...*profile.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	fields := make([]string, len(users))
+	for i, user := range users {
+		fields[i] = user.ID.String()
+	}
+
+	previous, err := b.client.HMGet(ctx, countriesKey, fields...).Result()
+	if err != nil {
+		return fmt.Errorf("reading countries: %w", err)
+	}
+
+	_, err = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, user := range users {
+			member := redis.Z{Score: float64(user.Rating), Member: fields[i]}
+
+			pipe.ZAdd(ctx, globalKey, member)
+
+			if old, ok := previous[i].(string); ok && (user.Country == nil || *user.Country != old) {
+				pipe.ZRem(ctx, countryKey(old), fields[i])
+			}
+
+			if user.Country == nil {
+				pipe.HDel(ctx, countriesKey, fields[i])
+				continue
+			}
+
+			pipe.ZAdd(ctx, countryKey(*user.Country), member)
+			pipe.HSet(ctx, countriesKey, fields[i], *user.Country)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("updating leaderboard: %w", err)
+	}
+
+	return nil
+}
+
+func (b *RedisBoard) Remove(ctx context.Context, userIDs ...uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	fields := make([]string, len(userIDs))
+	members := make([]any, len(userIDs))
+	for i, id := range userIDs {
+		fields[i] = id.String()
+		members[i] = fields[i]
+	}
+
+	previous, err := b.client.HMGet(ctx, countriesKey, fields...).Result()
+	if err != nil {
+		return fmt.Errorf("reading countries: %w", err)
+	}
+
+	_, err = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, globalKey, members...)
+		pipe.HDel(ctx, countriesKey, fields...)
+
+		for i, value := range previous {
+			if country, ok := value.(string); ok {
+				pipe.ZRem(ctx, countryKey(country), fields[i])
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("removing from leaderboard: %w", err)
+	}
+
+	return nil
+}
+
+func (b *RedisBoard) Range(ctx context.Context, country *string, offset, limit uint64) ([]*profile.LeaderboardEntry, error) {
+	if err := b.ready(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit == 0 {
+		return nil, nil
+	}
+
+	members, err := b.client.ZRevRangeWithScores(ctx, boardKey(country), int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("reading leaderboard: %w", err)
+	}
+
+	entries := make([]*profile.LeaderboardEntry, 0, len(members))
+	for i, member := range members {
+		raw, _ := member.Member.(string)
+
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing leaderboard member %q: %w", raw, err)
+		}
+
+		entries = append(entries, &profile.LeaderboardEntry{
+			Rank: offset + uint64(i) + 1,
+			User: &profile.User{ID: id, Rating: int32(member.Score)},
+		})
+	}
+
+	return entries, nil
+}
+
+func (b *RedisBoard) Rank(ctx context.Context, country *string, userID uuid.UUID) (uint64, error) {
+	if err := b.ready(ctx); err != nil {
+		return 0, err
+	}
+
+	rank, err := b.client.ZRevRank(ctx, boardKey(country), userID.String()).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return 0, ErrNotRanked
+	case err != nil:
+		return 0, fmt.Errorf("reading rank: %w", err)
+	}
+
+	return uint64(rank) + 1, nil
+}
+
+// Rebuild fills temporary keys first and renames them over the live ones,
+// so readers never observe a partially built board.
+func (b *RedisBoard) Rebuild(ctx context.Context, users []*profile.User) error {
+	previous, err := b.client.HVals(ctx, countriesKey).Result()
+	if err != nil {
+		return fmt.Errorf("reading countries: %w", err)
+	}
+
+	stale := make(map[string]struct{}, len(previous))
+	for _, country := range previous {
+		stale[country] = struct{}{}
+	}
+
+	global := make([]redis.Z, 0, len(users))
+	byCountry := make(map[string][]redis.Z)
+	countries := make(map[string]any)
+
+	for _, user := range users {
+		member := redis.Z{Score: float64(user.Rating), Member: user.ID.String()}
+		global = append(global, member)
+
+		if user.Country != nil {
+			byCountry[*user.Country] = append(byCountry[*user.Country], member)
+			countries[user.ID.String()] = *user.Country
+			delete(stale, *user.Country)
+		}
+	}
+
+	_, err = b.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, rebuildKey+globalKey, rebuildKey+countriesKey)
+
+		if len(global) > 0 {
+			pipe.ZAdd(ctx, rebuildKey+globalKey, global...)
+		}
+
+		if len(countries) > 0 {
+			pipe.HSet(ctx, rebuildKey+countriesKey, countries)
+		}
+
+		for country, members := range byCountry {
+			pipe.Del(ctx, rebuildKey+countryKey(country))
+			pipe.ZAdd(ctx, rebuildKey+countryKey(country), members...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("writing leaderboard: %w", err)
+	}
+
+	_, err = b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		swap(ctx, pipe, globalKey, len(global) > 0)
+		swap(ctx, pipe, countriesKey, len(countries) > 0)
+
+		for country := range byCountry {
+			swap(ctx, pipe, countryKey(country), true)
+		}
+
+		for country := range stale {
+			pipe.Del(ctx, countryKey(country))
+		}
+
+		pipe.Set(ctx, readyKey, 1, 0)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("publishing leaderboard: %w", err)
+	}
+
+	return nil
+}
+
+func (b *RedisBoard) ready(ctx context.Context) error {
+	exists, err := b.client.Exists(ctx, readyKey).Result()
+	if err != nil {
+		return fmt.Errorf("checking leaderboard: %w", err)
+	}
+
+	if exists == 0 {
+		return ErrNotReady
+	}
+
+	return nil
+}
+
+func swap(ctx context.Context, pipe redis.Pipeliner, key string, built bool) {
+	if built {
+		pipe.Rename(ctx, rebuildKey+key, key)
+		return
+	}
+
+	pipe.Del(ctx, key)
+}
+
+func boardKey(country *string) string {
+	if country == nil {
+		return globalKey
+	}
+
+	return countryKey(*country)
+}
+
+func countryKey(country string) string {
+	return "leaderboard:country:" + country
+}
@@ -67,6 +67,91 @@ var (
 			Help: "Number of changed profiles passwords",
 		},
 	)
+
+	ProfileExportTotalCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "profile_export_total",
+			Help: "Number of profile data exports",
+		},
+		[]string{"format"},
+	)
+
+	ProfilePurgedTotalCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "profile_purged_total",
+			Help: "Number of deleted profiles purged after the grace period",
+		},
+	)
+)
+
+var (
+	CoinsTransactionsTotalCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "coins_transactions_total",
+			Help: "Number of applied coins ledger transactions",
+		},
+		[]string{"type"},
+	)
+
+	CoinsLedgerMismatchesGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "coins_ledger_mismatches",
+			Help: "Number of users whose balance differs from the coins ledger on the last check",
+		},
+	)
+)
+
+var AchievementsAwardedTotalCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "achievements_awarded_total",
+		Help: "Number of awarded achievements",
+	},
+	[]string{"code"},
+)
+
+var LevelUpsTotalCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "level_ups_total",
+		Help: "Number of levels gained by users",
+	},
+)
+
+var DailyRewardsClaimedTotalCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "daily_rewards_claimed_total",
+		Help: "Number of claimed daily rewards",
+	},
+)
+
+var ShopPurchasesTotalCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "shop_purchases_total",
+		Help: "Number of items bought in the coin shop",
+	},
+	[]string{"kind"},
+)
+
+var NotificationsPurgedTotalCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "notifications_purged_total",
+		Help: "Number of notifications deleted after the retention",
+	},
+)
+
+var (
+	FriendRequestsExpiredTotalCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "friend_requests_expired_total",
+			Help: "Number of pending friend requests deleted after the request ttl",
+		},
+	)
+
+	FriendRequestRemindersTotalCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "friend_request_reminders_total",
+			Help: "Number of pending friend request reminders sent to recipients",
+		},
+	)
 )
 
 var (
@@ -97,6 +182,20 @@ func Initialize() {
 
 	prometheus.MustRegister(ProfileDeletionTotalCounter)
 	prometheus.MustRegister(ProfileChangePasswordTotalCounter)
+	prometheus.MustRegister(ProfileExportTotalCounter)
+	prometheus.MustRegister(ProfilePurgedTotalCounter)
+
+	prometheus.MustRegister(CoinsTransactionsTotalCounter)
+	prometheus.MustRegister(CoinsLedgerMismatchesGauge)
+
+	prometheus.MustRegister(AchievementsAwardedTotalCounter)
+	prometheus.MustRegister(LevelUpsTotalCounter)
+	prometheus.MustRegister(DailyRewardsClaimedTotalCounter)
+	prometheus.MustRegister(ShopPurchasesTotalCounter)
+
+	prometheus.MustRegister(FriendRequestsExpiredTotalCounter)
+	prometheus.MustRegister(FriendRequestRemindersTotalCounter)
+	prometheus.MustRegister(NotificationsPurgedTotalCounter)
 
 	prometheus.MustRegister(AdminActionsTotalCounter)
 	prometheus.MustRegister(AdminForbittenActionsTotalCounter)
@@ -1,9 +1,14 @@
 package auth
 
-import "github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+import (
+	"time"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
 
 type ProfileWithCredentials struct {
-	Profile  *profile.Profile
-	Password string
-	Role     string
+	Profile   *profile.Profile
+	Password  string
+	Role      string
+	DeletedAt *time.Time
 }
@@ -18,8 +18,10 @@ func (p ProfileWithCredentials) Request(req *userspb.RegisterRequest) (*ProfileW
 			AvatarID:  req.GetAvatarId(),
 			Username:  req.GetUsername(),
 			CreatedAt: time.Now(),
+			Privacy:   profile.Public,
 		},
-		Email: req.GetEmail(),
+		Email:    req.GetEmail(),
+		TimeZone: profile.DefaultTimeZone,
 	}
 
 	p.Password = req.GetPassword()
@@ -0,0 +1,199 @@
+package clan
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const (
+	DefaultMaxMembers = 50
+
+	minTagLength  = 3
+	maxTagLength  = 5
+	minNameLength = 3
+	maxNameLength = 32
+)
+
+var (
+	// ErrInvalidTag is returned when the tag is not 3 to 5 latin letters or digits.
+	ErrInvalidTag = errors.New("clan tag must be 3 to 5 latin letters or digits")
+	// ErrInvalidName is returned when the name is shorter than 3 or longer than 32 characters.
+	ErrInvalidName = errors.New("clan name must be 3 to 32 characters long")
+	// ErrNotAllowed is returned when the tag or the name contains a blocked word.
+	ErrNotAllowed = errors.New("clan tag or name contains a blocked word")
+	// ErrNotOfficer is returned when a member acts on the clan without being an officer or the owner.
+	ErrNotOfficer = errors.New("only officers and the owner can manage the clan")
+	// ErrNotOwner is returned when a non-owner changes roles or transfers the ownership.
+	ErrNotOwner = errors.New("only the owner can do this")
+	// ErrOutranked is returned when a member is kicked by someone without a higher role.
+	ErrOutranked = errors.New("member has the same or a higher role")
+	// ErrClanFull is returned when the clan reached its member cap.
+	ErrClanFull = errors.New("clan is full")
+	// ErrOwnerMustTransfer is returned when the owner leaves a clan that still has other members.
+	ErrOwnerMustTransfer = errors.New("owner must transfer the ownership before leaving")
+	// ErrSelfAction is returned when a member kicks, promotes or transfers the clan to themselves.
+	ErrSelfAction = errors.New("cannot do this to yourself")
+	// ErrInvalidRole is returned when a member is given a role other than officer or member.
+	ErrInvalidRole = errors.New("role must be officer or member")
+)
+
+// Clan is a persistent group of players, its rating is the average rating of the members.
+type Clan struct {
+	ID           uuid.UUID `json:"id"`
+	Tag          string    `json:"tag"`
+	Name         string    `json:"name"`
+	OwnerID      uuid.UUID `json:"owner_id"`
+	Rating       int32     `json:"rating"`
+	MembersCount int64     `json:"members_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type Member struct {
+	User     *profile.User `json:"user"`
+	Role     Role          `json:"role"`
+	JoinedAt time.Time     `json:"joined_at"`
+}
+
+// Membership is the clan a user belongs to and their role in it.
+type Membership struct {
+	ClanID uuid.UUID
+	Role   Role
+}
+
+type JoinRequest struct {
+	User      *profile.User `json:"user"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type ClanWithMembers struct {
+	Clan    *Clan
+	Members []*Member
+}
+
+type JoinRequests struct {
+	Requests []*JoinRequest
+}
+
+type JoinResult struct {
+	Joined bool
+}
+
+type Role string
+
+func (r Role) String() string {
+	return string(r)
+}
+
+const (
+	RoleUnknown Role = "unknown"
+	RoleOwner   Role = "owner"
+	RoleOfficer Role = "officer"
+	RoleMember  Role = "member"
+)
+
+func (r Role) rank() int {
+	switch r {
+	case RoleOwner:
+		return 3
+	case RoleOfficer:
+		return 2
+	case RoleMember:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CanManage reports whether the role may invite users and accept join requests.
+func (r Role) CanManage() bool {
+	return r.rank() >= RoleOfficer.rank()
+}
+
+// Outranks reports whether the role may kick a member with the other role.
+func (r Role) Outranks(other Role) bool {
+	return r.CanManage() && r.rank() > other.rank()
+}
+
+func (r Role) ToGRPCEnum() userspb.ClanRole {
+	switch r {
+	case RoleOwner:
+		return userspb.ClanRole_CLAN_ROLE_OWNER
+	case RoleOfficer:
+		return userspb.ClanRole_CLAN_ROLE_OFFICER
+	case RoleMember:
+		return userspb.ClanRole_CLAN_ROLE_MEMBER
+	default:
+		return userspb.ClanRole_CLAN_ROLE_UNSPECIFIED
+	}
+}
+
+func roleFromGRPCEnum(role userspb.ClanRole) Role {
+	switch role {
+	case userspb.ClanRole_CLAN_ROLE_OWNER:
+		return RoleOwner
+	case userspb.ClanRole_CLAN_ROLE_OFFICER:
+		return RoleOfficer
+	case userspb.ClanRole_CLAN_ROLE_MEMBER:
+		return RoleMember
+	default:
+		return RoleUnknown
+	}
+}
+
+// NormalizeTag upper-cases the tag and checks that it is 3 to 5 latin letters or digits.
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToUpper(strings.TrimSpace(tag))
+
+	if len(tag) < minTagLength || len(tag) > maxTagLength {
+		return "", ErrInvalidTag
+	}
+
+	for _, r := range tag {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return "", ErrInvalidTag
+		}
+	}
+
+	return tag, nil
+}
+
+// NormalizeName trims the name and checks its length.
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if n := utf8.RuneCountInString(name); n < minNameLength || n > maxNameLength {
+		return "", ErrInvalidName
+	}
+
+	return name, nil
+}
+
+// leetReplacer undoes the digit substitutions commonly used to sneak blocked words through.
+var leetReplacer = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s")
+
+// ContainsBlockedWord reports whether the text contains any of the words, ignoring case,
+// whitespace and digit substitutions. The words are normalized the same way as the text,
+// so a configured word containing digits still matches.
+func ContainsBlockedWord(text string, words []string) bool {
+	text = normalizeWord(text)
+
+	for _, word := range words {
+		word = normalizeWord(word)
+		if word != "" && strings.Contains(text, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeWord(text string) string {
+	return leetReplacer.Replace(strings.ToLower(strings.Join(strings.Fields(text), "")))
+}
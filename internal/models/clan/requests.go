@@ -0,0 +1,68 @@
+package clan
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+type CreateClan struct {
+	OwnerID uuid.UUID
+	Tag     string
+	Name    string
+}
+
+type SetMemberRole struct {
+	ClanID   uuid.UUID
+	ActorID  uuid.UUID
+	MemberID uuid.UUID
+	Role     Role
+}
+
+var _ abstractions.Requestable[CreateClan, *userspb.CreateClanRequest] = (*CreateClan)(nil)
+
+func (c CreateClan) Request(req *userspb.CreateClanRequest) (*CreateClan, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	c.OwnerID = id
+
+	if c.Tag, err = NormalizeTag(req.GetTag()); err != nil {
+		return nil, apperrors.BadRequest(err)
+	}
+
+	if c.Name, err = NormalizeName(req.GetName()); err != nil {
+		return nil, apperrors.BadRequest(err)
+	}
+
+	return &c, nil
+}
+
+var _ abstractions.Requestable[SetMemberRole, *userspb.SetClanMemberRoleRequest] = (*SetMemberRole)(nil)
+
+func (s SetMemberRole) Request(req *userspb.SetClanMemberRoleRequest) (*SetMemberRole, error) {
+	var err error
+
+	if s.ActorID, err = uuid.Parse(req.GetUserId()); err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	if s.ClanID, err = uuid.Parse(req.GetClanId()); err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid clan id")
+	}
+
+	if s.MemberID, err = uuid.Parse(req.GetMemberId()); err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid member id")
+	}
+
+	s.Role = roleFromGRPCEnum(req.GetRole())
+	if s.Role != RoleOfficer && s.Role != RoleMember {
+		return nil, apperrors.BadRequest(ErrInvalidRole)
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,105 @@
+package clan
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+var _ abstractions.Responseable[userspb.Clan] = (*Clan)(nil)
+
+func (c *Clan) Response() (*userspb.Clan, error) {
+	var res userspb.Clan
+
+	res.Id = c.ID.String()
+	res.Tag = c.Tag
+	res.Name = c.Name
+	res.OwnerId = c.OwnerID.String()
+	res.Rating = c.Rating
+	res.MembersCount = uint64(c.MembersCount)
+	res.CreatedAt = timestamppb.New(c.CreatedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ClanMember] = (*Member)(nil)
+
+func (m *Member) Response() (*userspb.ClanMember, error) {
+	var res userspb.ClanMember
+
+	user, err := m.User.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	res.User = user
+	res.Role = m.Role.ToGRPCEnum()
+	res.JoinedAt = timestamppb.New(m.JoinedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.GetClanResponse] = (*ClanWithMembers)(nil)
+
+func (c *ClanWithMembers) Response() (*userspb.GetClanResponse, error) {
+	var res userspb.GetClanResponse
+
+	clan, err := c.Clan.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	res.Clan = clan
+	res.Members = make([]*userspb.ClanMember, len(c.Members))
+	for i, member := range c.Members {
+		m, memberErr := member.Response()
+		if memberErr != nil {
+			return nil, memberErr
+		}
+
+		res.Members[i] = m
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ClanJoinRequest] = (*JoinRequest)(nil)
+
+func (j *JoinRequest) Response() (*userspb.ClanJoinRequest, error) {
+	var res userspb.ClanJoinRequest
+
+	user, err := j.User.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	res.User = user
+	res.CreatedAt = timestamppb.New(j.CreatedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListClanJoinRequestsResponse] = (*JoinRequests)(nil)
+
+func (j *JoinRequests) Response() (*userspb.ListClanJoinRequestsResponse, error) {
+	var res userspb.ListClanJoinRequestsResponse
+
+	res.Requests = make([]*userspb.ClanJoinRequest, len(j.Requests))
+	for i, request := range j.Requests {
+		r, err := request.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Requests[i] = r
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.JoinClanResponse] = (*JoinResult)(nil)
+
+func (j *JoinResult) Response() (*userspb.JoinClanResponse, error) {
+	return &userspb.JoinClanResponse{Joined: j.Joined}, nil
+}
@@ -0,0 +1,122 @@
+package profile
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+// ErrAchievementsHidden is returned when the privacy setting of the user hides the achievements from the viewer.
+var ErrAchievementsHidden = errors.New("achievements are not visible")
+
+// AchievementMetric is a per-user counter achievements are awarded on.
+type AchievementMetric string
+
+const (
+	MetricMatchesPlayed AchievementMetric = "matches_played"
+	MetricMatchesWon    AchievementMetric = "matches_won"
+	MetricFriends       AchievementMetric = "friends"
+	MetricLoginDays     AchievementMetric = "login_days"
+	MetricLoginStreak   AchievementMetric = "login_streak"
+	MetricLevel         AchievementMetric = "level"
+)
+
+func (m AchievementMetric) Valid() bool {
+	switch m {
+	case MetricMatchesPlayed, MetricMatchesWon, MetricFriends, MetricLoginDays, MetricLoginStreak, MetricLevel:
+		return true
+	default:
+		return false
+	}
+}
+
+// AchievementEvent triggers evaluation of achievements whose metric it may change.
+type AchievementEvent string
+
+const (
+	EventMatchResult    AchievementEvent = "match_result"
+	EventFriendAccepted AchievementEvent = "friend_accepted"
+	EventLogin          AchievementEvent = "login"
+	EventLevelUp        AchievementEvent = "level_up"
+)
+
+// Metrics returns the metrics the event may change.
+func (e AchievementEvent) Metrics() []AchievementMetric {
+	switch e {
+	case EventMatchResult:
+		return []AchievementMetric{MetricMatchesPlayed, MetricMatchesWon}
+	case EventFriendAccepted:
+		return []AchievementMetric{MetricFriends}
+	case EventLogin:
+		return []AchievementMetric{MetricLoginDays, MetricLoginStreak}
+	case EventLevelUp:
+		return []AchievementMetric{MetricLevel}
+	default:
+		return nil
+	}
+}
+
+type AchievementDefinition struct {
+	Code        string            `json:"code"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Metric      AchievementMetric `json:"metric"`
+	Threshold   int64             `json:"threshold"`
+}
+
+// AchievementProgress holds current values of every metric of a user.
+type AchievementProgress map[AchievementMetric]int64
+
+type UserAchievement struct {
+	Code      string    `json:"code"`
+	AwardedAt time.Time `json:"awarded_at"`
+}
+
+// Achievement is a catalogue entry together with the progress of a user, AwardedAt is nil until it is earned.
+type Achievement struct {
+	Definition *AchievementDefinition
+	Progress   int64
+	AwardedAt  *time.Time
+}
+
+type Achievements struct {
+	Achievements []*Achievement
+}
+
+var _ abstractions.Responseable[userspb.Achievement] = (*Achievement)(nil)
+
+func (a *Achievement) Response() (*userspb.Achievement, error) {
+	var res userspb.Achievement
+
+	res.Code = a.Definition.Code
+	res.Title = a.Definition.Title
+	res.Description = a.Definition.Description
+	res.Threshold = a.Definition.Threshold
+	res.Progress = a.Progress
+
+	if a.AwardedAt != nil {
+		res.AwardedAt = timestamppb.New(*a.AwardedAt)
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListAchievementsResponse] = (*Achievements)(nil)
+
+func (a *Achievements) Response() (*userspb.ListAchievementsResponse, error) {
+	var res userspb.ListAchievementsResponse
+
+	res.Achievements = make([]*userspb.Achievement, len(a.Achievements))
+	for i, achievement := range a.Achievements {
+		var err error
+		if res.Achievements[i], err = achievement.Response(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,62 @@
+package profile
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+var (
+	// ErrUserBlocked is returned when a user sends a friend request to someone who blocked them.
+	ErrUserBlocked = errors.New("user is blocked")
+	// ErrSelfBlock is returned when a user tries to block themselves.
+	ErrSelfBlock = errors.New("cannot block yourself")
+)
+
+// BlockedUser is a user blocked by the owner of the list.
+type BlockedUser struct {
+	User      *User     `json:"user"`
+	BlockedAt time.Time `json:"blocked_at"`
+}
+
+type BlockedUsers struct {
+	Users []*BlockedUser
+}
+
+var _ abstractions.Responseable[userspb.BlockedUser] = (*BlockedUser)(nil)
+
+func (b *BlockedUser) Response() (*userspb.BlockedUser, error) {
+	var res userspb.BlockedUser
+
+	user, err := b.User.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	res.User = user
+	res.BlockedAt = timestamppb.New(b.BlockedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListBlockedUsersResponse] = (*BlockedUsers)(nil)
+
+func (b *BlockedUsers) Response() (*userspb.ListBlockedUsersResponse, error) {
+	var res userspb.ListBlockedUsersResponse
+
+	res.Users = make([]*userspb.BlockedUser, len(b.Users))
+	for i, blocked := range b.Users {
+		u, err := blocked.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Users[i] = u
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,171 @@
+package profile
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	maxAnswerResults     = 500
+	maxAnswerBatchLength = 128
+	maxAnswerTimeMs      = 10 * 60 * 1000
+)
+
+// ErrCategoryStatsHidden is returned when the privacy setting of the user hides the category stats from the viewer.
+var ErrCategoryStatsHidden = errors.New("category stats are not visible")
+
+// AnswerResult is the answer to a question, only its category is kept.
+type AnswerResult struct {
+	CategoryID   int32
+	Correct      bool
+	AnswerTimeMs int64
+}
+
+// AnswerResults is a batch of answers of a user, a repeated batch id has no effect.
+type AnswerResults struct {
+	UserID  uuid.UUID
+	BatchID string
+	Results []*AnswerResult
+}
+
+// CategoryStats are the answers of a user in a quiz category, Name is nil when it could not be resolved.
+type CategoryStats struct {
+	CategoryID   int32   `json:"category_id"`
+	Name         *string `json:"name"`
+	Answered     int64   `json:"answered"`
+	Correct      int64   `json:"correct"`
+	AnswerTimeMs int64   `json:"answer_time_ms"`
+}
+
+type CategoryStatsList struct {
+	Stats []*CategoryStats
+}
+
+// Totals sums the results of the batch per category, ordered by category id.
+func (a *AnswerResults) Totals() []*CategoryStats {
+	totals := make(map[int32]*CategoryStats)
+
+	for _, result := range a.Results {
+		stats, ok := totals[result.CategoryID]
+		if !ok {
+			stats = &CategoryStats{CategoryID: result.CategoryID}
+			totals[result.CategoryID] = stats
+		}
+
+		stats.Answered++
+		stats.AnswerTimeMs += result.AnswerTimeMs
+
+		if result.Correct {
+			stats.Correct++
+		}
+	}
+
+	list := make([]*CategoryStats, 0, len(totals))
+	for _, stats := range totals {
+		list = append(list, stats)
+	}
+
+	slices.SortFunc(list, func(a, b *CategoryStats) int {
+		return cmp.Compare(a.CategoryID, b.CategoryID)
+	})
+
+	return list
+}
+
+// Accuracy is the share of correct answers, zero when nothing was answered.
+func (c *CategoryStats) Accuracy() float64 {
+	if c.Answered == 0 {
+		return 0
+	}
+
+	return float64(c.Correct) / float64(c.Answered)
+}
+
+func (c *CategoryStats) AverageAnswerTimeMs() float64 {
+	if c.Answered == 0 {
+		return 0
+	}
+
+	return float64(c.AnswerTimeMs) / float64(c.Answered)
+}
+
+var _ abstractions.Requestable[AnswerResults, *userspb.ReportAnswerResultsRequest] = (*AnswerResults)(nil)
+
+func (a AnswerResults) Request(req *userspb.ReportAnswerResultsRequest) (*AnswerResults, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	a.UserID = id
+	a.BatchID = strings.TrimSpace(req.GetBatchId())
+
+	switch {
+	case a.BatchID == "" || len(a.BatchID) > maxAnswerBatchLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("batch id must be 1 to %d characters", maxAnswerBatchLength))
+	case len(req.GetResults()) == 0:
+		return nil, apperrors.BadRequest(errors.New("answer results not provided"))
+	case len(req.GetResults()) > maxAnswerResults:
+		return nil, apperrors.BadRequest(fmt.Errorf("at most %d answer results can be reported at once", maxAnswerResults))
+	}
+
+	a.Results = make([]*AnswerResult, len(req.GetResults()))
+	for i, result := range req.GetResults() {
+		switch {
+		case result.GetCategoryId() <= 0:
+			return nil, apperrors.BadRequest(errors.New("invalid category id"))
+		case result.GetAnswerTimeMs() > maxAnswerTimeMs:
+			return nil, apperrors.BadRequest(fmt.Errorf("answer time exceeds %d ms", maxAnswerTimeMs))
+		}
+
+		a.Results[i] = &AnswerResult{
+			CategoryID:   result.GetCategoryId(),
+			Correct:      result.GetCorrect(),
+			AnswerTimeMs: int64(result.GetAnswerTimeMs()),
+		}
+	}
+
+	return &a, nil
+}
+
+var _ abstractions.Responseable[userspb.CategoryStats] = (*CategoryStats)(nil)
+
+func (c *CategoryStats) Response() (*userspb.CategoryStats, error) {
+	var res userspb.CategoryStats
+
+	res.CategoryId = c.CategoryID
+	res.Name = c.Name
+	res.Answered = c.Answered
+	res.Correct = c.Correct
+	res.Accuracy = c.Accuracy()
+	res.AverageAnswerTimeMs = c.AverageAnswerTimeMs()
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.GetCategoryStatsResponse] = (*CategoryStatsList)(nil)
+
+func (l *CategoryStatsList) Response() (*userspb.GetCategoryStatsResponse, error) {
+	var res userspb.GetCategoryStatsResponse
+
+	res.Stats = make([]*userspb.CategoryStats, len(l.Stats))
+	for i, stats := range l.Stats {
+		s, err := stats.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Stats[i] = s
+	}
+
+	return &res, nil
+}
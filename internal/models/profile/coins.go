@@ -0,0 +1,216 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxCoinsAmount          = math.MaxInt32
+	maxCoinsReasonLength    = 64
+	maxCoinsSourceLength    = 64
+	maxIdempotencyKeyLength = 128
+)
+
+// DefaultReconcileBatchSize caps the mismatches a ledger check reports when no batch size is configured.
+const DefaultReconcileBatchSize = 100
+
+// CoinTransaction is an entry of the append-only coins ledger.
+type CoinTransaction struct {
+	ID             int64     `json:"id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Amount         int64     `json:"amount"`
+	Reason         string    `json:"reason"`
+	Source         string    `json:"source"`
+	IdempotencyKey string    `json:"idempotency_key"`
+	BalanceAfter   int64     `json:"balance_after"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// CoinOperation changes the balance of a user by a signed amount,
+// repeating an operation with the same idempotency key has no effect.
+type CoinOperation struct {
+	UserID         uuid.UUID
+	Amount         int64
+	Reason         string
+	Source         string
+	IdempotencyKey string
+}
+
+type CreditCoins struct {
+	CoinOperation
+}
+
+type DebitCoins struct {
+	CoinOperation
+}
+
+type ListCoinTransactions struct {
+	UserID uuid.UUID
+	Size   uint64
+	Cursor *int64
+}
+
+type CoinTransactionsPage struct {
+	Transactions []*CoinTransaction
+	NextCursor   *int64
+}
+
+// CoinsMismatch is a user whose balance differs from the sum of the ledger.
+type CoinsMismatch struct {
+	UserID    uuid.UUID
+	Balance   int64
+	LedgerSum int64
+}
+
+type CoinsReconciliation struct {
+	Mismatches []*CoinsMismatch
+}
+
+func newCoinOperation(userID string, amount uint64, reason, source, key string) (*CoinOperation, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	op := CoinOperation{
+		UserID:         id,
+		Reason:         strings.TrimSpace(reason),
+		Source:         strings.TrimSpace(source),
+		IdempotencyKey: strings.TrimSpace(key),
+	}
+
+	switch {
+	case amount == 0:
+		return nil, apperrors.BadRequest(errors.New("coins amount not provided"))
+	case amount > maxCoinsAmount:
+		return nil, apperrors.BadRequest(fmt.Errorf("coins amount exceeds %d", maxCoinsAmount))
+	case op.Reason == "" || len(op.Reason) > maxCoinsReasonLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("reason must be 1 to %d characters", maxCoinsReasonLength))
+	case op.Source == "" || len(op.Source) > maxCoinsSourceLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("source must be 1 to %d characters", maxCoinsSourceLength))
+	case op.IdempotencyKey == "" || len(op.IdempotencyKey) > maxIdempotencyKeyLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("idempotency key must be 1 to %d characters", maxIdempotencyKeyLength))
+	}
+
+	op.Amount = int64(amount)
+
+	return &op, nil
+}
+
+var _ abstractions.Requestable[CreditCoins, *userspb.CreditCoinsRequest] = (*CreditCoins)(nil)
+
+func (c CreditCoins) Request(req *userspb.CreditCoinsRequest) (*CreditCoins, error) {
+	op, err := newCoinOperation(req.GetUserId(), req.GetAmount(), req.GetReason(), req.GetSource(), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+
+	c.CoinOperation = *op
+
+	return &c, nil
+}
+
+var _ abstractions.Requestable[DebitCoins, *userspb.DebitCoinsRequest] = (*DebitCoins)(nil)
+
+func (d DebitCoins) Request(req *userspb.DebitCoinsRequest) (*DebitCoins, error) {
+	op, err := newCoinOperation(req.GetUserId(), req.GetAmount(), req.GetReason(), req.GetSource(), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+
+	op.Amount = -op.Amount
+	d.CoinOperation = *op
+
+	return &d, nil
+}
+
+var _ abstractions.Requestable[ListCoinTransactions, *userspb.ListCoinTransactionsRequest] = (*ListCoinTransactions)(nil)
+
+func (l ListCoinTransactions) Request(req *userspb.ListCoinTransactionsRequest) (*ListCoinTransactions, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	l.UserID = id
+	l.Size = pageSize(req.GetSize())
+
+	if req.Cursor != nil {
+		cursor, cursorErr := DecodeIDCursor(req.GetCursor())
+		if cursorErr != nil {
+			return nil, apperrors.BadRequest(cursorErr)
+		}
+
+		l.Cursor = &cursor
+	}
+
+	return &l, nil
+}
+
+var _ abstractions.Responseable[userspb.CoinTransaction] = (*CoinTransaction)(nil)
+
+func (t *CoinTransaction) Response() (*userspb.CoinTransaction, error) {
+	var res userspb.CoinTransaction
+
+	res.Id = t.ID
+	res.UserId = t.UserID.String()
+	res.Amount = t.Amount
+	res.Reason = t.Reason
+	res.Source = t.Source
+	res.IdempotencyKey = t.IdempotencyKey
+	res.BalanceAfter = t.BalanceAfter
+	res.CreatedAt = timestamppb.New(t.CreatedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListCoinTransactionsResponse] = (*CoinTransactionsPage)(nil)
+
+func (p *CoinTransactionsPage) Response() (*userspb.ListCoinTransactionsResponse, error) {
+	var res userspb.ListCoinTransactionsResponse
+
+	res.Transactions = make([]*userspb.CoinTransaction, len(p.Transactions))
+	for i, transaction := range p.Transactions {
+		t, err := transaction.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Transactions[i] = t
+	}
+
+	if p.NextCursor != nil {
+		cursor := EncodeIDCursor(*p.NextCursor)
+		res.NextCursor = &cursor
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ReconcileCoinsResponse] = (*CoinsReconciliation)(nil)
+
+func (r *CoinsReconciliation) Response() (*userspb.ReconcileCoinsResponse, error) {
+	var res userspb.ReconcileCoinsResponse
+
+	res.Mismatches = make([]*userspb.CoinsMismatch, len(r.Mismatches))
+	for i, m := range r.Mismatches {
+		res.Mismatches[i] = &userspb.CoinsMismatch{
+			UserId:    m.UserID.String(),
+			Balance:   m.Balance,
+			LedgerSum: m.LedgerSum,
+		}
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,228 @@
+package profile
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// SearchCursor is the keyset position of the last player returned by a search page.
+type SearchCursor struct {
+	Score  float32
+	Rating int32
+	ID     uuid.UUID
+}
+
+func (c *SearchCursor) Encode() string {
+	raw := strings.Join([]string{
+		strconv.FormatFloat(float64(c.Score), 'g', -1, 32),
+		strconv.FormatInt(int64(c.Rating), 10),
+		c.ID.String(),
+	}, ":")
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeSearchCursor(cursor string) (*SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return nil, errInvalidCursor
+	}
+
+	score, err := strconv.ParseFloat(parts[0], 32)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	rating, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &SearchCursor{
+		Score:  float32(score),
+		Rating: int32(rating),
+		ID:     id,
+	}, nil
+}
+
+// EncodeIDCursor encodes the keyset position of pages ordered by a serial id.
+func EncodeIDCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func DecodeIDCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, errInvalidCursor
+	}
+
+	return id, nil
+}
+
+// MatchCursor is the keyset position of the last match returned by a match history page.
+type MatchCursor struct {
+	EndedAt time.Time
+	MatchID uuid.UUID
+}
+
+func (c *MatchCursor) Encode() string {
+	raw := strconv.FormatInt(c.EndedAt.UnixMicro(), 10) + ":" + c.MatchID.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeMatchCursor(cursor string) (*MatchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	endedAt, matchID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, errInvalidCursor
+	}
+
+	micros, err := strconv.ParseInt(endedAt, 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	id, err := uuid.Parse(matchID)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &MatchCursor{
+		EndedAt: time.UnixMicro(micros).UTC(),
+		MatchID: id,
+	}, nil
+}
+
+// FriendsCursor is the keyset position of the last friend returned by a friends page,
+// only the field of the sort it was issued for is set.
+type FriendsCursor struct {
+	Sort     FriendsSort
+	Username string
+	Rating   int32
+	Since    time.Time
+	ID       uuid.UUID
+}
+
+func (c *FriendsCursor) Encode() string {
+	var value string
+
+	switch c.Sort {
+	case SortByRating:
+		value = strconv.FormatInt(int64(c.Rating), 10)
+	case SortBySince:
+		value = strconv.FormatInt(c.Since.UnixMicro(), 10)
+	default:
+		value = c.Username
+	}
+
+	raw := strings.Join([]string{string(c.Sort), c.ID.String(), value}, ":")
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeFriendsCursor decodes a cursor issued for the same sort.
+func DecodeFriendsCursor(cursor string, sort FriendsSort) (*FriendsCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	parts := strings.SplitN(string(raw), ":", 3)
+	if len(parts) != 3 || FriendsSort(parts[0]) != sort {
+		return nil, errInvalidCursor
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	c := &FriendsCursor{Sort: sort, ID: id}
+
+	switch sort {
+	case SortByRating:
+		rating, parseErr := strconv.ParseInt(parts[2], 10, 32)
+		if parseErr != nil {
+			return nil, errInvalidCursor
+		}
+
+		c.Rating = int32(rating)
+	case SortBySince:
+		micros, parseErr := strconv.ParseInt(parts[2], 10, 64)
+		if parseErr != nil {
+			return nil, errInvalidCursor
+		}
+
+		c.Since = time.UnixMicro(micros).UTC()
+	default:
+		c.Username = parts[2]
+	}
+
+	return c, nil
+}
+
+// NotificationCursor is the keyset position of the last notification returned by a notifications page.
+type NotificationCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func (c *NotificationCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixMicro(), 10) + ":" + c.ID.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeNotificationCursor(cursor string) (*NotificationCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	createdAt, notificationID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, errInvalidCursor
+	}
+
+	micros, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	id, err := uuid.Parse(notificationID)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &NotificationCursor{
+		CreatedAt: time.UnixMicro(micros).UTC(),
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package profile
+
+import (
+	"errors"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const DefaultTimeZone = "UTC"
+
+var errInvalidTimeZone = errors.New("time zone must be an IANA time zone name")
+
+// NormalizeTimeZone validates an IANA time zone name such as Europe/Berlin.
+func NormalizeTimeZone(timeZone string) (string, error) {
+	timeZone = strings.TrimSpace(timeZone)
+	if timeZone == "" || timeZone == "Local" {
+		return "", apperrors.BadRequest(errInvalidTimeZone)
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return "", apperrors.BadRequest(errInvalidTimeZone)
+	}
+
+	return timeZone, nil
+}
+
+// LocalDay returns the calendar day of the instant in the time zone as midnight UTC.
+func LocalDay(now time.Time, timeZone string) time.Time {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	year, month, day := now.In(location).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// DailyReward is an entry of the reward table.
+type DailyReward struct {
+	Coins         int64
+	StreakFreezes int32
+}
+
+// DailyRewardTable pays the entry at index i on day i+1 of a streak, the last entry is repeated.
+type DailyRewardTable []DailyReward
+
+// For returns the reward of the streak day.
+func (t DailyRewardTable) For(streak int32) DailyReward {
+	if len(t) == 0 || streak < 1 {
+		return DailyReward{}
+	}
+
+	return t[min(int(streak), len(t))-1]
+}
+
+// DailyRewardState is the streak of a user, LastClaimedOn is nil before the first claim.
+type DailyRewardState struct {
+	Streak        int32
+	StreakFreezes int32
+	LastClaimedOn *time.Time
+}
+
+// Claim advances the streak to the day: the next day continues it, a single missed day
+// is bridged by a streak freeze and a longer gap starts a new streak. It reports false
+// when the day is not after the last claimed one.
+func (s *DailyRewardState) Claim(day time.Time) (freezeUsed bool, ok bool) {
+	if s.LastClaimedOn == nil {
+		s.Streak = 1
+		s.LastClaimedOn = &day
+
+		return false, true
+	}
+
+	switch gap := int(day.Sub(*s.LastClaimedOn).Hours() / 24); {
+	case gap < 1:
+		return false, false
+	case gap == 1:
+		s.Streak++
+	case gap == 2 && s.StreakFreezes > 0:
+		s.Streak++
+		s.StreakFreezes--
+		freezeUsed = true
+	default:
+		s.Streak = 1
+	}
+
+	s.LastClaimedOn = &day
+
+	return freezeUsed, true
+}
+
+// DailyRewardKey is the idempotency key of the coins paid for the claim of the day.
+func DailyRewardKey(day time.Time) string {
+	return "daily-reward:" + day.Format(time.DateOnly)
+}
+
+// DailyRewardClaim is a claimed daily reward, StreakFreezes is the number the user holds after it.
+type DailyRewardClaim struct {
+	UserID              uuid.UUID `json:"user_id"`
+	Day                 time.Time `json:"day"`
+	Streak              int32     `json:"streak"`
+	Coins               int64     `json:"coins"`
+	StreakFreezesEarned int32     `json:"streak_freezes_earned"`
+	StreakFreezeUsed    bool      `json:"streak_freeze_used"`
+	StreakFreezes       int32     `json:"streak_freezes"`
+	ClaimedAt           time.Time `json:"claimed_at"`
+}
+
+var _ abstractions.Responseable[userspb.DailyReward] = (*DailyRewardClaim)(nil)
+
+func (c *DailyRewardClaim) Response() (*userspb.DailyReward, error) {
+	var res userspb.DailyReward
+
+	res.UserId = c.UserID.String()
+	res.Day = c.Day.Format(time.DateOnly)
+	res.Streak = c.Streak
+	res.Coins = c.Coins
+	res.StreakFreezesEarned = c.StreakFreezesEarned
+	res.StreakFreezeUsed = c.StreakFreezeUsed
+	res.StreakFreezes = c.StreakFreezes
+	res.ClaimedAt = timestamppb.New(c.ClaimedAt)
+
+	return &res, nil
+}
@@ -0,0 +1,139 @@
+package profile
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"time"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	JSON ExportFormat = "json"
+	ZIP  ExportFormat = "zip"
+)
+
+type ExportFormat string
+
+func (f ExportFormat) String() string {
+	return string(f)
+}
+
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ZIP:
+		return "application/zip"
+	default:
+		return "application/json"
+	}
+}
+
+func ExportFormatFromGRPCEnum(format userspb.ExportFormat) ExportFormat {
+	switch format {
+	case userspb.ExportFormat_EXPORT_FORMAT_ZIP:
+		return ZIP
+	default:
+		return JSON
+	}
+}
+
+// DataExport holds everything stored about a single user.
+type DataExport struct {
+	Profile *Profile `json:"profile"`
+	ExportRecords
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// ExportRecords are the rows other tables hold about the user, each kept as the JSON array it is exported as.
+type ExportRecords struct {
+	Friends           json.RawMessage `json:"friends"`
+	CoinTransactions  json.RawMessage `json:"coin_transactions"`
+	RatingHistory     json.RawMessage `json:"rating_history"`
+	SeasonResults     json.RawMessage `json:"season_results"`
+	Achievements      json.RawMessage `json:"achievements"`
+	LoginDays         json.RawMessage `json:"login_days"`
+	XPGrants          json.RawMessage `json:"xp_grants"`
+	DailyRewards      json.RawMessage `json:"daily_rewards"`
+	DailyRewardClaims json.RawMessage `json:"daily_reward_claims"`
+	CategoryStats     json.RawMessage `json:"category_stats"`
+	Matches           json.RawMessage `json:"matches"`
+	Inventory         json.RawMessage `json:"inventory"`
+	Purchases         json.RawMessage `json:"purchases"`
+	Blocks            json.RawMessage `json:"blocks"`
+	ClanMembership    json.RawMessage `json:"clan_membership"`
+	ClanApplications  json.RawMessage `json:"clan_applications"`
+	ReportsFiled      json.RawMessage `json:"reports_filed"`
+	Notifications     json.RawMessage `json:"notifications"`
+}
+
+type exportFile struct {
+	name    string
+	content any
+}
+
+func (r *ExportRecords) files() []exportFile {
+	return []exportFile{
+		{name: "friends.json", content: r.Friends},
+		{name: "coin_transactions.json", content: r.CoinTransactions},
+		{name: "rating_history.json", content: r.RatingHistory},
+		{name: "season_results.json", content: r.SeasonResults},
+		{name: "achievements.json", content: r.Achievements},
+		{name: "login_days.json", content: r.LoginDays},
+		{name: "xp_grants.json", content: r.XPGrants},
+		{name: "daily_rewards.json", content: r.DailyRewards},
+		{name: "daily_reward_claims.json", content: r.DailyRewardClaims},
+		{name: "category_stats.json", content: r.CategoryStats},
+		{name: "matches.json", content: r.Matches},
+		{name: "inventory.json", content: r.Inventory},
+		{name: "purchases.json", content: r.Purchases},
+		{name: "blocks.json", content: r.Blocks},
+		{name: "clan_membership.json", content: r.ClanMembership},
+		{name: "clan_applications.json", content: r.ClanApplications},
+		{name: "reports_filed.json", content: r.ReportsFiled},
+		{name: "notifications.json", content: r.Notifications},
+	}
+}
+
+func (e *DataExport) FileName(format ExportFormat) string {
+	return "users-export-" + e.Profile.User.ID.String() + "." + format.String()
+}
+
+func (e *DataExport) Encode(format ExportFormat) ([]byte, error) {
+	if format != ZIP {
+		return json.MarshalIndent(e, "", "  ")
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	files := append([]exportFile{
+		{name: "profile.json", content: e.Profile},
+	}, e.files()...)
+
+	for _, file := range files {
+		data, err := json.MarshalIndent(file.content, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		w, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: e.ExportedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = w.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
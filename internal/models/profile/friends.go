@@ -0,0 +1,249 @@
+package profile
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+var (
+	// ErrSelfFriendRequest is returned when a user sends a friend request to themselves.
+	ErrSelfFriendRequest = errors.New("cannot send a friend request to yourself")
+	// ErrFriendLimitReached is returned when either user already has the maximum number of friends.
+	ErrFriendLimitReached = errors.New("friend limit reached")
+	// ErrPendingLimitReached is returned when the requester has too many friend requests waiting for an answer.
+	ErrPendingLimitReached = errors.New("pending friend request limit reached")
+	// ErrFriendsHidden is returned when the privacy setting of the user hides their friends from the viewer.
+	ErrFriendsHidden = errors.New("friends are not visible")
+	// ErrMutualFriendsSelf is returned when a user asks for the friends they have in common with themselves.
+	ErrMutualFriendsSelf = errors.New("mutual friends with yourself are not defined")
+)
+
+// FriendLimits caps the accepted friends and the outgoing pending requests of a user, zero is unlimited.
+type FriendLimits struct {
+	MaxFriends         int64
+	MaxPendingRequests int64
+}
+
+// FriendRequestReminder tells a user about the friend requests waiting for their answer.
+type FriendRequestReminder struct {
+	UserID       uuid.UUID
+	RequesterIDs []uuid.UUID
+}
+
+// FriendRelation selects which side of the friendships of a user is listed.
+type FriendRelation string
+
+const (
+	RelationAccepted FriendRelation = "accepted"
+	RelationIncoming FriendRelation = "incoming"
+	RelationOutgoing FriendRelation = "outgoing"
+)
+
+type FriendsSort string
+
+const (
+	SortByUsername FriendsSort = "username"
+	SortByRating   FriendsSort = "rating"
+	SortBySince    FriendsSort = "since"
+)
+
+func friendsSortFromGRPCEnum(sort userspb.FriendsSort) FriendsSort {
+	switch sort {
+	case userspb.FriendsSort_FRIENDS_SORT_RATING:
+		return SortByRating
+	case userspb.FriendsSort_FRIENDS_SORT_SINCE:
+		return SortBySince
+	default:
+		return SortByUsername
+	}
+}
+
+// ListFriends is a page of friends or friend requests of a user, ordered by the sort and then by id.
+type ListFriends struct {
+	UserID     uuid.UUID
+	Relation   FriendRelation
+	Sort       FriendsSort
+	Descending bool
+	Size       uint64
+	Cursor     *FriendsCursor
+}
+
+type FriendsPage struct {
+	Friends    []*Friend
+	NextCursor *FriendsCursor
+}
+
+// CursorOf returns the keyset position of the friend in the sort.
+func (l *ListFriends) CursorOf(f *Friend) *FriendsCursor {
+	return &FriendsCursor{
+		Sort:     l.Sort,
+		Username: f.User.Username,
+		Rating:   f.User.Rating,
+		Since:    f.Since,
+		ID:       f.User.ID,
+	}
+}
+
+type friendsPageRequest interface {
+	GetUserId() string
+	GetSort() userspb.FriendsSort
+	GetDescending() bool
+	GetSize() uint64
+}
+
+func newListFriends(relation FriendRelation, req friendsPageRequest, cursor *string) (*ListFriends, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	l := ListFriends{
+		UserID:     id,
+		Relation:   relation,
+		Sort:       friendsSortFromGRPCEnum(req.GetSort()),
+		Descending: req.GetDescending(),
+		Size:       pageSize(req.GetSize()),
+	}
+
+	if cursor != nil {
+		c, cursorErr := DecodeFriendsCursor(*cursor, l.Sort)
+		if cursorErr != nil {
+			return nil, apperrors.BadRequest(cursorErr)
+		}
+
+		l.Cursor = c
+	}
+
+	return &l, nil
+}
+
+var _ abstractions.Requestable[ListFriends, *userspb.ListAcceptedFriendsRequest] = (*ListFriends)(nil)
+
+func (l ListFriends) Request(req *userspb.ListAcceptedFriendsRequest) (*ListFriends, error) {
+	return newListFriends(RelationAccepted, req, req.Cursor)
+}
+
+// ListIncomingFriendRequests lists pending requests sent to the user.
+type ListIncomingFriendRequests struct {
+	ListFriends
+}
+
+var _ abstractions.Requestable[ListIncomingFriendRequests, *userspb.ListIncomingFriendRequestsRequest] = (*ListIncomingFriendRequests)(nil)
+
+func (l ListIncomingFriendRequests) Request(req *userspb.ListIncomingFriendRequestsRequest) (*ListIncomingFriendRequests, error) {
+	filter, err := newListFriends(RelationIncoming, req, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	l.ListFriends = *filter
+
+	return &l, nil
+}
+
+// ListOutgoingFriendRequests lists pending requests sent by the user.
+type ListOutgoingFriendRequests struct {
+	ListFriends
+}
+
+var _ abstractions.Requestable[ListOutgoingFriendRequests, *userspb.ListOutgoingFriendRequestsRequest] = (*ListOutgoingFriendRequests)(nil)
+
+func (l ListOutgoingFriendRequests) Request(req *userspb.ListOutgoingFriendRequestsRequest) (*ListOutgoingFriendRequests, error) {
+	filter, err := newListFriends(RelationOutgoing, req, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	l.ListFriends = *filter
+
+	return &l, nil
+}
+
+var _ abstractions.Responseable[userspb.FriendsPage] = (*FriendsPage)(nil)
+
+func (p *FriendsPage) Response() (*userspb.FriendsPage, error) {
+	var res userspb.FriendsPage
+
+	res.Friends = make([]*userspb.Friend, len(p.Friends))
+	for i, friend := range p.Friends {
+		f, err := friend.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Friends[i] = f
+	}
+
+	if p.NextCursor != nil {
+		cursor := p.NextCursor.Encode()
+		res.NextCursor = &cursor
+	}
+
+	return &res, nil
+}
+
+// GetMutualFriends pages the accepted friends the viewer and the user have in common, ordered by username.
+type GetMutualFriends struct {
+	UserID   uuid.UUID
+	ViewerID uuid.UUID
+	Size     uint64
+	Cursor   *FriendsCursor
+}
+
+type MutualFriendsPage struct {
+	Count      int64
+	Users      []*User
+	NextCursor *FriendsCursor
+}
+
+var _ abstractions.Requestable[GetMutualFriends, *userspb.GetMutualFriendsRequest] = (*GetMutualFriends)(nil)
+
+func (g GetMutualFriends) Request(req *userspb.GetMutualFriendsRequest) (*GetMutualFriends, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	g.UserID = id
+	g.Size = pageSize(req.GetSize())
+
+	if req.Cursor != nil {
+		cursor, cursorErr := DecodeFriendsCursor(req.GetCursor(), SortByUsername)
+		if cursorErr != nil {
+			return nil, apperrors.BadRequest(cursorErr)
+		}
+
+		g.Cursor = cursor
+	}
+
+	return &g, nil
+}
+
+var _ abstractions.Responseable[userspb.GetMutualFriendsResponse] = (*MutualFriendsPage)(nil)
+
+func (p *MutualFriendsPage) Response() (*userspb.GetMutualFriendsResponse, error) {
+	var res userspb.GetMutualFriendsResponse
+
+	res.Count = uint64(p.Count)
+	res.Users = make([]*userspb.User, len(p.Users))
+	for i, user := range p.Users {
+		u, err := user.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Users[i] = u
+	}
+
+	if p.NextCursor != nil {
+		cursor := p.NextCursor.Encode()
+		res.NextCursor = &cursor
+	}
+
+	return &res, nil
+}
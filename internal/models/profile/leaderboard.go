@@ -0,0 +1,108 @@
+package profile
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	defaultLeaderboardSize   uint64 = 10
+	maxLeaderboardSize       uint64 = 100
+	defaultLeaderboardRadius uint64 = 5
+	maxLeaderboardRadius     uint64 = 25
+)
+
+var errInvalidCountry = errors.New("country must be an ISO 3166-1 alpha-2 code")
+
+// NormalizeCountry validates an ISO 3166-1 alpha-2 country code and returns it in upper case.
+func NormalizeCountry(country string) (string, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 || country[0] < 'A' || country[0] > 'Z' || country[1] < 'A' || country[1] > 'Z' {
+		return "", apperrors.BadRequest(errInvalidCountry)
+	}
+
+	return country, nil
+}
+
+type LeaderboardEntry struct {
+	Rank uint64
+	User *User
+}
+
+type Leaderboard struct {
+	Entries []*LeaderboardEntry
+}
+
+// LeaderboardFilter selects a slice of the ranking by rating, Country limits the ranking to one country.
+type LeaderboardFilter struct {
+	Country *string
+	Offset  uint64
+	Limit   uint64
+}
+
+func NewLeaderboardFilter(size uint64, country *string) (*LeaderboardFilter, error) {
+	filter := LeaderboardFilter{Limit: size}
+
+	switch {
+	case size == 0:
+		filter.Limit = defaultLeaderboardSize
+	case size > maxLeaderboardSize:
+		filter.Limit = maxLeaderboardSize
+	}
+
+	if country != nil {
+		normalized, err := NormalizeCountry(*country)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Country = &normalized
+	}
+
+	return &filter, nil
+}
+
+// NewLeaderboardWindow selects up to radius players above and below the given one-based rank.
+func NewLeaderboardWindow(rank, radius uint64) *LeaderboardFilter {
+	switch {
+	case radius == 0:
+		radius = defaultLeaderboardRadius
+	case radius > maxLeaderboardRadius:
+		radius = maxLeaderboardRadius
+	}
+
+	var offset uint64
+	if rank > radius+1 {
+		offset = rank - radius - 1
+	}
+
+	return &LeaderboardFilter{
+		Offset: offset,
+		Limit:  2*radius + 1,
+	}
+}
+
+var _ abstractions.Responseable[userspb.Leaderboard] = (*Leaderboard)(nil)
+
+func (l *Leaderboard) Response() (*userspb.Leaderboard, error) {
+	var res userspb.Leaderboard
+
+	res.Entries = make([]*userspb.LeaderboardEntry, len(l.Entries))
+	for i, entry := range l.Entries {
+		user, err := entry.User.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Entries[i] = &userspb.LeaderboardEntry{
+			Rank: entry.Rank,
+			User: user,
+		}
+	}
+
+	return &res, nil
+}
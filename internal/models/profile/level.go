@@ -0,0 +1,190 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	DefaultLevelBaseXP = 100
+	DefaultLevelGrowth = 1.2
+	DefaultMaxLevel    = 100
+
+	maxXPAmount        = math.MaxInt32
+	maxXPEventIDLength = 96
+	maxXPSourceLength  = 64
+	maxLevelXP         = 1 << 53
+)
+
+// LevelProgress is the level of a user, LevelXP is earned within the level and
+// NextLevelXP is needed to reach the next one, it is zero at the max level.
+type LevelProgress struct {
+	Level       int32 `json:"level"`
+	XP          int64 `json:"xp"`
+	LevelXP     int64 `json:"level_xp"`
+	NextLevelXP int64 `json:"next_level_xp"`
+}
+
+// LevelCurve defines the XP every level requires: BaseXP for the first level,
+// multiplied by Growth for every level above it.
+type LevelCurve struct {
+	BaseXP   int64
+	Growth   float64
+	MaxLevel int32
+}
+
+// NewLevelCurve returns the curve with defaults applied to zero values.
+func NewLevelCurve(baseXP int64, growth float64, maxLevel int32) (LevelCurve, error) {
+	curve := LevelCurve{
+		BaseXP:   baseXP,
+		Growth:   growth,
+		MaxLevel: maxLevel,
+	}
+
+	if curve.BaseXP == 0 {
+		curve.BaseXP = DefaultLevelBaseXP
+	}
+
+	if curve.Growth == 0 {
+		curve.Growth = DefaultLevelGrowth
+	}
+
+	if curve.MaxLevel == 0 {
+		curve.MaxLevel = DefaultMaxLevel
+	}
+
+	switch {
+	case curve.BaseXP < 0:
+		return LevelCurve{}, errors.New("level base xp must be positive")
+	case curve.Growth < 1:
+		return LevelCurve{}, errors.New("level growth must be at least 1")
+	case curve.MaxLevel < 2:
+		return LevelCurve{}, errors.New("max level must be at least 2")
+	}
+
+	return curve, nil
+}
+
+// Required returns the XP needed to advance from the level to the next one.
+func (c LevelCurve) Required(level int32) int64 {
+	if level >= c.MaxLevel {
+		return 0
+	}
+
+	required := float64(c.BaseXP) * math.Pow(c.Growth, float64(level-1))
+
+	return int64(math.Min(math.Round(required), maxLevelXP))
+}
+
+// Start returns the progress of a new user.
+func (c LevelCurve) Start() LevelProgress {
+	return LevelProgress{
+		Level:       1,
+		NextLevelXP: c.Required(1),
+	}
+}
+
+// Advance returns the progress after the XP is earned, XP past the max level only adds to the total.
+func (c LevelCurve) Advance(p LevelProgress, amount int64) LevelProgress {
+	p.XP += amount
+	p.LevelXP += amount
+
+	for p.Level < c.MaxLevel && p.LevelXP >= c.Required(p.Level) {
+		p.LevelXP -= c.Required(p.Level)
+		p.Level++
+	}
+
+	if p.Level >= c.MaxLevel {
+		p.LevelXP = 0
+	}
+
+	p.NextLevelXP = c.Required(p.Level)
+
+	return p
+}
+
+// GrantXP awards XP for an event of a trusted service, a repeated event id has no effect.
+type GrantXP struct {
+	UserID  uuid.UUID
+	Amount  int64
+	EventID string
+	Source  string
+}
+
+// XPGrant is a recorded grant, Progress is the level of the user after it.
+type XPGrant struct {
+	UserID        uuid.UUID     `json:"user_id"`
+	EventID       string        `json:"event_id"`
+	Amount        int64         `json:"amount"`
+	Source        string        `json:"source"`
+	LevelBefore   int32         `json:"level_before"`
+	LevelAfter    int32         `json:"level_after"`
+	CoinsRewarded int64         `json:"coins_rewarded"`
+	Progress      LevelProgress `json:"progress"`
+	CreatedAt     time.Time     `json:"created_at"`
+	// Replayed is set when the event was granted before and the stored grant is returned.
+	Replayed bool `json:"-"`
+}
+
+// LevelUpKey is the idempotency key of the coins rewarded for the levels gained on the event.
+func LevelUpKey(eventID string) string {
+	return "level-up:" + eventID
+}
+
+var _ abstractions.Requestable[GrantXP, *userspb.GrantXPRequest] = (*GrantXP)(nil)
+
+func (g GrantXP) Request(req *userspb.GrantXPRequest) (*GrantXP, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	g.UserID = id
+	g.EventID = strings.TrimSpace(req.GetEventId())
+	g.Source = strings.TrimSpace(req.GetSource())
+
+	switch {
+	case req.GetAmount() == 0:
+		return nil, apperrors.BadRequest(errors.New("xp amount not provided"))
+	case req.GetAmount() > maxXPAmount:
+		return nil, apperrors.BadRequest(fmt.Errorf("xp amount exceeds %d", maxXPAmount))
+	case g.EventID == "" || len(g.EventID) > maxXPEventIDLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("event id must be 1 to %d characters", maxXPEventIDLength))
+	case g.Source == "" || len(g.Source) > maxXPSourceLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("source must be 1 to %d characters", maxXPSourceLength))
+	}
+
+	g.Amount = int64(req.GetAmount())
+
+	return &g, nil
+}
+
+var _ abstractions.Responseable[userspb.XPGrant] = (*XPGrant)(nil)
+
+func (g *XPGrant) Response() (*userspb.XPGrant, error) {
+	var res userspb.XPGrant
+
+	res.UserId = g.UserID.String()
+	res.EventId = g.EventID
+	res.Amount = g.Amount
+	res.Source = g.Source
+	res.LevelBefore = g.LevelBefore
+	res.LevelAfter = g.LevelAfter
+	res.CoinsRewarded = g.CoinsRewarded
+	res.Xp = g.Progress.XP
+	res.LevelXp = g.Progress.LevelXP
+	res.NextLevelXp = g.Progress.NextLevelXP
+	res.CreatedAt = timestamppb.New(g.CreatedAt)
+
+	return &res, nil
+}
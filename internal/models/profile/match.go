@@ -0,0 +1,235 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	maxMatchModeLength = 32
+	maxMatchCategories = 64
+)
+
+// ErrMatchHistoryHidden is returned when the privacy setting of the user hides the match history from the viewer.
+var ErrMatchHistoryHidden = errors.New("match history is not visible")
+
+// MatchRecordParticipant is the outcome of a match for one of its players.
+type MatchRecordParticipant struct {
+	UserID    uuid.UUID
+	Score     int64
+	Placement int
+}
+
+// MatchRecord is a finished match reported by the game service, recording it again has no effect.
+type MatchRecord struct {
+	MatchID      uuid.UUID
+	Mode         string
+	CategoryIDs  []int32
+	StartedAt    time.Time
+	EndedAt      time.Time
+	Participants []*MatchRecordParticipant
+}
+
+// ListMatchHistory filters the matches of a user by mode and by the end of the match within [From, To).
+type ListMatchHistory struct {
+	UserID uuid.UUID
+	Mode   *string
+	From   *time.Time
+	To     *time.Time
+	Size   uint64
+	Cursor *MatchCursor
+}
+
+// MatchHistoryEntry is a match as played by the user, RatingDelta is nil while the match is not rated.
+type MatchHistoryEntry struct {
+	MatchID     uuid.UUID `json:"match_id"`
+	Mode        string    `json:"mode"`
+	CategoryIDs []int32   `json:"category_ids"`
+	Score       int64     `json:"score"`
+	Placement   int       `json:"placement"`
+	RatingDelta *int32    `json:"rating_delta"`
+	StartedAt   time.Time `json:"started_at"`
+	EndedAt     time.Time `json:"ended_at"`
+}
+
+type MatchHistoryPage struct {
+	Matches    []*MatchHistoryEntry
+	NextCursor *MatchCursor
+}
+
+// CanViewMatchHistory reports whether the viewer may see the match history of a user with the privacy setting.
+func CanViewMatchHistory(privacy Privacy, isOwner, isFriend bool) bool {
+	return privacy.VisibleTo(isOwner, isFriend)
+}
+
+func normalizeMatchMode(mode string) (string, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" || len(mode) > maxMatchModeLength {
+		return "", apperrors.BadRequest(fmt.Errorf("mode must be 1 to %d characters", maxMatchModeLength))
+	}
+
+	return mode, nil
+}
+
+var _ abstractions.Requestable[MatchRecord, *userspb.RecordMatchRequest] = (*MatchRecord)(nil)
+
+func (m MatchRecord) Request(req *userspb.RecordMatchRequest) (*MatchRecord, error) {
+	matchID, err := uuid.Parse(req.GetMatchId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid match id")
+	}
+
+	if m.Mode, err = normalizeMatchMode(req.GetMode()); err != nil {
+		return nil, err
+	}
+
+	participants := req.GetParticipants()
+
+	switch {
+	case req.StartedAt == nil || req.EndedAt == nil:
+		return nil, apperrors.BadRequest(errors.New("match start and end not provided"))
+	case req.GetEndedAt().AsTime().Before(req.GetStartedAt().AsTime()):
+		return nil, apperrors.BadRequest(errors.New("match must end after it started"))
+	case len(req.GetCategoryIds()) > maxMatchCategories:
+		return nil, apperrors.BadRequest(fmt.Errorf("too many categories: maximum is %d", maxMatchCategories))
+	case len(participants) == 0:
+		return nil, apperrors.BadRequest(errors.New("match participants not provided"))
+	case len(participants) > MaxMatchParticipants:
+		return nil, apperrors.BadRequest(fmt.Errorf("too many participants: maximum is %d", MaxMatchParticipants))
+	}
+
+	m.MatchID = matchID
+	m.StartedAt = req.GetStartedAt().AsTime()
+	m.EndedAt = req.GetEndedAt().AsTime()
+
+	m.CategoryIDs = make([]int32, 0, len(req.GetCategoryIds()))
+	for _, id := range req.GetCategoryIds() {
+		if id <= 0 {
+			return nil, apperrors.BadRequest(errors.New("invalid category id"))
+		}
+
+		m.CategoryIDs = append(m.CategoryIDs, id)
+	}
+
+	m.Participants = make([]*MatchRecordParticipant, len(participants))
+	seen := make(map[uuid.UUID]struct{}, len(participants))
+
+	for i, p := range participants {
+		userID, parseErr := uuid.Parse(p.GetUserId())
+		if parseErr != nil {
+			return nil, apperrors.BadRequestHidden(parseErr, "invalid user id")
+		}
+
+		if _, ok := seen[userID]; ok {
+			return nil, apperrors.BadRequest(fmt.Errorf("duplicate participant %s", userID))
+		}
+
+		if p.GetPlacement() == 0 || p.GetPlacement() > uint32(len(participants)) {
+			return nil, apperrors.BadRequest(fmt.Errorf("placement of %s must be between 1 and %d", userID, len(participants)))
+		}
+
+		seen[userID] = struct{}{}
+		m.Participants[i] = &MatchRecordParticipant{
+			UserID:    userID,
+			Score:     p.GetScore(),
+			Placement: int(p.GetPlacement()),
+		}
+	}
+
+	return &m, nil
+}
+
+var _ abstractions.Requestable[ListMatchHistory, *userspb.ListMatchHistoryRequest] = (*ListMatchHistory)(nil)
+
+func (l ListMatchHistory) Request(req *userspb.ListMatchHistoryRequest) (*ListMatchHistory, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	l.UserID = id
+	l.Size = pageSize(req.GetSize())
+
+	if req.Mode != nil {
+		mode, modeErr := normalizeMatchMode(req.GetMode())
+		if modeErr != nil {
+			return nil, modeErr
+		}
+
+		l.Mode = &mode
+	}
+
+	if req.From != nil {
+		from := req.GetFrom().AsTime()
+		l.From = &from
+	}
+
+	if req.To != nil {
+		to := req.GetTo().AsTime()
+		l.To = &to
+	}
+
+	if l.From != nil && l.To != nil && !l.To.After(*l.From) {
+		return nil, apperrors.BadRequest(errors.New("period end must be after its start"))
+	}
+
+	if req.Cursor != nil {
+		cursor, cursorErr := DecodeMatchCursor(req.GetCursor())
+		if cursorErr != nil {
+			return nil, apperrors.BadRequest(cursorErr)
+		}
+
+		l.Cursor = cursor
+	}
+
+	return &l, nil
+}
+
+var _ abstractions.Responseable[userspb.MatchHistoryEntry] = (*MatchHistoryEntry)(nil)
+
+func (e *MatchHistoryEntry) Response() (*userspb.MatchHistoryEntry, error) {
+	var res userspb.MatchHistoryEntry
+
+	res.MatchId = e.MatchID.String()
+	res.Mode = e.Mode
+	res.CategoryIds = e.CategoryIDs
+	res.Score = e.Score
+	res.Placement = uint32(e.Placement)
+	res.RatingDelta = e.RatingDelta
+	res.StartedAt = timestamppb.New(e.StartedAt)
+	res.EndedAt = timestamppb.New(e.EndedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListMatchHistoryResponse] = (*MatchHistoryPage)(nil)
+
+func (p *MatchHistoryPage) Response() (*userspb.ListMatchHistoryResponse, error) {
+	var res userspb.ListMatchHistoryResponse
+
+	res.Matches = make([]*userspb.MatchHistoryEntry, len(p.Matches))
+	for i, match := range p.Matches {
+		m, err := match.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Matches[i] = m
+	}
+
+	if p.NextCursor != nil {
+		cursor := p.NextCursor.Encode()
+		res.NextCursor = &cursor
+	}
+
+	return &res, nil
+}
@@ -8,10 +8,17 @@ import (
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
 )
 
+const (
+	// DefaultDeletionGracePeriod is how long a deleted account can still be restored before it is purged.
+	DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+	DefaultPurgeBatchSize      = 100
+)
+
 type Profile struct {
-	User  *User
-	Email string `json:"email"`
-	Coins int64  `json:"coins"`
+	User     *User  `json:"user"`
+	Email    string `json:"email"`
+	Coins    int64  `json:"coins"`
+	TimeZone string `json:"time_zone"`
 }
 
 type User struct {
@@ -21,20 +28,47 @@ type User struct {
 	Rating      int32      `json:"rating"`
 	CreatedAt   time.Time  `json:"created_at"`
 	LastLoginAt *time.Time `json:"last_login_at"`
+	Privacy     Privacy    `json:"privacy"`
+	Country     *string    `json:"country"`
+	LevelProgress
 }
 
 type UserAdmin struct {
 	Profile   *Profile
 	DeletedAt *time.Time `json:"deleted_at"`
+	BannedAt  *time.Time `json:"banned_at"`
 }
 
+// Friend is a friendship or a friend request, Since is when it was accepted or requested.
 type Friend struct {
-	User   *User  `json:"user"`
-	Status Status `json:"status"`
+	User     *User     `json:"user"`
+	Status   Status    `json:"status"`
+	Since    time.Time `json:"since"`
+	Presence *Presence `json:"-"`
 }
 
 type UpdateProfile struct {
-	Username *string `json:"username"`
+	Username *string  `json:"username"`
+	Privacy  *Privacy `json:"privacy"`
+	// Country is cleared when set to an empty string.
+	Country  *string `json:"country"`
+	TimeZone *string `json:"time_zone"`
+}
+
+type SearchPlayers struct {
+	Query  string
+	Size   uint64
+	Cursor *SearchCursor
+}
+
+type PlayersPage struct {
+	Users      []*User
+	NextCursor *SearchCursor
+}
+
+type UsersBatch struct {
+	Users      []*User
+	MissingIDs []uuid.UUID
 }
 
 type Status string
@@ -75,3 +109,60 @@ func statusFromGRPCEnum(status userspb.Status) Status {
 		return Unknown
 	}
 }
+
+type Privacy string
+
+func (p Privacy) String() string {
+	return string(p)
+}
+
+const (
+	Public  Privacy = "public"
+	Friends Privacy = "friends"
+	Private Privacy = "private"
+)
+
+// Redact hides activity details of the user from viewers the privacy setting does not share them with.
+func (u *User) Redact(viewerID uuid.UUID, isFriend bool) {
+	if u.Privacy.VisibleTo(u.ID == viewerID, isFriend) {
+		return
+	}
+
+	u.LastLoginAt = nil
+}
+
+// VisibleTo reports whether data guarded by the privacy setting may be shown to the viewer.
+func (p Privacy) VisibleTo(isOwner, isFriend bool) bool {
+	switch p {
+	case Public, "":
+		return true
+	case Friends:
+		return isOwner || isFriend
+	default:
+		return isOwner
+	}
+}
+
+func (p Privacy) ToGRPCEnum() userspb.Privacy {
+	switch p {
+	case Public:
+		return userspb.Privacy_PRIVACY_PUBLIC
+	case Friends:
+		return userspb.Privacy_PRIVACY_FRIENDS
+	case Private:
+		return userspb.Privacy_PRIVACY_PRIVATE
+	default:
+		return userspb.Privacy_PRIVACY_UNSPECIFIED
+	}
+}
+
+func privacyFromGRPCEnum(privacy userspb.Privacy) Privacy {
+	switch privacy {
+	case userspb.Privacy_PRIVACY_FRIENDS:
+		return Friends
+	case userspb.Privacy_PRIVACY_PRIVATE:
+		return Private
+	default:
+		return Public
+	}
+}
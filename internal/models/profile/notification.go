@@ -0,0 +1,195 @@
+package profile
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+type NotificationKind string
+
+func (k NotificationKind) String() string {
+	return string(k)
+}
+
+const (
+	NotificationFriendRequestReceived NotificationKind = "friend_request_received"
+	NotificationFriendRequestAccepted NotificationKind = "friend_request_accepted"
+	NotificationFriendRemoved         NotificationKind = "friend_removed"
+	NotificationFriendRequestReminder NotificationKind = "friend_request_reminder"
+	NotificationPartyInvite           NotificationKind = "party_invite"
+	NotificationAchievementAwarded    NotificationKind = "achievement_awarded"
+	NotificationLevelUp               NotificationKind = "level_up"
+)
+
+func (k NotificationKind) ToGRPCEnum() userspb.NotificationKind {
+	switch k {
+	case NotificationFriendRequestReceived:
+		return userspb.NotificationKind_NOTIFICATION_KIND_FRIEND_REQUEST_RECEIVED
+	case NotificationFriendRequestAccepted:
+		return userspb.NotificationKind_NOTIFICATION_KIND_FRIEND_REQUEST_ACCEPTED
+	case NotificationFriendRemoved:
+		return userspb.NotificationKind_NOTIFICATION_KIND_FRIEND_REMOVED
+	case NotificationFriendRequestReminder:
+		return userspb.NotificationKind_NOTIFICATION_KIND_FRIEND_REQUEST_REMINDER
+	case NotificationPartyInvite:
+		return userspb.NotificationKind_NOTIFICATION_KIND_PARTY_INVITE
+	case NotificationAchievementAwarded:
+		return userspb.NotificationKind_NOTIFICATION_KIND_ACHIEVEMENT_AWARDED
+	case NotificationLevelUp:
+		return userspb.NotificationKind_NOTIFICATION_KIND_LEVEL_UP
+	default:
+		return userspb.NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+	}
+}
+
+// Notification tells a user about a social event, it is kept unread until the user marks it as read.
+// ActorID is the user who caused the event, PartyID, AchievementCode and Level are set for their kinds only.
+type Notification struct {
+	ID              uuid.UUID        `json:"id"`
+	UserID          uuid.UUID        `json:"user_id"`
+	Kind            NotificationKind `json:"kind"`
+	ActorID         *uuid.UUID       `json:"actor_id"`
+	PartyID         *uuid.UUID       `json:"party_id"`
+	AchievementCode *string          `json:"achievement_code"`
+	Level           *int32           `json:"level"`
+	CreatedAt       time.Time        `json:"created_at"`
+	ReadAt          *time.Time       `json:"read_at"`
+}
+
+// NewActorNotification is a notification about something the actor did to the user.
+func NewActorNotification(userID uuid.UUID, kind NotificationKind, actorID uuid.UUID) *Notification {
+	return &Notification{UserID: userID, Kind: kind, ActorID: &actorID}
+}
+
+// ListNotifications pages the notifications of a user, newest first.
+type ListNotifications struct {
+	UserID     uuid.UUID
+	Size       uint64
+	UnreadOnly bool
+	Cursor     *NotificationCursor
+}
+
+type NotificationsPage struct {
+	Notifications []*Notification
+	UnreadCount   int64
+	NextCursor    *NotificationCursor
+}
+
+// MarkRead marks the notifications of the user as read, every notification is marked when IDs is empty.
+type MarkRead struct {
+	UserID uuid.UUID
+	IDs    []uuid.UUID
+}
+
+type UnreadCount struct {
+	Count int64
+}
+
+var _ abstractions.Requestable[ListNotifications, *userspb.ListNotificationsRequest] = (*ListNotifications)(nil)
+
+func (l ListNotifications) Request(req *userspb.ListNotificationsRequest) (*ListNotifications, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	l.UserID = id
+	l.Size = pageSize(req.GetSize())
+	l.UnreadOnly = req.GetUnreadOnly()
+
+	if req.Cursor != nil {
+		cursor, cursorErr := DecodeNotificationCursor(req.GetCursor())
+		if cursorErr != nil {
+			return nil, apperrors.BadRequest(cursorErr)
+		}
+
+		l.Cursor = cursor
+	}
+
+	return &l, nil
+}
+
+var _ abstractions.Requestable[MarkRead, *userspb.MarkReadRequest] = (*MarkRead)(nil)
+
+func (m MarkRead) Request(req *userspb.MarkReadRequest) (*MarkRead, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	m.UserID = id
+
+	m.IDs = make([]uuid.UUID, len(req.GetNotificationIds()))
+	for i, raw := range req.GetNotificationIds() {
+		if m.IDs[i], err = uuid.Parse(raw); err != nil {
+			return nil, apperrors.BadRequestHidden(err, "invalid notification id")
+		}
+	}
+
+	return &m, nil
+}
+
+var _ abstractions.Responseable[userspb.Notification] = (*Notification)(nil)
+
+func (n *Notification) Response() (*userspb.Notification, error) {
+	var res userspb.Notification
+
+	res.Id = n.ID.String()
+	res.Kind = n.Kind.ToGRPCEnum()
+	res.AchievementCode = n.AchievementCode
+	res.Level = n.Level
+	res.CreatedAt = timestamppb.New(n.CreatedAt)
+
+	if n.ActorID != nil {
+		actorID := n.ActorID.String()
+		res.ActorId = &actorID
+	}
+
+	if n.PartyID != nil {
+		partyID := n.PartyID.String()
+		res.PartyId = &partyID
+	}
+
+	if n.ReadAt != nil {
+		res.ReadAt = timestamppb.New(*n.ReadAt)
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListNotificationsResponse] = (*NotificationsPage)(nil)
+
+func (p *NotificationsPage) Response() (*userspb.ListNotificationsResponse, error) {
+	var res userspb.ListNotificationsResponse
+
+	res.Notifications = make([]*userspb.Notification, len(p.Notifications))
+	for i, notification := range p.Notifications {
+		n, err := notification.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Notifications[i] = n
+	}
+
+	res.UnreadCount = p.UnreadCount
+
+	if p.NextCursor != nil {
+		cursor := p.NextCursor.Encode()
+		res.NextCursor = &cursor
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.MarkReadResponse] = (*UnreadCount)(nil)
+
+func (u *UnreadCount) Response() (*userspb.MarkReadResponse, error) {
+	return &userspb.MarkReadResponse{UnreadCount: u.Count}, nil
+}
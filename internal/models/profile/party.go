@@ -0,0 +1,137 @@
+package profile
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+var (
+	// ErrNotPartyLeader is returned when a member who is not the leader invites or promotes.
+	ErrNotPartyLeader = errors.New("only the party leader can do this")
+	// ErrNotPartyMember is returned when a user watches a party they are neither a member of nor invited to.
+	ErrNotPartyMember = errors.New("user is not a member of the party")
+	// ErrPartyFull is returned when an invite is accepted by a party that reached its size cap.
+	ErrPartyFull = errors.New("party is full")
+	// ErrNotFriends is returned when the leader invites a user who is not an accepted friend.
+	ErrNotFriends = errors.New("only friends can be invited")
+)
+
+type PartyEvent string
+
+func (e PartyEvent) String() string {
+	return string(e)
+}
+
+const (
+	PartyCreated       PartyEvent = "created"
+	PartyInvited       PartyEvent = "invited"
+	PartyJoined        PartyEvent = "joined"
+	PartyDeclined      PartyEvent = "declined"
+	PartyLeft          PartyEvent = "left"
+	PartyLeaderChanged PartyEvent = "leader_changed"
+	PartyDisbanded     PartyEvent = "disbanded"
+)
+
+func (e PartyEvent) ToGRPCEnum() userspb.PartyEvent {
+	switch e {
+	case PartyCreated:
+		return userspb.PartyEvent_PARTY_EVENT_CREATED
+	case PartyInvited:
+		return userspb.PartyEvent_PARTY_EVENT_INVITED
+	case PartyJoined:
+		return userspb.PartyEvent_PARTY_EVENT_JOINED
+	case PartyDeclined:
+		return userspb.PartyEvent_PARTY_EVENT_DECLINED
+	case PartyLeft:
+		return userspb.PartyEvent_PARTY_EVENT_LEFT
+	case PartyLeaderChanged:
+		return userspb.PartyEvent_PARTY_EVENT_LEADER_CHANGED
+	case PartyDisbanded:
+		return userspb.PartyEvent_PARTY_EVENT_DISBANDED
+	default:
+		return userspb.PartyEvent_PARTY_EVENT_UNSPECIFIED
+	}
+}
+
+// Party is a short-lived group of friends queueing for a match together, members are kept in join order.
+// A party without members is disbanded. Version starts at 1 and grows with every update, so updates
+// delivered out of order can be told apart from newer ones.
+type Party struct {
+	ID         uuid.UUID   `json:"id"`
+	LeaderID   uuid.UUID   `json:"leader_id"`
+	MemberIDs  []uuid.UUID `json:"member_ids"`
+	InvitedIDs []uuid.UUID `json:"invited_ids"`
+	Event      PartyEvent  `json:"event"`
+	Version    int64       `json:"version"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+func (p *Party) IsMember(userID uuid.UUID) bool {
+	return slices.Contains(p.MemberIDs, userID)
+}
+
+func (p *Party) IsInvited(userID uuid.UUID) bool {
+	return slices.Contains(p.InvitedIDs, userID)
+}
+
+// RemoveInvite drops the invite of the user and reports whether there was one.
+func (p *Party) RemoveInvite(userID uuid.UUID) bool {
+	i := slices.Index(p.InvitedIDs, userID)
+	if i < 0 {
+		return false
+	}
+
+	p.InvitedIDs = slices.Delete(p.InvitedIDs, i, i+1)
+
+	return true
+}
+
+// RemoveMember drops the member and hands the leadership to the longest staying member when the leader leaves.
+func (p *Party) RemoveMember(userID uuid.UUID) bool {
+	i := slices.Index(p.MemberIDs, userID)
+	if i < 0 {
+		return false
+	}
+
+	p.MemberIDs = slices.Delete(p.MemberIDs, i, i+1)
+
+	if p.LeaderID == userID && len(p.MemberIDs) > 0 {
+		p.LeaderID = p.MemberIDs[0]
+	}
+
+	return true
+}
+
+func (p *Party) Disbanded() bool {
+	return len(p.MemberIDs) == 0
+}
+
+var _ abstractions.Responseable[userspb.Party] = (*Party)(nil)
+
+func (p *Party) Response() (*userspb.Party, error) {
+	var res userspb.Party
+
+	res.Id = p.ID.String()
+	res.LeaderId = p.LeaderID.String()
+	res.Event = p.Event.ToGRPCEnum()
+	res.UpdatedAt = timestamppb.New(p.UpdatedAt)
+
+	res.MemberIds = make([]string, len(p.MemberIDs))
+	for i, id := range p.MemberIDs {
+		res.MemberIds[i] = id.String()
+	}
+
+	res.InvitedIds = make([]string, len(p.InvitedIDs))
+	for i, id := range p.InvitedIDs {
+		res.InvitedIds[i] = id.String()
+	}
+
+	return &res, nil
+}
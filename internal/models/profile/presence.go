@@ -0,0 +1,89 @@
+package profile
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PresenceStatus string
+
+func (s PresenceStatus) String() string {
+	return string(s)
+}
+
+const (
+	PresenceUnknown PresenceStatus = "unknown"
+	Offline         PresenceStatus = "offline"
+	Online          PresenceStatus = "online"
+	InLobby         PresenceStatus = "in_lobby"
+	InMatch         PresenceStatus = "in_match"
+	Away            PresenceStatus = "away"
+)
+
+func (s PresenceStatus) ToGRPCEnum() userspb.PresenceStatus {
+	switch s {
+	case Offline:
+		return userspb.PresenceStatus_PRESENCE_STATUS_OFFLINE
+	case Online:
+		return userspb.PresenceStatus_PRESENCE_STATUS_ONLINE
+	case InLobby:
+		return userspb.PresenceStatus_PRESENCE_STATUS_IN_LOBBY
+	case InMatch:
+		return userspb.PresenceStatus_PRESENCE_STATUS_IN_MATCH
+	case Away:
+		return userspb.PresenceStatus_PRESENCE_STATUS_AWAY
+	default:
+		return userspb.PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+	}
+}
+
+func PresenceStatusFromGRPCEnum(status userspb.PresenceStatus) PresenceStatus {
+	switch status {
+	case userspb.PresenceStatus_PRESENCE_STATUS_OFFLINE:
+		return Offline
+	case userspb.PresenceStatus_PRESENCE_STATUS_ONLINE:
+		return Online
+	case userspb.PresenceStatus_PRESENCE_STATUS_IN_LOBBY:
+		return InLobby
+	case userspb.PresenceStatus_PRESENCE_STATUS_IN_MATCH:
+		return InMatch
+	case userspb.PresenceStatus_PRESENCE_STATUS_AWAY:
+		return Away
+	default:
+		return PresenceUnknown
+	}
+}
+
+type Presence struct {
+	UserID    uuid.UUID      `json:"user_id"`
+	Status    PresenceStatus `json:"status"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// OfflinePresence is reported for users without a live presence entry.
+func OfflinePresence(userID uuid.UUID) *Presence {
+	return &Presence{
+		UserID: userID,
+		Status: Offline,
+	}
+}
+
+var _ abstractions.Responseable[userspb.Presence] = (*Presence)(nil)
+
+func (p *Presence) Response() (*userspb.Presence, error) {
+	var res userspb.Presence
+
+	res.UserId = p.UserID.String()
+	res.Status = p.Status.ToGRPCEnum()
+
+	if !p.UpdatedAt.IsZero() {
+		res.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,116 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const MaxMatchParticipants = 100
+
+type MatchParticipant struct {
+	UserID    uuid.UUID
+	Placement int
+}
+
+type MatchResult struct {
+	MatchID      uuid.UUID
+	Participants []*MatchParticipant
+}
+
+// RatingState is the stored rating of a user.
+type RatingState struct {
+	UserID     uuid.UUID
+	Rating     int32
+	Deviation  float64
+	Volatility float64
+}
+
+// RatingChange is the rating of a match participant before and after the match.
+type RatingChange struct {
+	UserID          uuid.UUID
+	Placement       int
+	RatingBefore    int32
+	RatingAfter     int32
+	DeviationBefore float64
+	DeviationAfter  float64
+	Volatility      float64
+}
+
+// RateFunc computes rating changes of the participants from their locked rating states,
+// states are passed in the order of participants.
+type RateFunc func(participants []*MatchParticipant, states []*RatingState) []*RatingChange
+
+type MatchRatings struct {
+	MatchID uuid.UUID
+	Changes []*RatingChange
+}
+
+var _ abstractions.Requestable[MatchResult, *userspb.ReportMatchResultRequest] = (*MatchResult)(nil)
+
+func (m MatchResult) Request(req *userspb.ReportMatchResultRequest) (*MatchResult, error) {
+	matchID, err := uuid.Parse(req.GetMatchId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid match id")
+	}
+
+	participants := req.GetParticipants()
+
+	switch {
+	case len(participants) < 2:
+		return nil, apperrors.BadRequest(errors.New("match requires at least two participants"))
+	case len(participants) > MaxMatchParticipants:
+		return nil, apperrors.BadRequest(fmt.Errorf("too many participants: maximum is %d", MaxMatchParticipants))
+	}
+
+	m.MatchID = matchID
+	m.Participants = make([]*MatchParticipant, len(participants))
+	seen := make(map[uuid.UUID]struct{}, len(participants))
+
+	for i, p := range participants {
+		userID, parseErr := uuid.Parse(p.GetUserId())
+		if parseErr != nil {
+			return nil, apperrors.BadRequestHidden(parseErr, "invalid user id")
+		}
+
+		if _, ok := seen[userID]; ok {
+			return nil, apperrors.BadRequest(fmt.Errorf("duplicate participant %s", userID))
+		}
+
+		if p.GetPlacement() == 0 || p.GetPlacement() > uint32(len(participants)) {
+			return nil, apperrors.BadRequest(fmt.Errorf("placement of %s must be between 1 and %d", userID, len(participants)))
+		}
+
+		seen[userID] = struct{}{}
+		m.Participants[i] = &MatchParticipant{UserID: userID, Placement: int(p.GetPlacement())}
+	}
+
+	return &m, nil
+}
+
+var _ abstractions.Responseable[userspb.ReportMatchResultResponse] = (*MatchRatings)(nil)
+
+func (m *MatchRatings) Response() (*userspb.ReportMatchResultResponse, error) {
+	var res userspb.ReportMatchResultResponse
+
+	res.MatchId = m.MatchID.String()
+	res.Changes = make([]*userspb.RatingChange, len(m.Changes))
+
+	for i, c := range m.Changes {
+		res.Changes[i] = &userspb.RatingChange{
+			UserId:          c.UserID.String(),
+			Placement:       uint32(c.Placement),
+			RatingBefore:    c.RatingBefore,
+			RatingAfter:     c.RatingAfter,
+			DeviationBefore: c.DeviationBefore,
+			DeviationAfter:  c.DeviationAfter,
+		}
+	}
+
+	return &res, nil
+}
@@ -2,6 +2,7 @@ package profile
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -29,6 +30,8 @@ func (u *User) Request(req *userspb.User) (*User, error) {
 		u.LastLoginAt = &time
 	}
 
+	u.Country = req.Country
+
 	return u, nil
 }
 
@@ -53,6 +56,8 @@ func (p *Profile) Request(req *userspb.Profile) (*Profile, error) {
 		p.User.LastLoginAt = &time
 	}
 
+	p.User.Privacy = privacyFromGRPCEnum(req.GetPrivacy())
+	p.User.Country = req.Country
 	p.Email = req.GetEmail()
 	p.Coins = req.GetCoins()
 
@@ -86,6 +91,11 @@ func (u *UserAdmin) Request(req *userspb.UserAdmin) (*UserAdmin, error) {
 		u.DeletedAt = &time
 	}
 
+	if req.BannedAt != nil {
+		time := req.BannedAt.AsTime()
+		u.BannedAt = &time
+	}
+
 	return u, nil
 }
 
@@ -112,6 +122,10 @@ func (f *Friend) Request(req *userspb.Friend) (*Friend, error) {
 
 	f.Status = statusFromGRPCEnum(req.GetStatus())
 
+	if req.GetSince() != nil {
+		f.Since = req.GetSince().AsTime()
+	}
+
 	return f, nil
 }
 
@@ -125,9 +139,77 @@ func (u UpdateProfile) Request(req *userspb.UpdateProfileRequest) (*UpdateProfil
 		flag = true
 	}
 
+	if req.Privacy != nil {
+		privacy := privacyFromGRPCEnum(req.GetPrivacy())
+		u.Privacy = &privacy
+		flag = true
+	}
+
+	if req.Country != nil {
+		country := ""
+		if req.GetCountry() != "" {
+			var err error
+			if country, err = NormalizeCountry(req.GetCountry()); err != nil {
+				return nil, err
+			}
+		}
+
+		u.Country = &country
+		flag = true
+	}
+
+	if req.TimeZone != nil {
+		timeZone, err := NormalizeTimeZone(req.GetTimeZone())
+		if err != nil {
+			return nil, err
+		}
+
+		u.TimeZone = &timeZone
+		flag = true
+	}
+
 	if !flag {
 		return nil, apperrors.BadRequest(errors.New("data to change not provided"))
 	}
 
 	return &u, nil
 }
+
+const (
+	defaultPageSize uint64 = 20
+	maxPageSize     uint64 = 50
+)
+
+// pageSize applies the default and the upper bound to a requested page size.
+func pageSize(size uint64) uint64 {
+	switch {
+	case size == 0:
+		return defaultPageSize
+	case size > maxPageSize:
+		return maxPageSize
+	default:
+		return size
+	}
+}
+
+var _ abstractions.Requestable[SearchPlayers, *userspb.SearchPlayersRequest] = (*SearchPlayers)(nil)
+
+func (s SearchPlayers) Request(req *userspb.SearchPlayersRequest) (*SearchPlayers, error) {
+	s.Query = strings.TrimSpace(req.GetQuery())
+	if s.Query == "" {
+		return nil, apperrors.BadRequest(errors.New("search query not provided"))
+	}
+
+	s.Size = pageSize(req.GetSize())
+
+	if req.Cursor != nil {
+		cursor, err := DecodeSearchCursor(req.GetCursor())
+		if err != nil {
+			return nil, apperrors.BadRequest(err)
+		}
+
+		s.Cursor = cursor
+	}
+
+	return &s, nil
+}
@@ -21,6 +21,12 @@ func (u *User) Response() (*userspb.User, error) {
 		res.LastLoginAt = timestamppb.New(*u.LastLoginAt)
 	}
 
+	res.Country = u.Country
+	res.Level = u.Level
+	res.Xp = u.XP
+	res.LevelXp = u.LevelXP
+	res.NextLevelXp = u.NextLevelXP
+
 	return &res, nil
 }
 
@@ -41,6 +47,13 @@ func (p *Profile) Response() (*userspb.Profile, error) {
 
 	res.Email = p.Email
 	res.Coins = p.Coins
+	res.Privacy = p.User.Privacy.ToGRPCEnum()
+	res.Country = p.User.Country
+	res.TimeZone = p.TimeZone
+	res.Level = p.User.Level
+	res.Xp = p.User.XP
+	res.LevelXp = p.User.LevelXP
+	res.NextLevelXp = p.User.NextLevelXP
 
 	return &res, nil
 }
@@ -67,6 +80,10 @@ func (u *UserAdmin) Response() (*userspb.UserAdmin, error) {
 		res.DeletedAt = timestamppb.New(*u.DeletedAt)
 	}
 
+	if u.BannedAt != nil {
+		res.BannedAt = timestamppb.New(*u.BannedAt)
+	}
+
 	return &res, nil
 }
 
@@ -83,5 +100,62 @@ func (f *Friend) Response() (*userspb.Friend, error) {
 	res.User = user
 	res.Status = f.Status.ToGRPCEnum()
 
+	if !f.Since.IsZero() {
+		res.Since = timestamppb.New(f.Since)
+	}
+
+	if f.Presence != nil {
+		res.Presence, err = f.Presence.Response()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.GetUsersByIDsResponse] = (*UsersBatch)(nil)
+
+func (b *UsersBatch) Response() (*userspb.GetUsersByIDsResponse, error) {
+	var res userspb.GetUsersByIDsResponse
+
+	res.Users = make([]*userspb.User, len(b.Users))
+	for i, user := range b.Users {
+		u, err := user.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Users[i] = u
+	}
+
+	res.MissingIds = make([]string, len(b.MissingIDs))
+	for i, id := range b.MissingIDs {
+		res.MissingIds[i] = id.String()
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.SearchPlayersResponse] = (*PlayersPage)(nil)
+
+func (p *PlayersPage) Response() (*userspb.SearchPlayersResponse, error) {
+	var res userspb.SearchPlayersResponse
+
+	res.Users = make([]*userspb.User, len(p.Users))
+	for i, user := range p.Users {
+		u, err := user.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Users[i] = u
+	}
+
+	if p.NextCursor != nil {
+		cursor := p.NextCursor.Encode()
+		res.NextCursor = &cursor
+	}
+
 	return &res, nil
 }
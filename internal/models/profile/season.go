@@ -0,0 +1,198 @@
+package profile
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const maxSeasonNameLength = 64
+
+// ErrSeasonHistoryHidden is returned when the privacy setting of the user hides the season history from the viewer.
+var ErrSeasonHistoryHidden = errors.New("season history is not visible")
+
+// DefaultSeasonRolloverInterval is how often ended seasons are looked for when no interval is configured.
+const DefaultSeasonRolloverInterval = time.Minute
+
+// Season is a competitive period, when it ends every rating is archived and soft reset
+// to ResetBase + (rating - ResetBase) * ResetFactor.
+type Season struct {
+	ID          int32      `json:"id"`
+	Name        string     `json:"name"`
+	StartsAt    time.Time  `json:"starts_at"`
+	EndsAt      time.Time  `json:"ends_at"`
+	ResetBase   int32      `json:"reset_base"`
+	ResetFactor float64    `json:"reset_factor"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+}
+
+// UpdateSeason changes the definition of a season that is not archived yet, nil fields are kept.
+type UpdateSeason struct {
+	ID          int32
+	Name        *string
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	ResetBase   *int32
+	ResetFactor *float64
+}
+
+type Seasons struct {
+	Seasons []*Season
+}
+
+// SeasonResult is the archived placement of a user in a past season.
+type SeasonResult struct {
+	Season      *Season
+	Rating      int32
+	Rank        uint64
+	ResetRating int32
+}
+
+type SeasonHistory struct {
+	Results []*SeasonResult
+}
+
+// Validate checks the definition of the season.
+func (s *Season) Validate() error {
+	switch {
+	case s.Name == "":
+		return apperrors.BadRequest(errors.New("season name not provided"))
+	case len(s.Name) > maxSeasonNameLength:
+		return apperrors.BadRequest(errors.New("season name is too long"))
+	case !s.EndsAt.After(s.StartsAt):
+		return apperrors.BadRequest(errors.New("season must end after it starts"))
+	case s.ResetBase < math.MinInt16 || s.ResetBase > math.MaxInt16:
+		return apperrors.BadRequest(errors.New("season reset base is out of range"))
+	case s.ResetFactor < 0 || s.ResetFactor > 1:
+		return apperrors.BadRequest(errors.New("season reset factor must be between 0 and 1"))
+	}
+
+	return nil
+}
+
+// Apply returns the season with the changes applied.
+func (u *UpdateSeason) Apply(season Season) *Season {
+	if u.Name != nil {
+		season.Name = strings.TrimSpace(*u.Name)
+	}
+
+	if u.StartsAt != nil {
+		season.StartsAt = *u.StartsAt
+	}
+
+	if u.EndsAt != nil {
+		season.EndsAt = *u.EndsAt
+	}
+
+	if u.ResetBase != nil {
+		season.ResetBase = *u.ResetBase
+	}
+
+	if u.ResetFactor != nil {
+		season.ResetFactor = *u.ResetFactor
+	}
+
+	return &season
+}
+
+var _ abstractions.Requestable[Season, *userspb.CreateSeasonRequest] = (*Season)(nil)
+
+func (s Season) Request(req *userspb.CreateSeasonRequest) (*Season, error) {
+	s.Name = strings.TrimSpace(req.GetName())
+	s.StartsAt = req.GetStartsAt().AsTime().UTC()
+	s.EndsAt = req.GetEndsAt().AsTime().UTC()
+	s.ResetBase = req.GetResetBase()
+	s.ResetFactor = req.GetResetFactor()
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+var _ abstractions.Requestable[UpdateSeason, *userspb.UpdateSeasonRequest] = (*UpdateSeason)(nil)
+
+func (u UpdateSeason) Request(req *userspb.UpdateSeasonRequest) (*UpdateSeason, error) {
+	u.ID = req.GetSeasonId()
+	u.Name = req.Name
+	u.ResetBase = req.ResetBase
+	u.ResetFactor = req.ResetFactor
+
+	if req.StartsAt != nil {
+		startsAt := req.GetStartsAt().AsTime().UTC()
+		u.StartsAt = &startsAt
+	}
+
+	if req.EndsAt != nil {
+		endsAt := req.GetEndsAt().AsTime().UTC()
+		u.EndsAt = &endsAt
+	}
+
+	return &u, nil
+}
+
+var _ abstractions.Responseable[userspb.Season] = (*Season)(nil)
+
+func (s *Season) Response() (*userspb.Season, error) {
+	var res userspb.Season
+
+	res.Id = s.ID
+	res.Name = s.Name
+	res.StartsAt = timestamppb.New(s.StartsAt)
+	res.EndsAt = timestamppb.New(s.EndsAt)
+	res.ResetBase = s.ResetBase
+	res.ResetFactor = s.ResetFactor
+
+	if s.ArchivedAt != nil {
+		res.ArchivedAt = timestamppb.New(*s.ArchivedAt)
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListSeasonsResponse] = (*Seasons)(nil)
+
+func (s *Seasons) Response() (*userspb.ListSeasonsResponse, error) {
+	var res userspb.ListSeasonsResponse
+
+	res.Seasons = make([]*userspb.Season, len(s.Seasons))
+	for i, season := range s.Seasons {
+		var err error
+		if res.Seasons[i], err = season.Response(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.GetSeasonHistoryResponse] = (*SeasonHistory)(nil)
+
+func (h *SeasonHistory) Response() (*userspb.GetSeasonHistoryResponse, error) {
+	var res userspb.GetSeasonHistoryResponse
+
+	res.Results = make([]*userspb.SeasonResult, len(h.Results))
+	for i, r := range h.Results {
+		season, err := r.Season.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Results[i] = &userspb.SeasonResult{
+			Season:      season,
+			Rating:      r.Rating,
+			Rank:        r.Rank,
+			ResetRating: r.ResetRating,
+		}
+	}
+
+	return &res, nil
+}
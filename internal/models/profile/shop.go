@@ -0,0 +1,264 @@
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	maxShopItemCodeLength = 64
+	purchaseKeyPrefix     = "purchase:"
+	maxPurchaseKeyLength  = maxIdempotencyKeyLength - len(purchaseKeyPrefix)
+)
+
+// ErrAvatarNotOwned is returned when a user sets a shop avatar they have not bought.
+var ErrAvatarNotOwned = errors.New("avatar is not owned")
+
+type ShopItemKind string
+
+const (
+	ItemAvatar       ShopItemKind = "avatar"
+	ItemAvatarFrame  ShopItemKind = "avatar_frame"
+	ItemTitle        ShopItemKind = "title"
+	ItemStreakFreeze ShopItemKind = "streak_freeze"
+)
+
+func (k ShopItemKind) String() string {
+	return string(k)
+}
+
+func (k ShopItemKind) Valid() bool {
+	switch k {
+	case ItemAvatar, ItemAvatarFrame, ItemTitle, ItemStreakFreeze:
+		return true
+	default:
+		return false
+	}
+}
+
+// Consumable reports whether the item is used up instead of kept in the inventory,
+// a bought streak freeze is added to the daily reward streak of the user.
+func (k ShopItemKind) Consumable() bool {
+	return k == ItemStreakFreeze
+}
+
+func (k ShopItemKind) ToGRPCEnum() userspb.ShopItemKind {
+	switch k {
+	case ItemAvatar:
+		return userspb.ShopItemKind_SHOP_ITEM_KIND_AVATAR
+	case ItemAvatarFrame:
+		return userspb.ShopItemKind_SHOP_ITEM_KIND_AVATAR_FRAME
+	case ItemTitle:
+		return userspb.ShopItemKind_SHOP_ITEM_KIND_TITLE
+	case ItemStreakFreeze:
+		return userspb.ShopItemKind_SHOP_ITEM_KIND_STREAK_FREEZE
+	default:
+		return userspb.ShopItemKind_SHOP_ITEM_KIND_UNSPECIFIED
+	}
+}
+
+// ShopItem is an item of the coin shop, AvatarID is set for avatars only.
+type ShopItem struct {
+	Code     string       `json:"code"`
+	Kind     ShopItemKind `json:"kind"`
+	Title    string       `json:"title"`
+	Price    int64        `json:"price"`
+	AvatarID *int32       `json:"avatar_id"`
+}
+
+// ShopCatalogue is the validated item catalogue in the configured order.
+type ShopCatalogue struct {
+	Items    []*ShopItem
+	byCode   map[string]*ShopItem
+	byAvatar map[int32]*ShopItem
+}
+
+func NewShopCatalogue(items []*ShopItem) (*ShopCatalogue, error) {
+	catalogue := &ShopCatalogue{
+		Items:    items,
+		byCode:   make(map[string]*ShopItem, len(items)),
+		byAvatar: make(map[int32]*ShopItem),
+	}
+
+	for _, item := range items {
+		switch {
+		case item.Code == "" || len(item.Code) > maxShopItemCodeLength:
+			return nil, fmt.Errorf("shop item code must be 1 to %d characters", maxShopItemCodeLength)
+		case !item.Kind.Valid():
+			return nil, fmt.Errorf("shop item %q has unknown kind %q", item.Code, item.Kind)
+		case item.Title == "":
+			return nil, fmt.Errorf("shop item %q has no title", item.Code)
+		case item.Price < 0:
+			return nil, fmt.Errorf("shop item %q has a negative price", item.Code)
+		case (item.Kind == ItemAvatar) != (item.AvatarID != nil):
+			return nil, fmt.Errorf("shop item %q must set an avatar id only for avatars", item.Code)
+		}
+
+		if _, ok := catalogue.byCode[item.Code]; ok {
+			return nil, fmt.Errorf("shop item %q is defined twice", item.Code)
+		}
+
+		catalogue.byCode[item.Code] = item
+
+		if item.AvatarID != nil {
+			if _, ok := catalogue.byAvatar[*item.AvatarID]; ok {
+				return nil, fmt.Errorf("avatar %d is sold twice", *item.AvatarID)
+			}
+
+			catalogue.byAvatar[*item.AvatarID] = item
+		}
+	}
+
+	return catalogue, nil
+}
+
+func (c *ShopCatalogue) Item(code string) (*ShopItem, bool) {
+	item, ok := c.byCode[code]
+	return item, ok
+}
+
+// Avatar returns the item selling the avatar, avatars that are not sold are free.
+func (c *ShopCatalogue) Avatar(avatarID int32) (*ShopItem, bool) {
+	item, ok := c.byAvatar[avatarID]
+	return item, ok
+}
+
+// PurchaseItem buys an item for coins, repeating a purchase with the same idempotency key has no effect.
+type PurchaseItem struct {
+	UserID         uuid.UUID
+	ItemCode       string
+	IdempotencyKey string
+}
+
+// PurchaseKey is the idempotency key of the coins debited for the purchase.
+func PurchaseKey(key string) string {
+	return purchaseKeyPrefix + key
+}
+
+type Purchase struct {
+	UserID       uuid.UUID
+	Item         *ShopItem
+	Price        int64
+	BalanceAfter int64
+	PurchasedAt  time.Time
+}
+
+// InventoryItem is an item owned by a user, Item is nil when it was removed from the catalogue.
+type InventoryItem struct {
+	ItemCode   string       `json:"item_code"`
+	Kind       ShopItemKind `json:"kind"`
+	AcquiredAt time.Time    `json:"acquired_at"`
+	Item       *ShopItem    `json:"-"`
+}
+
+type Inventory struct {
+	Items []*InventoryItem
+}
+
+var _ abstractions.Requestable[PurchaseItem, *userspb.PurchaseItemRequest] = (*PurchaseItem)(nil)
+
+func (p PurchaseItem) Request(req *userspb.PurchaseItemRequest) (*PurchaseItem, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	p.UserID = id
+	p.ItemCode = strings.TrimSpace(req.GetItemCode())
+	p.IdempotencyKey = strings.TrimSpace(req.GetIdempotencyKey())
+
+	switch {
+	case p.ItemCode == "":
+		return nil, apperrors.BadRequest(errors.New("item code not provided"))
+	case p.IdempotencyKey == "" || len(p.IdempotencyKey) > maxPurchaseKeyLength:
+		return nil, apperrors.BadRequest(fmt.Errorf("idempotency key must be 1 to %d characters", maxPurchaseKeyLength))
+	}
+
+	return &p, nil
+}
+
+var _ abstractions.Responseable[userspb.ShopItem] = (*ShopItem)(nil)
+
+func (i *ShopItem) Response() (*userspb.ShopItem, error) {
+	var res userspb.ShopItem
+
+	res.Code = i.Code
+	res.Kind = i.Kind.ToGRPCEnum()
+	res.Title = i.Title
+	res.Price = i.Price
+	res.AvatarId = i.AvatarID
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListShopItemsResponse] = (*ShopCatalogue)(nil)
+
+func (c *ShopCatalogue) Response() (*userspb.ListShopItemsResponse, error) {
+	var res userspb.ListShopItemsResponse
+
+	res.Items = make([]*userspb.ShopItem, len(c.Items))
+	for i, item := range c.Items {
+		it, err := item.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Items[i] = it
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.Purchase] = (*Purchase)(nil)
+
+func (p *Purchase) Response() (*userspb.Purchase, error) {
+	var res userspb.Purchase
+
+	item, err := p.Item.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	res.UserId = p.UserID.String()
+	res.Item = item
+	res.Price = p.Price
+	res.BalanceAfter = p.BalanceAfter
+	res.PurchasedAt = timestamppb.New(p.PurchasedAt)
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListInventoryResponse] = (*Inventory)(nil)
+
+func (i *Inventory) Response() (*userspb.ListInventoryResponse, error) {
+	var res userspb.ListInventoryResponse
+
+	res.Items = make([]*userspb.InventoryItem, len(i.Items))
+	for j, owned := range i.Items {
+		item := owned.Item
+		if item == nil {
+			item = &ShopItem{Code: owned.ItemCode, Kind: owned.Kind}
+		}
+
+		it, err := item.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Items[j] = &userspb.InventoryItem{
+			Item:       it,
+			AcquiredAt: timestamppb.New(owned.AcquiredAt),
+		}
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,161 @@
+package profile
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	defaultSuggestionRatingRange = 100
+	defaultSuggestionMatchWindow = time.Hour * 24 * 30
+	// suggestionPoolSize caps the users drawn in for a similar rating and for the same country each,
+	// so suggestions never rank the whole user table.
+	suggestionPoolSize = 500
+)
+
+type SuggestionReason string
+
+const (
+	ReasonMutualFriends  SuggestionReason = "mutual_friends"
+	ReasonPlayedTogether SuggestionReason = "played_together"
+	ReasonSimilarRating  SuggestionReason = "similar_rating"
+	ReasonSameCountry    SuggestionReason = "same_country"
+)
+
+func (r SuggestionReason) ToGRPCEnum() userspb.SuggestionReason {
+	switch r {
+	case ReasonMutualFriends:
+		return userspb.SuggestionReason_SUGGESTION_REASON_MUTUAL_FRIENDS
+	case ReasonPlayedTogether:
+		return userspb.SuggestionReason_SUGGESTION_REASON_PLAYED_TOGETHER
+	case ReasonSimilarRating:
+		return userspb.SuggestionReason_SUGGESTION_REASON_SIMILAR_RATING
+	case ReasonSameCountry:
+		return userspb.SuggestionReason_SUGGESTION_REASON_SAME_COUNTRY
+	default:
+		return userspb.SuggestionReason_SUGGESTION_REASON_UNSPECIFIED
+	}
+}
+
+// SuggestFriends asks for the best candidates to befriend. Ratings within RatingRange of the user
+// are similar and only matches that ended after PlayedSince are counted. Candidates are friends of friends,
+// recent opponents and at most PoolSize users each with the closest ratings and from the same country.
+type SuggestFriends struct {
+	UserID      uuid.UUID
+	Size        uint64
+	RatingRange int32
+	PlayedSince time.Time
+	PoolSize    uint64
+}
+
+// WithDefaults fills the rating range and the match window that were not configured.
+func (s *SuggestFriends) WithDefaults(ratingRange int32, matchWindow time.Duration, now time.Time) {
+	if ratingRange <= 0 {
+		ratingRange = defaultSuggestionRatingRange
+	}
+
+	if matchWindow <= 0 {
+		matchWindow = defaultSuggestionMatchWindow
+	}
+
+	s.RatingRange = ratingRange
+	s.PlayedSince = now.Add(-matchWindow)
+	s.PoolSize = suggestionPoolSize
+}
+
+// FriendSuggestion is a candidate that is neither a friend, nor a pending request, nor blocked in either direction.
+type FriendSuggestion struct {
+	User            *User `json:"user"`
+	MutualFriends   int64 `json:"mutual_friends"`
+	MatchesTogether int64 `json:"matches_together"`
+	SimilarRating   bool  `json:"similar_rating"`
+	SameCountry     bool  `json:"same_country"`
+}
+
+// Reasons lists why the candidate is suggested, strongest first.
+func (f *FriendSuggestion) Reasons() []SuggestionReason {
+	reasons := make([]SuggestionReason, 0, 4)
+
+	if f.MutualFriends > 0 {
+		reasons = append(reasons, ReasonMutualFriends)
+	}
+
+	if f.MatchesTogether > 0 {
+		reasons = append(reasons, ReasonPlayedTogether)
+	}
+
+	if f.SimilarRating {
+		reasons = append(reasons, ReasonSimilarRating)
+	}
+
+	if f.SameCountry {
+		reasons = append(reasons, ReasonSameCountry)
+	}
+
+	return reasons
+}
+
+type FriendSuggestions struct {
+	Suggestions []*FriendSuggestion
+}
+
+var _ abstractions.Requestable[SuggestFriends, *userspb.SuggestFriendsRequest] = (*SuggestFriends)(nil)
+
+func (s SuggestFriends) Request(req *userspb.SuggestFriendsRequest) (*SuggestFriends, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	s.UserID = id
+	s.Size = pageSize(req.GetSize())
+
+	return &s, nil
+}
+
+var _ abstractions.Responseable[userspb.FriendSuggestion] = (*FriendSuggestion)(nil)
+
+func (f *FriendSuggestion) Response() (*userspb.FriendSuggestion, error) {
+	var res userspb.FriendSuggestion
+
+	user, err := f.User.Response()
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := f.Reasons()
+
+	res.User = user
+	res.Reasons = make([]userspb.SuggestionReason, len(reasons))
+	res.MutualFriends = uint64(f.MutualFriends)
+	res.MatchesTogether = uint64(f.MatchesTogether)
+
+	for i, reason := range reasons {
+		res.Reasons[i] = reason.ToGRPCEnum()
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.SuggestFriendsResponse] = (*FriendSuggestions)(nil)
+
+func (s *FriendSuggestions) Response() (*userspb.SuggestFriendsResponse, error) {
+	var res userspb.SuggestFriendsResponse
+
+	res.Suggestions = make([]*userspb.FriendSuggestion, len(s.Suggestions))
+	for i, suggestion := range s.Suggestions {
+		sug, err := suggestion.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Suggestions[i] = sug
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,237 @@
+package report
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+const (
+	DefaultWindow = 24 * time.Hour
+
+	maxTextLength = 1000
+	// usernameResetPrefix starts the generated username of a user whose username was reset by a moderator.
+	usernameResetPrefix = "player_"
+)
+
+var (
+	// ErrSelfReport is returned when a user reports themselves.
+	ErrSelfReport = errors.New("cannot report yourself")
+	// ErrInvalidCategory is returned when the report has no category.
+	ErrInvalidCategory = errors.New("report category is required")
+	// ErrTextTooLong is returned when the report text is longer than 1000 characters.
+	ErrTextTooLong = errors.New("report text must be at most 1000 characters long")
+	// ErrMatchNotShared is returned when the reported match was not played by both users.
+	ErrMatchNotShared = errors.New("match was not played by both users")
+	// ErrReportLimitReached is returned when the reporter filed too many reports within the rate limit window.
+	ErrReportLimitReached = errors.New("report limit reached, try again later")
+	// ErrReportClaimed is returned when a moderator acts on a report claimed by another moderator.
+	ErrReportClaimed = errors.New("report is claimed by another moderator")
+	// ErrReportClosed is returned when a moderator acts on a resolved or dismissed report.
+	ErrReportClosed = errors.New("report is already closed")
+	// ErrInvalidAction is returned when a report is resolved without an action.
+	ErrInvalidAction = errors.New("report action is required")
+)
+
+// Limits caps the reports a reporter files within Window, zero MaxPerWindow is unlimited.
+type Limits struct {
+	MaxPerWindow int64
+	Window       time.Duration
+}
+
+// Report is a complaint of a player about another player, it waits in the moderation queue
+// until a moderator resolves or dismisses it.
+type Report struct {
+	ID          uuid.UUID  `json:"id"`
+	ReporterID  uuid.UUID  `json:"reporter_id"`
+	ReportedID  uuid.UUID  `json:"reported_id"`
+	Category    Category   `json:"category"`
+	Text        string     `json:"text"`
+	MatchID     *uuid.UUID `json:"match_id"`
+	Status      Status     `json:"status"`
+	ModeratorID *uuid.UUID `json:"moderator_id"`
+	Action      *Action    `json:"action"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ClaimedAt   *time.Time `json:"claimed_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+}
+
+// CheckModerator returns an error when the moderator may not claim or close the report.
+func (r *Report) CheckModerator(moderatorID uuid.UUID) error {
+	switch {
+	case r.Status.Closed():
+		return ErrReportClosed
+	case r.Status == StatusClaimed && r.ModeratorID != nil && *r.ModeratorID != moderatorID:
+		return ErrReportClaimed
+	default:
+		return nil
+	}
+}
+
+// Filter selects the reports of the moderation queue, nil fields match every report.
+type Filter struct {
+	Offset     uint64
+	Limit      uint64
+	Status     *Status
+	Category   *Category
+	ReportedID *uuid.UUID
+}
+
+type ReportsPage struct {
+	Reports []*Report
+	Page    uint64
+	Size    uint64
+	Amount  int64
+}
+
+type Category string
+
+func (c Category) String() string {
+	return string(c)
+}
+
+const (
+	CategoryCheating        Category = "cheating"
+	CategoryAbusiveUsername Category = "abusive_username"
+	CategoryHarassment      Category = "harassment"
+	CategoryOther           Category = "other"
+)
+
+func (c Category) ToGRPCEnum() userspb.ReportCategory {
+	switch c {
+	case CategoryCheating:
+		return userspb.ReportCategory_REPORT_CATEGORY_CHEATING
+	case CategoryAbusiveUsername:
+		return userspb.ReportCategory_REPORT_CATEGORY_ABUSIVE_USERNAME
+	case CategoryHarassment:
+		return userspb.ReportCategory_REPORT_CATEGORY_HARASSMENT
+	case CategoryOther:
+		return userspb.ReportCategory_REPORT_CATEGORY_OTHER
+	default:
+		return userspb.ReportCategory_REPORT_CATEGORY_UNSPECIFIED
+	}
+}
+
+func categoryFromGRPCEnum(category userspb.ReportCategory) (Category, bool) {
+	switch category {
+	case userspb.ReportCategory_REPORT_CATEGORY_CHEATING:
+		return CategoryCheating, true
+	case userspb.ReportCategory_REPORT_CATEGORY_ABUSIVE_USERNAME:
+		return CategoryAbusiveUsername, true
+	case userspb.ReportCategory_REPORT_CATEGORY_HARASSMENT:
+		return CategoryHarassment, true
+	case userspb.ReportCategory_REPORT_CATEGORY_OTHER:
+		return CategoryOther, true
+	default:
+		return "", false
+	}
+}
+
+type Status string
+
+func (s Status) String() string {
+	return string(s)
+}
+
+const (
+	StatusOpen      Status = "open"
+	StatusClaimed   Status = "claimed"
+	StatusResolved  Status = "resolved"
+	StatusDismissed Status = "dismissed"
+)
+
+// Closed reports whether the report left the moderation queue.
+func (s Status) Closed() bool {
+	return s == StatusResolved || s == StatusDismissed
+}
+
+func (s Status) ToGRPCEnum() userspb.ReportStatus {
+	switch s {
+	case StatusOpen:
+		return userspb.ReportStatus_REPORT_STATUS_OPEN
+	case StatusClaimed:
+		return userspb.ReportStatus_REPORT_STATUS_CLAIMED
+	case StatusResolved:
+		return userspb.ReportStatus_REPORT_STATUS_RESOLVED
+	case StatusDismissed:
+		return userspb.ReportStatus_REPORT_STATUS_DISMISSED
+	default:
+		return userspb.ReportStatus_REPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func statusFromGRPCEnum(status userspb.ReportStatus) (Status, bool) {
+	switch status {
+	case userspb.ReportStatus_REPORT_STATUS_OPEN:
+		return StatusOpen, true
+	case userspb.ReportStatus_REPORT_STATUS_CLAIMED:
+		return StatusClaimed, true
+	case userspb.ReportStatus_REPORT_STATUS_RESOLVED:
+		return StatusResolved, true
+	case userspb.ReportStatus_REPORT_STATUS_DISMISSED:
+		return StatusDismissed, true
+	default:
+		return "", false
+	}
+}
+
+// Action is what the moderator did to the reported user when resolving the report.
+type Action string
+
+func (a Action) String() string {
+	return string(a)
+}
+
+const (
+	ActionNone          Action = "none"
+	ActionBan           Action = "ban"
+	ActionResetUsername Action = "reset_username"
+)
+
+func (a Action) ToGRPCEnum() userspb.ReportAction {
+	switch a {
+	case ActionNone:
+		return userspb.ReportAction_REPORT_ACTION_NONE
+	case ActionBan:
+		return userspb.ReportAction_REPORT_ACTION_BAN
+	case ActionResetUsername:
+		return userspb.ReportAction_REPORT_ACTION_RESET_USERNAME
+	default:
+		return userspb.ReportAction_REPORT_ACTION_UNSPECIFIED
+	}
+}
+
+func ActionFromGRPCEnum(action userspb.ReportAction) (Action, bool) {
+	switch action {
+	case userspb.ReportAction_REPORT_ACTION_NONE:
+		return ActionNone, true
+	case userspb.ReportAction_REPORT_ACTION_BAN:
+		return ActionBan, true
+	case userspb.ReportAction_REPORT_ACTION_RESET_USERNAME:
+		return ActionResetUsername, true
+	default:
+		return "", false
+	}
+}
+
+// NormalizeText trims the report text and checks its length.
+func NormalizeText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+
+	if utf8.RuneCountInString(text) > maxTextLength {
+		return "", ErrTextTooLong
+	}
+
+	return text, nil
+}
+
+// ResetUsername returns a neutral username replacing the username of a reported user,
+// it is derived from the user id so that it is unique.
+func ResetUsername(userID uuid.UUID) string {
+	return usernameResetPrefix + strings.ReplaceAll(userID.String(), "-", "")[:16]
+}
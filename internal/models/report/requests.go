@@ -0,0 +1,91 @@
+package report
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+type ReportUser struct {
+	ReporterID uuid.UUID
+	ReportedID uuid.UUID
+	Category   Category
+	Text       string
+	MatchID    *uuid.UUID
+}
+
+var _ abstractions.Requestable[ReportUser, *userspb.ReportUserRequest] = (*ReportUser)(nil)
+
+func (r ReportUser) Request(req *userspb.ReportUserRequest) (*ReportUser, error) {
+	var err error
+
+	if r.ReporterID, err = uuid.Parse(req.GetUserId()); err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid user id")
+	}
+
+	if r.ReportedID, err = uuid.Parse(req.GetReportedId()); err != nil {
+		return nil, apperrors.BadRequestHidden(err, "invalid reported id")
+	}
+
+	if r.ReporterID == r.ReportedID {
+		return nil, apperrors.BadRequest(ErrSelfReport)
+	}
+
+	var ok bool
+	if r.Category, ok = categoryFromGRPCEnum(req.GetCategory()); !ok {
+		return nil, apperrors.BadRequest(ErrInvalidCategory)
+	}
+
+	if r.Text, err = NormalizeText(req.GetText()); err != nil {
+		return nil, apperrors.BadRequest(err)
+	}
+
+	if req.MatchId != nil {
+		matchID, parseErr := uuid.Parse(req.GetMatchId())
+		if parseErr != nil {
+			return nil, apperrors.BadRequestHidden(parseErr, "invalid match id")
+		}
+
+		r.MatchID = &matchID
+	}
+
+	return &r, nil
+}
+
+var _ abstractions.Requestable[Filter, *userspb.ListReportsRequest] = (*Filter)(nil)
+
+func (f Filter) Request(req *userspb.ListReportsRequest) (*Filter, error) {
+	page, size := req.GetPage(), req.GetSize()
+	if page == 0 {
+		page = 1
+	}
+
+	f.Offset, f.Limit = (page-1)*size, size
+
+	if req.Status != nil {
+		status, ok := statusFromGRPCEnum(req.GetStatus())
+		if ok {
+			f.Status = &status
+		}
+	}
+
+	if req.Category != nil {
+		category, ok := categoryFromGRPCEnum(req.GetCategory())
+		if ok {
+			f.Category = &category
+		}
+	}
+
+	if req.ReportedId != nil {
+		reportedID, err := uuid.Parse(req.GetReportedId())
+		if err != nil {
+			return nil, apperrors.BadRequestHidden(err, "invalid reported id")
+		}
+
+		f.ReportedID = &reportedID
+	}
+
+	return &f, nil
+}
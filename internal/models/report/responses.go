@@ -0,0 +1,68 @@
+package report
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+)
+
+var _ abstractions.Responseable[userspb.UserReport] = (*Report)(nil)
+
+func (r *Report) Response() (*userspb.UserReport, error) {
+	var res userspb.UserReport
+
+	res.Id = r.ID.String()
+	res.ReporterId = r.ReporterID.String()
+	res.ReportedId = r.ReportedID.String()
+	res.Category = r.Category.ToGRPCEnum()
+	res.Text = r.Text
+	res.Status = r.Status.ToGRPCEnum()
+	res.CreatedAt = timestamppb.New(r.CreatedAt)
+
+	if r.MatchID != nil {
+		matchID := r.MatchID.String()
+		res.MatchId = &matchID
+	}
+
+	if r.ModeratorID != nil {
+		moderatorID := r.ModeratorID.String()
+		res.ModeratorId = &moderatorID
+	}
+
+	if r.Action != nil {
+		res.Action = r.Action.ToGRPCEnum()
+	}
+
+	if r.ClaimedAt != nil {
+		res.ClaimedAt = timestamppb.New(*r.ClaimedAt)
+	}
+
+	if r.ClosedAt != nil {
+		res.ClosedAt = timestamppb.New(*r.ClosedAt)
+	}
+
+	return &res, nil
+}
+
+var _ abstractions.Responseable[userspb.ListReportsResponse] = (*ReportsPage)(nil)
+
+func (p *ReportsPage) Response() (*userspb.ListReportsResponse, error) {
+	var res userspb.ListReportsResponse
+
+	res.Reports = make([]*userspb.UserReport, len(p.Reports))
+	for i, r := range p.Reports {
+		report, err := r.Response()
+		if err != nil {
+			return nil, err
+		}
+
+		res.Reports[i] = report
+	}
+
+	res.Page = p.Page
+	res.Size = p.Size
+	res.Amount = p.Amount
+
+	return &res, nil
+}
@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+var _ Broker = (*MemoryBroker)(nil)
+
+// MemoryBroker is a single instance Broker used when Redis is not configured.
+type MemoryBroker struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan *profile.Notification]struct{}
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		subscribers: make(map[uuid.UUID]map[chan *profile.Notification]struct{}),
+	}
+}
+
+// Publish never blocks, slow subscribers miss notifications instead of blocking writers.
+func (b *MemoryBroker) Publish(_ context.Context, notification *profile.Notification) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for updates := range b.subscribers[notification.UserID] {
+		n := *notification
+		select {
+		case updates <- &n:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, userID uuid.UUID) (<-chan *profile.Notification, error) {
+	updates := make(chan *profile.Notification, subscriptionBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[chan *profile.Notification]struct{})
+	}
+	b.subscribers[userID][updates] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subscribers[userID], updates)
+		if len(b.subscribers[userID]) == 0 {
+			delete(b.subscribers, userID)
+		}
+		close(updates)
+		b.mu.Unlock()
+	}()
+
+	return updates, nil
+}
@@ -0,0 +1,20 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const subscriptionBufferSize = 32
+
+// Broker fans stored notifications out to the streams of their recipients, whichever instance serves them.
+// Delivery is best effort, notifications missed while a user is not subscribed are listed from the database.
+type Broker interface {
+	// Publish delivers the notification to the current subscribers of its recipient.
+	Publish(ctx context.Context, notification *profile.Notification) error
+	// Subscribe streams the notifications of the user until ctx is done.
+	Subscribe(ctx context.Context, userID uuid.UUID) (<-chan *profile.Notification, error)
+}
@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const channelPattern = "notifications:*"
+
+var _ Broker = (*RedisBroker)(nil)
+
+// RedisBroker publishes notifications to a per-user channel, so a notification created on one instance
+// reaches the stream of its recipient on any other. Each instance holds a single pattern subscription
+// and fans the notifications out to its local subscribers, like MemoryBroker does.
+type RedisBroker struct {
+	client *redis.Client
+	pubsub *redis.PubSub
+	local  *MemoryBroker
+	logger *zap.Logger
+}
+
+func NewRedisBroker(ctx context.Context, client *redis.Client, logger *zap.Logger) (*RedisBroker, error) {
+	pubsub := client.PSubscribe(ctx, channelPattern)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("subscribing to notifications: %w", err)
+	}
+
+	b := &RedisBroker{
+		client: client,
+		pubsub: pubsub,
+		local:  NewMemoryBroker(),
+		logger: logger,
+	}
+
+	go b.receive()
+
+	return b, nil
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, notification *profile.Notification) error {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("encoding notification: %w", err)
+	}
+
+	if err = b.client.Publish(ctx, channel(notification.UserID), payload).Err(); err != nil {
+		return fmt.Errorf("publishing notification: %w", err)
+	}
+
+	return nil
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, userID uuid.UUID) (<-chan *profile.Notification, error) {
+	return b.local.Subscribe(ctx, userID)
+}
+
+// Close ends the pattern subscription, the local streams stay open until their contexts are done.
+func (b *RedisBroker) Close() error {
+	return b.pubsub.Close()
+}
+
+// receive hands every notification published on any instance to the local subscribers of its recipient
+// until the broker is closed.
+func (b *RedisBroker) receive() {
+	for msg := range b.pubsub.Channel() {
+		var n profile.Notification
+		if err := json.Unmarshal([]byte(msg.Payload), &n); err != nil {
+			b.logger.Warn("skipping malformed notification", zap.String("channel", msg.Channel), zap.Error(err))
+			continue
+		}
+
+		_ = b.local.Publish(context.Background(), &n)
+	}
+}
+
+func channel(userID uuid.UUID) string {
+	return "notifications:" + userID.String()
+}
@@ -0,0 +1,215 @@
+package party
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+var _ Registry = (*MemoryRegistry)(nil)
+
+type memoryEntry struct {
+	party     *profile.Party
+	expiresAt time.Time
+}
+
+// MemoryRegistry is a single instance Registry used when Redis is not configured.
+type MemoryRegistry struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	parties     map[uuid.UUID]memoryEntry
+	members     map[uuid.UUID]uuid.UUID
+	subscribers map[uuid.UUID]map[chan *profile.Party]struct{}
+}
+
+func NewMemoryRegistry(ttl time.Duration) *MemoryRegistry {
+	return &MemoryRegistry{
+		ttl:         ttl,
+		parties:     make(map[uuid.UUID]memoryEntry),
+		members:     make(map[uuid.UUID]uuid.UUID),
+		subscribers: make(map[uuid.UUID]map[chan *profile.Party]struct{}),
+	}
+}
+
+func (r *MemoryRegistry) Create(_ context.Context, party *profile.Party) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	for _, id := range party.MemberIDs {
+		if _, ok := r.partyOf(id, now); ok {
+			return ErrInAnotherParty
+		}
+	}
+
+	r.store(clone(party), nil, now)
+
+	return nil
+}
+
+func (r *MemoryRegistry) Get(_ context.Context, partyID uuid.UUID) (*profile.Party, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.live(partyID, time.Now())
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return clone(entry.party), nil
+}
+
+func (r *MemoryRegistry) PartyOf(_ context.Context, userID uuid.UUID) (uuid.UUID, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	partyID, ok := r.partyOf(userID, time.Now())
+
+	return partyID, ok, nil
+}
+
+func (r *MemoryRegistry) Update(_ context.Context, partyID uuid.UUID, fn func(party *profile.Party) error) (*profile.Party, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	entry, ok := r.live(partyID, now)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	party := clone(entry.party)
+	if err := fn(party); err != nil {
+		return nil, err
+	}
+
+	party.Version++
+
+	added, removed := diffMembers(entry.party.MemberIDs, party.MemberIDs)
+	for _, id := range added {
+		if _, taken := r.partyOf(id, now); taken {
+			return nil, ErrInAnotherParty
+		}
+	}
+
+	r.store(party, removed, now)
+
+	return clone(party), nil
+}
+
+func (r *MemoryRegistry) Subscribe(ctx context.Context, partyID uuid.UUID) (<-chan *profile.Party, error) {
+	updates := make(chan *profile.Party, subscriptionBufferSize)
+
+	r.mu.Lock()
+	if r.subscribers[partyID] == nil {
+		r.subscribers[partyID] = make(map[chan *profile.Party]struct{})
+	}
+	r.subscribers[partyID][updates] = struct{}{}
+	r.mu.Unlock()
+
+	go func() {
+		r.watchExpiry(ctx, partyID, updates)
+
+		r.mu.Lock()
+		delete(r.subscribers[partyID], updates)
+		if len(r.subscribers[partyID]) == 0 {
+			delete(r.subscribers, partyID)
+		}
+		close(updates)
+		r.mu.Unlock()
+	}()
+
+	return updates, nil
+}
+
+// watchExpiry streams the party as disbanded once it expires and returns when ctx is done.
+func (r *MemoryRegistry) watchExpiry(ctx context.Context, partyID uuid.UUID, updates chan *profile.Party) {
+	expiry := time.NewTimer(r.ttl)
+	defer expiry.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expiry.C:
+		}
+
+		r.mu.Lock()
+		now := time.Now()
+		entry, ok := r.live(partyID, now)
+		if !ok {
+			deliver(updates, expired(partyID))
+		}
+		r.mu.Unlock()
+
+		if !ok {
+			<-ctx.Done()
+			return
+		}
+
+		expiry.Reset(entry.expiresAt.Sub(now))
+	}
+}
+
+// store must be called with r.mu held, it saves or deletes the party, updates the member index and publishes the party.
+func (r *MemoryRegistry) store(party *profile.Party, removed []uuid.UUID, now time.Time) {
+	for _, id := range removed {
+		delete(r.members, id)
+	}
+
+	if party.Disbanded() {
+		delete(r.parties, party.ID)
+	} else {
+		r.parties[party.ID] = memoryEntry{party: party, expiresAt: now.Add(r.ttl)}
+
+		for _, id := range party.MemberIDs {
+			r.members[id] = party.ID
+		}
+	}
+
+	r.publish(party)
+}
+
+// live returns the unexpired party, dropping an expired one together with its member index.
+func (r *MemoryRegistry) live(partyID uuid.UUID, now time.Time) (memoryEntry, bool) {
+	entry, ok := r.parties[partyID]
+	if ok && now.After(entry.expiresAt) {
+		delete(r.parties, partyID)
+
+		for _, id := range entry.party.MemberIDs {
+			if r.members[id] == partyID {
+				delete(r.members, id)
+			}
+		}
+
+		return memoryEntry{}, false
+	}
+
+	return entry, ok
+}
+
+func (r *MemoryRegistry) partyOf(userID uuid.UUID, now time.Time) (uuid.UUID, bool) {
+	partyID, ok := r.members[userID]
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	if _, live := r.live(partyID, now); !live {
+		return uuid.Nil, false
+	}
+
+	return partyID, true
+}
+
+// publish must be called with r.mu held, slow subscribers miss updates instead of blocking writers.
+func (r *MemoryRegistry) publish(party *profile.Party) {
+	for sub := range r.subscribers[party.ID] {
+		deliver(sub, clone(party))
+	}
+}
@@ -0,0 +1,105 @@
+package party
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+var (
+	// ErrNotFound is returned for a party that was disbanded or expired.
+	ErrNotFound = errors.New("party not found")
+	// ErrInAnotherParty is returned when a user joins a party while being a member of another one.
+	ErrInAnotherParty = errors.New("user is in another party")
+)
+
+// Registry keeps short-lived parties, a party expires unless it changes within the TTL.
+// A user is a member of at most one party.
+type Registry interface {
+	// Create stores a new party, none of its members may be in another party.
+	Create(ctx context.Context, party *profile.Party) error
+	// Get returns the party or ErrNotFound.
+	Get(ctx context.Context, partyID uuid.UUID) (*profile.Party, error)
+	// PartyOf returns the party the user is a member of, ok is false when there is none.
+	PartyOf(ctx context.Context, userID uuid.UUID) (partyID uuid.UUID, ok bool, err error)
+	// Update applies fn to the party atomically, bumps its version and publishes the result, a party left
+	// without members is deleted. An error returned by fn aborts the update and is returned as is.
+	Update(ctx context.Context, partyID uuid.UUID, fn func(party *profile.Party) error) (*profile.Party, error)
+	// Subscribe streams every change of the party until ctx is done. A party that expires is streamed
+	// as disbanded, and the disbanded party is never dropped for a slow subscriber.
+	Subscribe(ctx context.Context, partyID uuid.UUID) (<-chan *profile.Party, error)
+}
+
+const (
+	DefaultTTL     = time.Minute * 30
+	DefaultMaxSize = 4
+
+	subscriptionBufferSize = 16
+)
+
+func clone(party *profile.Party) *profile.Party {
+	c := *party
+	c.MemberIDs = append([]uuid.UUID(nil), party.MemberIDs...)
+	c.InvitedIDs = append([]uuid.UUID(nil), party.InvitedIDs...)
+
+	return &c
+}
+
+// expired is the disbanded party streamed to subscribers when the party expires without a change.
+func expired(partyID uuid.UUID) *profile.Party {
+	return &profile.Party{
+		ID:        partyID,
+		Event:     profile.PartyDisbanded,
+		UpdatedAt: time.Now().UTC(),
+	}
+}
+
+// deliver hands the party to a subscriber without blocking, slow subscribers miss intermediate updates.
+// A disbanded party ends the stream, so it makes room by dropping the oldest pending update instead.
+func deliver(sub chan *profile.Party, party *profile.Party) {
+	for {
+		select {
+		case sub <- party:
+			return
+		default:
+		}
+
+		if !party.Disbanded() {
+			return
+		}
+
+		select {
+		case <-sub:
+		default:
+		}
+	}
+}
+
+// diffMembers returns the members that were added and removed between the two lists.
+func diffMembers(before, after []uuid.UUID) (added, removed []uuid.UUID) {
+	was := make(map[uuid.UUID]struct{}, len(before))
+	for _, id := range before {
+		was[id] = struct{}{}
+	}
+
+	is := make(map[uuid.UUID]struct{}, len(after))
+	for _, id := range after {
+		is[id] = struct{}{}
+
+		if _, ok := was[id]; !ok {
+			added = append(added, id)
+		}
+	}
+
+	for _, id := range before {
+		if _, ok := is[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	return added, removed
+}
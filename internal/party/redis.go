@@ -0,0 +1,298 @@
+package party
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+// maxTxAttempts bounds the retries of an optimistic transaction that lost a race with another writer.
+const maxTxAttempts = 5
+
+var _ Registry = (*RedisRegistry)(nil)
+
+// RedisRegistry shares parties between service instances. A party and the member index of its members
+// live under keys with a TTL refreshed on every change, changes are written in WATCH transactions
+// and published to a per-party channel.
+type RedisRegistry struct {
+	client *redis.Client
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+func NewRedisRegistry(client *redis.Client, ttl time.Duration, logger *zap.Logger) *RedisRegistry {
+	return &RedisRegistry{
+		client: client,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+func (r *RedisRegistry) Create(ctx context.Context, party *profile.Party) error {
+	value, err := json.Marshal(party)
+	if err != nil {
+		return fmt.Errorf("encoding party: %w", err)
+	}
+
+	keys := memberKeys(party.MemberIDs)
+
+	err = r.retry(ctx, func(tx *redis.Tx) error {
+		taken, txErr := tx.Exists(ctx, keys...).Result()
+		switch {
+		case txErr != nil:
+			return fmt.Errorf("reading party members: %w", txErr)
+		case taken > 0:
+			return ErrInAnotherParty
+		}
+
+		_, txErr = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key(party.ID), value, r.ttl)
+
+			for _, id := range party.MemberIDs {
+				pipe.Set(ctx, memberKey(id), party.ID.String(), r.ttl)
+			}
+
+			return nil
+		})
+
+		return txErr
+	}, keys...)
+	if err != nil {
+		return err
+	}
+
+	r.publish(ctx, party)
+
+	return nil
+}
+
+func (r *RedisRegistry) Get(ctx context.Context, partyID uuid.UUID) (*profile.Party, error) {
+	return r.read(ctx, r.client, partyID)
+}
+
+func (r *RedisRegistry) PartyOf(ctx context.Context, userID uuid.UUID) (uuid.UUID, bool, error) {
+	value, err := r.client.Get(ctx, memberKey(userID)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return uuid.Nil, false, nil
+	case err != nil:
+		return uuid.Nil, false, fmt.Errorf("reading party member: %w", err)
+	}
+
+	partyID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("decoding party member: %w", err)
+	}
+
+	return partyID, true, nil
+}
+
+func (r *RedisRegistry) Update(ctx context.Context, partyID uuid.UUID, fn func(party *profile.Party) error) (*profile.Party, error) {
+	var updated *profile.Party
+
+	err := r.retry(ctx, func(tx *redis.Tx) error {
+		party, txErr := r.read(ctx, tx, partyID)
+		if txErr != nil {
+			return txErr
+		}
+
+		before := party.MemberIDs
+		party = clone(party)
+
+		if txErr = fn(party); txErr != nil {
+			return txErr
+		}
+
+		party.Version++
+
+		added, removed := diffMembers(before, party.MemberIDs)
+		if len(added) > 0 {
+			keys := memberKeys(added)
+
+			if txErr = tx.Watch(ctx, keys...).Err(); txErr != nil {
+				return fmt.Errorf("watching party members: %w", txErr)
+			}
+
+			taken, existsErr := tx.Exists(ctx, keys...).Result()
+			switch {
+			case existsErr != nil:
+				return fmt.Errorf("reading party members: %w", existsErr)
+			case taken > 0:
+				return ErrInAnotherParty
+			}
+		}
+
+		value, txErr := json.Marshal(party)
+		if txErr != nil {
+			return fmt.Errorf("encoding party: %w", txErr)
+		}
+
+		_, txErr = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, id := range removed {
+				pipe.Del(ctx, memberKey(id))
+			}
+
+			if party.Disbanded() {
+				pipe.Del(ctx, key(partyID))
+				return nil
+			}
+
+			pipe.Set(ctx, key(partyID), value, r.ttl)
+
+			for _, id := range party.MemberIDs {
+				pipe.Set(ctx, memberKey(id), partyID.String(), r.ttl)
+			}
+
+			return nil
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		updated = party
+
+		return nil
+	}, key(partyID))
+	if err != nil {
+		return nil, err
+	}
+
+	// The party is already written, a failed publish only delays subscribers until the next change.
+	r.publish(ctx, updated)
+
+	return updated, nil
+}
+
+func (r *RedisRegistry) Subscribe(ctx context.Context, partyID uuid.UUID) (<-chan *profile.Party, error) {
+	updates := make(chan *profile.Party, subscriptionBufferSize)
+
+	pubsub := r.client.Subscribe(ctx, channel(partyID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("subscribing to party updates: %w", err)
+	}
+
+	go func() {
+		defer close(updates)
+		defer func() { _ = pubsub.Close() }()
+
+		messages := pubsub.Channel()
+
+		// Every change refreshes the ttl and is published, so the party is checked for expiry only
+		// once it stayed unchanged for the whole ttl.
+		expiry := time.NewTimer(r.ttl)
+		defer expiry.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-expiry.C:
+				left, err := r.client.PTTL(ctx, key(partyID)).Result()
+				switch {
+				case err != nil:
+					r.logger.Warn("checking party expiry", zap.String("party_id", partyID.String()), zap.Error(err))
+					expiry.Reset(r.ttl)
+				case left < 0:
+					deliver(updates, expired(partyID))
+					return
+				default:
+					expiry.Reset(left)
+				}
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var p profile.Party
+				if err := json.Unmarshal([]byte(msg.Payload), &p); err != nil {
+					r.logger.Warn("skipping malformed party update", zap.String("channel", msg.Channel), zap.Error(err))
+					continue
+				}
+
+				deliver(updates, &p)
+
+				if p.Disbanded() {
+					return
+				}
+
+				expiry.Reset(r.ttl)
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// retry runs fn in a WATCH transaction over keys, starting over when another writer changed a watched key.
+func (r *RedisRegistry) retry(ctx context.Context, fn func(tx *redis.Tx) error, keys ...string) error {
+	var err error
+
+	for range maxTxAttempts {
+		err = r.client.Watch(ctx, fn, keys...)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("writing party: %w", err)
+}
+
+func (r *RedisRegistry) read(ctx context.Context, client redis.Cmdable, partyID uuid.UUID) (*profile.Party, error) {
+	value, err := client.Get(ctx, key(partyID)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, ErrNotFound
+	case err != nil:
+		return nil, fmt.Errorf("reading party: %w", err)
+	}
+
+	var party profile.Party
+	if err = json.Unmarshal([]byte(value), &party); err != nil {
+		return nil, fmt.Errorf("decoding party: %w", err)
+	}
+
+	return &party, nil
+}
+
+// publish logs instead of failing, the change it announces is already stored.
+func (r *RedisRegistry) publish(ctx context.Context, party *profile.Party) {
+	payload, err := json.Marshal(party)
+	if err != nil {
+		r.logger.Error("encoding party update", zap.String("party_id", party.ID.String()), zap.Error(err))
+		return
+	}
+
+	if err = r.client.Publish(ctx, channel(party.ID), payload).Err(); err != nil {
+		r.logger.Warn("publishing party update", zap.String("party_id", party.ID.String()), zap.Error(err))
+	}
+}
+
+func memberKeys(userIDs []uuid.UUID) []string {
+	keys := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		keys[i] = memberKey(id)
+	}
+
+	return keys
+}
+
+func key(partyID uuid.UUID) string {
+	return "party:" + partyID.String()
+}
+
+func memberKey(userID uuid.UUID) string {
+	return "party:member:" + userID.String()
+}
+
+func channel(partyID uuid.UUID) string {
+	return "party:updates:" + partyID.String()
+}
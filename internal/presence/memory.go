@@ -0,0 +1,156 @@
+package presence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+var _ Tracker = (*MemoryTracker)(nil)
+
+type memoryEntry struct {
+	presence  profile.Presence
+	expiresAt time.Time
+}
+
+type memorySubscriber struct {
+	userIDs map[uuid.UUID]struct{}
+	updates chan *profile.Presence
+}
+
+// MemoryTracker is a single instance Tracker used when Redis is not configured.
+type MemoryTracker struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	entries     map[uuid.UUID]memoryEntry
+	subscribers map[*memorySubscriber]struct{}
+}
+
+func NewMemoryTracker(ttl time.Duration) *MemoryTracker {
+	return &MemoryTracker{
+		ttl:         ttl,
+		entries:     make(map[uuid.UUID]memoryEntry),
+		subscribers: make(map[*memorySubscriber]struct{}),
+	}
+}
+
+func (t *MemoryTracker) Set(_ context.Context, userID uuid.UUID, status profile.PresenceStatus) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	current, ok := t.live(userID, now)
+
+	if status == profile.Offline {
+		delete(t.entries, userID)
+
+		if ok {
+			t.publish(profile.Presence{UserID: userID, Status: profile.Offline, UpdatedAt: now})
+		}
+
+		return nil
+	}
+
+	entry := memoryEntry{
+		presence:  profile.Presence{UserID: userID, Status: status, UpdatedAt: now},
+		expiresAt: now.Add(t.ttl),
+	}
+
+	t.entries[userID] = entry
+
+	if !ok || current.presence.Status != status {
+		t.publish(entry.presence)
+	}
+
+	return nil
+}
+
+func (t *MemoryTracker) Touch(ctx context.Context, userID uuid.UUID) error {
+	t.mu.Lock()
+
+	now := time.Now()
+	if current, ok := t.live(userID, now); ok {
+		current.expiresAt = now.Add(t.ttl)
+		t.entries[userID] = current
+		t.mu.Unlock()
+
+		return nil
+	}
+
+	t.mu.Unlock()
+
+	return t.Set(ctx, userID, profile.Online)
+}
+
+func (t *MemoryTracker) Get(_ context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*profile.Presence, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	presences := make(map[uuid.UUID]*profile.Presence, len(userIDs))
+
+	for _, id := range userIDs {
+		if entry, ok := t.live(id, now); ok {
+			p := entry.presence
+			presences[id] = &p
+		}
+	}
+
+	return presences, nil
+}
+
+func (t *MemoryTracker) Subscribe(ctx context.Context, userIDs []uuid.UUID) (<-chan *profile.Presence, error) {
+	sub := &memorySubscriber{
+		userIDs: make(map[uuid.UUID]struct{}, len(userIDs)),
+		updates: make(chan *profile.Presence, subscriptionBufferSize),
+	}
+
+	for _, id := range userIDs {
+		sub.userIDs[id] = struct{}{}
+	}
+
+	t.mu.Lock()
+	t.subscribers[sub] = struct{}{}
+	t.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		t.mu.Lock()
+		delete(t.subscribers, sub)
+		close(sub.updates)
+		t.mu.Unlock()
+	}()
+
+	return sub.updates, nil
+}
+
+// live returns the unexpired entry of the user, dropping an expired one.
+func (t *MemoryTracker) live(userID uuid.UUID, now time.Time) (memoryEntry, bool) {
+	entry, ok := t.entries[userID]
+	if ok && now.After(entry.expiresAt) {
+		delete(t.entries, userID)
+		return memoryEntry{}, false
+	}
+
+	return entry, ok
+}
+
+// publish must be called with t.mu held, slow subscribers miss updates instead of blocking writers.
+func (t *MemoryTracker) publish(presence profile.Presence) {
+	for sub := range t.subscribers {
+		if _, ok := sub.userIDs[presence.UserID]; !ok {
+			continue
+		}
+
+		p := presence
+		select {
+		case sub.updates <- &p:
+		default:
+		}
+	}
+}
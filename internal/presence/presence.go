@@ -0,0 +1,31 @@
+package presence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const (
+	// DefaultTTL is how long an entry lives without being refreshed when no ttl is configured.
+	DefaultTTL = time.Minute
+	// DefaultResyncInterval is how often presence streams re-read the friend list when no interval is configured.
+	DefaultResyncInterval = 30 * time.Second
+)
+
+// Tracker keeps short-lived presence entries of connected players.
+// Entries expire unless refreshed, an expired or missing entry means the player is offline.
+type Tracker interface {
+	// Set stores the status of the user, setting Offline removes the entry.
+	Set(ctx context.Context, userID uuid.UUID, status profile.PresenceStatus) error
+	// Touch refreshes the entry of the user, marking the user online when there is none.
+	Touch(ctx context.Context, userID uuid.UUID) error
+	// Get returns live entries of the given users, offline users are omitted.
+	Get(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*profile.Presence, error)
+	// Subscribe streams status changes of the given users until ctx is done.
+	// Expirations are not published.
+	Subscribe(ctx context.Context, userIDs []uuid.UUID) (<-chan *profile.Presence, error)
+}
@@ -0,0 +1,190 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const subscriptionBufferSize = 16
+
+var _ Tracker = (*RedisTracker)(nil)
+
+// RedisTracker shares presence between service instances, entries live under keys with a TTL
+// and status changes are published to per-user channels.
+type RedisTracker struct {
+	client *redis.Client
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+func NewRedisTracker(client *redis.Client, ttl time.Duration, logger *zap.Logger) *RedisTracker {
+	return &RedisTracker{
+		client: client,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+func (t *RedisTracker) Set(ctx context.Context, userID uuid.UUID, status profile.PresenceStatus) error {
+	presence := profile.Presence{UserID: userID, Status: status, UpdatedAt: time.Now().UTC()}
+
+	if status == profile.Offline {
+		err := t.client.Del(ctx, key(userID)).Err()
+		if err != nil {
+			return fmt.Errorf("deleting presence: %w", err)
+		}
+
+		return t.publish(ctx, presence)
+	}
+
+	value, err := json.Marshal(presence)
+	if err != nil {
+		return fmt.Errorf("encoding presence: %w", err)
+	}
+
+	previous, err := t.client.SetArgs(ctx, key(userID), value, redis.SetArgs{TTL: t.ttl, Get: true}).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+	case err != nil:
+		return fmt.Errorf("storing presence: %w", err)
+	default:
+		var old profile.Presence
+		if json.Unmarshal([]byte(previous), &old) == nil && old.Status == status {
+			return nil
+		}
+	}
+
+	return t.publish(ctx, presence)
+}
+
+func (t *RedisTracker) Touch(ctx context.Context, userID uuid.UUID) error {
+	refreshed, err := t.client.Expire(ctx, key(userID), t.ttl).Result()
+	if err != nil {
+		return fmt.Errorf("refreshing presence: %w", err)
+	}
+
+	if refreshed {
+		return nil
+	}
+
+	return t.Set(ctx, userID, profile.Online)
+}
+
+func (t *RedisTracker) Get(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*profile.Presence, error) {
+	presences := make(map[uuid.UUID]*profile.Presence, len(userIDs))
+	if len(userIDs) == 0 {
+		return presences, nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		keys[i] = key(id)
+	}
+
+	values, err := t.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("reading presences: %w", err)
+	}
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var p profile.Presence
+		if err = json.Unmarshal([]byte(raw), &p); err != nil {
+			t.logger.Warn("skipping malformed presence", zap.String("user_id", userIDs[i].String()), zap.Error(err))
+			continue
+		}
+
+		presences[userIDs[i]] = &p
+	}
+
+	return presences, nil
+}
+
+func (t *RedisTracker) Subscribe(ctx context.Context, userIDs []uuid.UUID) (<-chan *profile.Presence, error) {
+	updates := make(chan *profile.Presence, subscriptionBufferSize)
+
+	if len(userIDs) == 0 {
+		go func() {
+			<-ctx.Done()
+			close(updates)
+		}()
+
+		return updates, nil
+	}
+
+	channels := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		channels[i] = channel(id)
+	}
+
+	pubsub := t.client.Subscribe(ctx, channels...)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("subscribing to presence updates: %w", err)
+	}
+
+	go func() {
+		defer close(updates)
+		defer func() { _ = pubsub.Close() }()
+
+		messages := pubsub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var p profile.Presence
+				if err := json.Unmarshal([]byte(msg.Payload), &p); err != nil {
+					t.logger.Warn("skipping malformed presence update", zap.String("channel", msg.Channel), zap.Error(err))
+					continue
+				}
+
+				select {
+				case updates <- &p:
+				default:
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+func (t *RedisTracker) publish(ctx context.Context, presence profile.Presence) error {
+	payload, err := json.Marshal(presence)
+	if err != nil {
+		return fmt.Errorf("encoding presence: %w", err)
+	}
+
+	if err = t.client.Publish(ctx, channel(presence.UserID), payload).Err(); err != nil {
+		return fmt.Errorf("publishing presence: %w", err)
+	}
+
+	return nil
+}
+
+func key(userID uuid.UUID) string {
+	return "presence:" + userID.String()
+}
+
+func channel(userID uuid.UUID) string {
+	return "presence:updates:" + userID.String()
+}
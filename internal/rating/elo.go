@@ -0,0 +1,55 @@
+package rating
+
+import "math"
+
+const defaultEloK = 32.0
+
+var _ Calculator = (*EloCalculator)(nil)
+
+// EloCalculator treats a match as pairwise games between all participants,
+// the K factor is shared between opponents so match size does not inflate changes.
+type EloCalculator struct {
+	k float64
+}
+
+func NewEloCalculator(k float64) *EloCalculator {
+	if k <= 0 {
+		k = defaultEloK
+	}
+
+	return &EloCalculator{k: k}
+}
+
+func (c *EloCalculator) Rate(players []Player) []Result {
+	results := make([]Result, len(players))
+	if len(players) < 2 {
+		for i, p := range players {
+			results[i] = Result{Rating: clampRating(p.Rating), Deviation: p.Deviation, Volatility: p.Volatility}
+		}
+
+		return results
+	}
+
+	k := c.k / float64(len(players)-1)
+
+	for i, p := range players {
+		var expected, actual float64
+
+		for j, o := range players {
+			if i == j {
+				continue
+			}
+
+			expected += 1 / (1 + math.Pow(10, (o.Rating-p.Rating)/400))
+			actual += score(p, o)
+		}
+
+		results[i] = Result{
+			Rating:     clampRating(p.Rating + k*(actual-expected)),
+			Deviation:  p.Deviation,
+			Volatility: p.Volatility,
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,133 @@
+package rating
+
+import "math"
+
+const (
+	defaultGlickoTau = 0.5
+	glickoScale      = 173.7178
+	glickoBase       = 1500.0
+	glickoEpsilon    = 0.000001
+	minDeviation     = 30.0
+)
+
+var _ Calculator = (*Glicko2Calculator)(nil)
+
+// Glicko2Calculator rates a match as one rating period in which every
+// participant played all the others, following Glickman's Glicko-2 paper.
+type Glicko2Calculator struct {
+	tau float64
+}
+
+func NewGlicko2Calculator(tau float64) *Glicko2Calculator {
+	if tau <= 0 {
+		tau = defaultGlickoTau
+	}
+
+	return &Glicko2Calculator{tau: tau}
+}
+
+func (c *Glicko2Calculator) Rate(players []Player) []Result {
+	results := make([]Result, len(players))
+
+	for i, p := range players {
+		mu, phi, sigma := toGlicko2(p)
+
+		var variance, improvement float64
+
+		for j, o := range players {
+			if i == j {
+				continue
+			}
+
+			muJ, phiJ, _ := toGlicko2(o)
+			g := glickoG(phiJ)
+			e := 1 / (1 + math.Exp(-g*(mu-muJ)))
+
+			variance += g * g * e * (1 - e)
+			improvement += g * (score(p, o) - e)
+		}
+
+		if variance == 0 {
+			results[i] = Result{Rating: clampRating(p.Rating), Deviation: p.Deviation, Volatility: sigma}
+			continue
+		}
+
+		v := 1 / variance
+		delta := v * improvement
+
+		sigma = c.volatility(phi, sigma, v, delta)
+		phiStar := math.Sqrt(phi*phi + sigma*sigma)
+		phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+		mu += phi * phi * improvement
+
+		results[i] = Result{
+			Rating:     clampRating(glickoScale*mu + glickoBase),
+			Deviation:  math.Max(minDeviation, math.Min(DefaultDeviation, glickoScale*phi)),
+			Volatility: sigma,
+		}
+	}
+
+	return results
+}
+
+// volatility solves for the new volatility with the Illinois algorithm (step 5 of the paper).
+func (c *Glicko2Calculator) volatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	tau2 := c.tau * c.tau
+
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/tau2
+	}
+
+	upper := a
+	lower := 0.0
+
+	if delta*delta > phi*phi+v {
+		lower = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*c.tau) < 0 {
+			k++
+		}
+
+		lower = a - k*c.tau
+	}
+
+	fUpper, fLower := f(upper), f(lower)
+
+	for math.Abs(lower-upper) > glickoEpsilon {
+		next := upper + (upper-lower)*fUpper/(fLower-fUpper)
+		fNext := f(next)
+
+		if fNext*fLower <= 0 {
+			upper, fUpper = lower, fLower
+		} else {
+			fUpper /= 2
+		}
+
+		lower, fLower = next, fNext
+	}
+
+	return math.Exp(upper / 2)
+}
+
+func toGlicko2(p Player) (mu, phi, sigma float64) {
+	deviation := p.Deviation
+	if deviation <= 0 {
+		deviation = DefaultDeviation
+	}
+
+	sigma = p.Volatility
+	if sigma <= 0 {
+		sigma = DefaultVolatility
+	}
+
+	return (p.Rating - glickoBase) / glickoScale, deviation / glickoScale, sigma
+}
+
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
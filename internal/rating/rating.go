@@ -0,0 +1,68 @@
+package rating
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	Elo     = "elo"
+	Glicko2 = "glicko2"
+)
+
+const (
+	DefaultDeviation  = 350.0
+	DefaultVolatility = 0.06
+
+	minRating = math.MinInt16
+	maxRating = math.MaxInt16
+)
+
+// Player is the rating state of a match participant before the match.
+// Placement starts at 1, equal placements are draws.
+type Player struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+	Placement  int
+}
+
+// Result is the rating state of a participant after the match.
+type Result struct {
+	Rating     int32
+	Deviation  float64
+	Volatility float64
+}
+
+// Calculator rates a single multiplayer match, results are returned in the order of players.
+type Calculator interface {
+	Rate(players []Player) []Result
+}
+
+// NewCalculator returns the calculator of the algorithm, Elo is used when none is set.
+func NewCalculator(algorithm string, eloK, glickoTau float64) (Calculator, error) {
+	switch algorithm {
+	case Elo, "":
+		return NewEloCalculator(eloK), nil
+	case Glicko2:
+		return NewGlicko2Calculator(glickoTau), nil
+	default:
+		return nil, fmt.Errorf("unknown rating algorithm %q", algorithm)
+	}
+}
+
+// score is the outcome of a pairwise comparison of placements from the view of a.
+func score(a, b Player) float64 {
+	switch {
+	case a.Placement < b.Placement:
+		return 1
+	case a.Placement == b.Placement:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+func clampRating(r float64) int32 {
+	return int32(math.Max(minRating, math.Min(maxRating, math.Round(r))))
+}
@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Locker elects the replica that runs locked jobs. RunLocked runs fn only on the elected replica and
+// reports false without running it on the others. A replica keeps the leadership until it stops or
+// loses its lock, then another replica takes over on its next tick.
+type Locker interface {
+	RunLocked(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
+}
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+	// RunOnStart runs the job once as soon as the scheduler starts instead of waiting for the first tick.
+	RunOnStart bool
+	// Locker limits runs of the job to the elected replica, every replica runs it when nil.
+	Locker Locker
+}
+
+// Scheduler runs registered jobs periodically until it is stopped.
+type Scheduler struct {
+	jobs   []Job
+	logger *zap.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(logger *zap.Logger) *Scheduler {
+	return &Scheduler{logger: logger}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.logger.Warn("job disabled: interval is not set", zap.String("job", job.Name))
+			continue
+		}
+
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.logger.Info("job scheduled", zap.String("job", job.Name), zap.Duration("interval", job.Interval))
+
+	if job.RunOnStart {
+		s.execute(ctx, job)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.execute(ctx, job)
+		}
+	}
+}
+
+// RunNow runs the named job once, through its locker, without waiting for the next tick.
+// It reports false when the job is not registered or another replica is the leader.
+func (s *Scheduler) RunNow(ctx context.Context, name string) (bool, error) {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return runJob(ctx, job)
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Scheduler) execute(ctx context.Context, job Job) {
+	ran, err := runJob(ctx, job)
+	switch {
+	case err != nil:
+		s.logger.Error("job failed", zap.String("job", job.Name), zap.Error(err))
+	case !ran:
+		s.logger.Debug("job skipped: another replica is the leader", zap.String("job", job.Name))
+	}
+}
+
+func runJob(ctx context.Context, job Job) (bool, error) {
+	if job.Locker == nil {
+		return true, job.Run(ctx)
+	}
+
+	return job.Locker.RunLocked(ctx, job.Name, job.Run)
+}
@@ -23,18 +23,30 @@ import (
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/clients"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
+	questionsv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/questions/v1"
 	usersv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/achievements"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/handler"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/service"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/users-service/internal/categories"
 
 	"github.com/DavidMovas/gopherbox/pkg/closer"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/consul"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/log"
 	"github.com/QuizWars-Ecosystem/users-service/internal/config"
+	"github.com/QuizWars-Ecosystem/users-service/internal/leaderboard"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/internal/notification"
+	"github.com/QuizWars-Ecosystem/users-service/internal/party"
+	"github.com/QuizWars-Ecosystem/users-service/internal/presence"
+	"github.com/QuizWars-Ecosystem/users-service/internal/scheduler"
+	"github.com/QuizWars-Ecosystem/users-service/internal/shop"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -49,6 +61,7 @@ type Server struct {
 	consul       *consul.Consul
 	logger       *log.Logger
 	manager      *manager.Manager[config.Config]
+	scheduler    *scheduler.Scheduler
 	closer       *closer.Closer
 }
 
@@ -59,6 +72,11 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 	logger := log.NewLogger(cfg.Local, cfg.Logger.Level)
 	cl.PushIO(logger)
 
+	if err := cfg.Validate(); err != nil {
+		logger.Zap().Error("invalid config", zap.Error(err))
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	manager.Subscribe(logger.SectionKey(), func(cfg *config.Config) error { return logger.UpdateConfig(cfg.Logger) })
 
 	consulManager, err := consul.NewConsul(cfg.ConsulURL, cfg.Name, cfg.Address, cfg.GRPCPort, logger)
@@ -91,16 +109,94 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 	jwtService := jwt.NewService(cfg.JWT)
 	manager.Subscribe(jwtService.SectionKey(), func(cfg *config.Config) error { return jwtService.UpdateConfig(cfg.JWT) })
 
+	var (
+		tracker presence.Tracker    = presence.NewMemoryTracker(presenceTTL(cfg.Presence))
+		parties party.Registry      = party.NewMemoryRegistry(partyTTL(cfg.Parties))
+		broker  notification.Broker = notification.NewMemoryBroker()
+		board   leaderboard.Board
+	)
+
+	if cfg.Redis.URL != "" {
+		redisOpts, err := redis.ParseURL(cfg.Redis.URL)
+		if err != nil {
+			logger.Zap().Error("error parsing redis url", zap.Error(err))
+			return nil, fmt.Errorf("error parsing redis url: %w", err)
+		}
+
+		redisClient := redis.NewClient(redisOpts)
+		if err = redisClient.Ping(ctx).Err(); err != nil {
+			logger.Zap().Error("error initializing redis client", zap.Error(err))
+			return nil, fmt.Errorf("error initializing redis client: %w", err)
+		}
+
+		cl.PushIO(redisClient)
+
+		tracker = presence.NewRedisTracker(redisClient, presenceTTL(cfg.Presence), logger.Zap())
+		parties = party.NewRedisRegistry(redisClient, partyTTL(cfg.Parties), logger.Zap())
+		board = leaderboard.NewRedisBoard(redisClient)
+
+		redisBroker, err := notification.NewRedisBroker(ctx, redisClient, logger.Zap())
+		if err != nil {
+			logger.Zap().Error("error initializing notification broker", zap.Error(err))
+			return nil, fmt.Errorf("error initializing notification broker: %w", err)
+		}
+
+		cl.PushIO(redisBroker)
+
+		broker = redisBroker
+	} else {
+		logger.Zap().Warn("redis url not provided, presence, parties and notification streams are kept in memory and leaderboards are read from postgres")
+	}
+
+	engine, err := achievements.NewEngine(cfg.Achievements)
+	if err != nil {
+		logger.Zap().Error("error loading achievements catalogue", zap.Error(err))
+		return nil, fmt.Errorf("error loading achievements catalogue: %w", err)
+	}
+
+	manager.Subscribe(engine.SectionKey(), func(cfg *config.Config) error { return engine.UpdateConfig(cfg.Achievements) })
+
+	catalogue, err := shop.NewShop(cfg.Shop)
+	if err != nil {
+		logger.Zap().Error("error loading shop catalogue", zap.Error(err))
+		return nil, fmt.Errorf("error loading shop catalogue: %w", err)
+	}
+
+	manager.Subscribe(catalogue.SectionKey(), func(cfg *config.Config) error { return catalogue.UpdateConfig(cfg.Shop) })
+
+	questions, err := dialQuestions(cfg.Questions, grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithTracerProvider(provider))))
+	if err != nil {
+		logger.Zap().Error("error initializing questions client", zap.Error(err))
+		return nil, fmt.Errorf("error initializing questions client: %w", err)
+	}
+
+	if questions == nil {
+		logger.Zap().Warn("questions url not provided, category names are not resolved")
+	} else {
+		cl.PushIO(questions)
+	}
+
+	directory := newDirectory(cfg.Questions, questions, logger.Zap())
+
 	storage := store.NewStore(db, logger.Zap())
-	srv := service.NewService(storage, logger.Zap())
+	cl.PushCtx(storage.Close)
+
+	srv := service.NewService(storage, tracker, board, parties, broker, engine, catalogue, directory, cfg, logger.Zap())
 	hand := handler.NewHandler(srv, jwtService, logger.Zap())
 
+	sched := newScheduler(cfg, srv, storage, board != nil, logger.Zap())
+	cl.PushCtx(sched.Stop)
+
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			grpcrecovery.UnaryServerInterceptor(),
 			grpccommon.ServerMetricsInterceptor(),
 			grpcprometheus.UnaryServerInterceptor,
 		),
+		grpc.ChainStreamInterceptor(
+			grpcrecovery.StreamServerInterceptor(),
+			grpcprometheus.StreamServerInterceptor,
+		),
 		grpc.StatsHandler(
 			otelgrpc.NewServerHandler(
 				otelgrpc.WithTracerProvider(provider),
@@ -118,6 +214,8 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 	usersv1.RegisterUsersAuthServiceServer(grpcServer, hand)
 	usersv1.RegisterUsersProfileServiceServer(grpcServer, hand)
 	usersv1.RegisterUsersSocialServiceServer(grpcServer, hand)
+	usersv1.RegisterUsersLeaderboardServiceServer(grpcServer, hand)
+	usersv1.RegisterUsersClansServiceServer(grpcServer, hand)
 
 	metrics.Initialize()
 
@@ -138,10 +236,135 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 		consul:     consulManager,
 		logger:     logger,
 		manager:    manager,
+		scheduler:  sched,
 		closer:     cl,
 	}, nil
 }
 
+// newScheduler registers the periodic jobs. Every job runs under the storage lock so runs on different
+// replicas do not overlap, a run skipped because another replica holds the lock waits for the next tick.
+func newScheduler(cfg *config.Config, srv *service.Service, locker scheduler.Locker, withBoard bool, logger *zap.Logger) *scheduler.Scheduler {
+	sched := scheduler.NewScheduler(logger)
+
+	if cfg.Account != nil {
+		sched.Add(scheduler.Job{
+			Name:     "purge-deleted-profiles",
+			Interval: cfg.Account.PurgeInterval,
+			Run:      srv.PurgeDeletedProfiles,
+			Locker:   locker,
+		})
+	}
+
+	if cfg.Coins != nil {
+		sched.Add(scheduler.Job{
+			Name:     "check-coins-ledger",
+			Interval: cfg.Coins.ReconcileInterval,
+			Run:      srv.CheckCoinsLedger,
+			Locker:   locker,
+		})
+	}
+
+	sched.Add(scheduler.Job{
+		Name:     "rollover-seasons",
+		Interval: seasonRolloverInterval(cfg.Seasons),
+		Run:      srv.RolloverSeasons,
+		Locker:   locker,
+	})
+
+	if cfg.Friends != nil {
+		sched.Add(scheduler.Job{
+			Name:     "expire-friend-requests",
+			Interval: cfg.Friends.ExpiryInterval,
+			Run:      srv.ExpireFriendRequests,
+			Locker:   locker,
+		})
+	}
+
+	if cfg.Notifications != nil {
+		sched.Add(scheduler.Job{
+			Name:     "purge-notifications",
+			Interval: cfg.Notifications.PurgeInterval,
+			Run:      srv.PurgeNotifications,
+			Locker:   locker,
+		})
+	}
+
+	if withBoard {
+		sched.Add(scheduler.Job{
+			Name:       "rebuild-leaderboards",
+			Interval:   leaderboardRebuildInterval(cfg.Leaderboard),
+			Run:        srv.RebuildLeaderboards,
+			RunOnStart: true,
+			Locker:     locker,
+		})
+	}
+
+	return sched
+}
+
+// dialQuestions connects to the questions service, the connection is nil when its url is not configured.
+func dialQuestions(cfg *config.QuestionsConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if cfg == nil || cfg.URL == "" {
+		return nil, nil
+	}
+
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+	return grpc.NewClient(cfg.URL, opts...)
+}
+
+// presenceTTL returns how long a presence entry is kept without a heartbeat.
+func presenceTTL(cfg *config.PresenceConfig) time.Duration {
+	if cfg == nil || cfg.TTL <= 0 {
+		return presence.DefaultTTL
+	}
+
+	return cfg.TTL
+}
+
+// seasonRolloverInterval returns how often ended seasons are archived.
+func seasonRolloverInterval(cfg *config.SeasonsConfig) time.Duration {
+	if cfg == nil || cfg.RolloverInterval <= 0 {
+		return profile.DefaultSeasonRolloverInterval
+	}
+
+	return cfg.RolloverInterval
+}
+
+// leaderboardRebuildInterval returns how often the board is rebuilt.
+// The board is only ready after its first rebuild, so the job is never disabled.
+func leaderboardRebuildInterval(cfg *config.LeaderboardConfig) time.Duration {
+	if cfg == nil || cfg.RebuildInterval <= 0 {
+		return leaderboard.DefaultRebuildInterval
+	}
+
+	return cfg.RebuildInterval
+}
+
+// partyTTL returns how long an unchanged party is kept.
+func partyTTL(cfg *config.PartiesConfig) time.Duration {
+	if cfg == nil || cfg.TTL <= 0 {
+		return party.DefaultTTL
+	}
+
+	return cfg.TTL
+}
+
+// newDirectory resolves category names through the questions connection, durations that are
+// not configured fall back to the defaults of the directory.
+func newDirectory(cfg *config.QuestionsConfig, conn *grpc.ClientConn, logger *zap.Logger) *categories.Directory {
+	var client questionsv1.QuestionsClientServiceClient
+	if conn != nil {
+		client = questionsv1.NewQuestionsClientServiceClient(conn)
+	}
+
+	if cfg == nil {
+		return categories.NewDirectory(client, 0, 0, 0, logger)
+	}
+
+	return categories.NewDirectory(client, cfg.CacheTTL, cfg.RetryInterval, cfg.Timeout, logger)
+}
+
 func (s *Server) Start() error {
 	z := s.logger.Zap()
 
@@ -180,6 +403,8 @@ func (s *Server) Start() error {
 		return s.grpcServer.Serve(s.grpcListener)
 	})
 
+	s.scheduler.Start()
+
 	err := s.consul.RegisterService()
 	if err != nil {
 		z.Error("Failed to register service in consul registry", zap.String("name", cfg.Name), zap.Error(err))
@@ -13,10 +13,16 @@ import (
 	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/log"
 	usersv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/achievements"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/handler"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/service"
 	"github.com/QuizWars-Ecosystem/users-service/internal/apis/store"
 	"github.com/QuizWars-Ecosystem/users-service/internal/config"
+	"github.com/QuizWars-Ecosystem/users-service/internal/notification"
+	"github.com/QuizWars-Ecosystem/users-service/internal/party"
+	"github.com/QuizWars-Ecosystem/users-service/internal/presence"
+	"github.com/QuizWars-Ecosystem/users-service/internal/scheduler"
+	"github.com/QuizWars-Ecosystem/users-service/internal/shop"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -26,6 +32,7 @@ type TestServer struct {
 	listener   net.Listener
 	logger     *log.Logger
 	cfg        *config.Config
+	scheduler  *scheduler.Scheduler
 	closer     *closer.Closer
 }
 
@@ -34,6 +41,11 @@ func NewTestServer(ctx context.Context, cfg *config.Config) (*TestServer, error)
 
 	logger := log.NewLogger(cfg.Local, cfg.Logger.Level)
 
+	if err := cfg.Validate(); err != nil {
+		logger.Zap().Error("invalid config", zap.Error(err))
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	dbOpts := clients.NewPostgresOptions(cfg.Postgres.URL)
 	dbOpts.WithConnectTimeout(time.Second * 20)
 
@@ -44,25 +56,66 @@ func NewTestServer(ctx context.Context, cfg *config.Config) (*TestServer, error)
 	}
 
 	storage := store.NewStore(db, logger.Zap())
+	cl.PushCtx(storage.Close)
+
 	jwtService := jwt.NewService(cfg.JWT)
-	srv := service.NewService(storage, logger.Zap())
+	tracker := presence.NewMemoryTracker(presenceTTL(cfg.Presence))
+	parties := party.NewMemoryRegistry(partyTTL(cfg.Parties))
+	broker := notification.NewMemoryBroker()
+
+	engine, err := achievements.NewEngine(cfg.Achievements)
+	if err != nil {
+		logger.Zap().Error("error loading achievements catalogue", zap.Error(err))
+		return nil, fmt.Errorf("error loading achievements catalogue: %w", err)
+	}
+
+	catalogue, err := shop.NewShop(cfg.Shop)
+	if err != nil {
+		logger.Zap().Error("error loading shop catalogue", zap.Error(err))
+		return nil, fmt.Errorf("error loading shop catalogue: %w", err)
+	}
+
+	questions, err := dialQuestions(cfg.Questions)
+	if err != nil {
+		logger.Zap().Error("error initializing questions client", zap.Error(err))
+		return nil, fmt.Errorf("error initializing questions client: %w", err)
+	}
+
+	if questions != nil {
+		cl.PushIO(questions)
+	}
+
+	directory := newDirectory(cfg.Questions, questions, logger.Zap())
+
+	srv := service.NewService(storage, tracker, nil, parties, broker, engine, catalogue, directory, cfg, logger.Zap())
 	hand := handler.NewHandler(srv, jwtService, logger.Zap())
 
+	// The jobs are not started, tests run them on demand with RunJob.
+	sched := newScheduler(cfg, srv, storage, false, logger.Zap())
+
 	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor())
 
 	usersv1.RegisterUsersAdminServiceServer(grpcServer, hand)
 	usersv1.RegisterUsersAuthServiceServer(grpcServer, hand)
 	usersv1.RegisterUsersProfileServiceServer(grpcServer, hand)
 	usersv1.RegisterUsersSocialServiceServer(grpcServer, hand)
+	usersv1.RegisterUsersLeaderboardServiceServer(grpcServer, hand)
+	usersv1.RegisterUsersClansServiceServer(grpcServer, hand)
 
 	return &TestServer{
 		grpcServer: grpcServer,
 		logger:     logger,
 		cfg:        cfg,
+		scheduler:  sched,
 		closer:     cl,
 	}, nil
 }
 
+// RunJob runs the named scheduler job once and reports whether it ran.
+func (s *TestServer) RunJob(ctx context.Context, name string) (bool, error) {
+	return s.scheduler.RunNow(ctx, name)
+}
+
 func (s *TestServer) Start() error {
 	z := s.logger.Zap()
 
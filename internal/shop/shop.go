@@ -0,0 +1,73 @@
+package shop
+
+import (
+	"sync"
+
+	"github.com/QuizWars-Ecosystem/users-service/internal/config"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+)
+
+const sectionKey = "shop"
+
+// Shop holds the validated item catalogue of the coin shop.
+// The catalogue is replaced as a whole when the config section changes.
+type Shop struct {
+	mu        sync.RWMutex
+	catalogue *profile.ShopCatalogue
+}
+
+func NewShop(cfg *config.ShopConfig) (*Shop, error) {
+	var shop Shop
+
+	if err := shop.UpdateConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return &shop, nil
+}
+
+func (s *Shop) SectionKey() string {
+	return sectionKey
+}
+
+// UpdateConfig validates the catalogue and swaps it in, the current catalogue is kept when it is invalid.
+func (s *Shop) UpdateConfig(cfg *config.ShopConfig) error {
+	var entries []*config.ShopItemConfig
+	if cfg != nil {
+		entries = cfg.Items
+	}
+
+	items := make([]*profile.ShopItem, len(entries))
+	for i, entry := range entries {
+		items[i] = &profile.ShopItem{
+			Code:  entry.Code,
+			Kind:  profile.ShopItemKind(entry.Kind),
+			Title: entry.Title,
+			Price: entry.Price,
+		}
+
+		if items[i].Kind == profile.ItemAvatar {
+			avatarID := entry.AvatarID
+			items[i].AvatarID = &avatarID
+		}
+	}
+
+	catalogue, err := profile.NewShopCatalogue(items)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.catalogue = catalogue
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Catalogue returns the current catalogue.
+func (s *Shop) Catalogue() *profile.ShopCatalogue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.catalogue
+}
@@ -0,0 +1,19 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_at TIMESTAMP;
+
+-- Bans used to be stored as deletions, they are moved over so the purge job does not remove banned users.
+UPDATE users SET banned_at = deleted_at, deleted_at = NULL WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users(deleted_at) WHERE deleted_at IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_users_deleted_at;
+
+UPDATE users SET deleted_at = banned_at, banned_at = NULL WHERE banned_at IS NOT NULL;
+
+ALTER TABLE users DROP COLUMN IF EXISTS banned_at;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
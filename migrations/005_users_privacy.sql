@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+
+CREATE TYPE user_privacy AS ENUM ('public', 'friends', 'private');
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS privacy user_privacy NOT NULL DEFAULT 'public';
+
+---- create above / drop below ----
+
+ALTER TABLE users DROP COLUMN IF EXISTS privacy;
+
+DROP TYPE IF EXISTS user_privacy;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
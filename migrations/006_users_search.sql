@@ -0,0 +1,12 @@
+-- Write your migrate up statements here
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING gin (username gin_trgm_ops);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_users_username_trgm;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
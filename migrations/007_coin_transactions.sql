@@ -0,0 +1,31 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS coin_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL CHECK (amount <> 0),
+    reason VARCHAR(64) NOT NULL,
+    source VARCHAR(64) NOT NULL,
+    idempotency_key VARCHAR(128) NOT NULL,
+    balance_after BIGINT NOT NULL CHECK (balance_after >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (user_id, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coin_transactions_user_id ON coin_transactions (user_id, id DESC);
+
+INSERT INTO coin_transactions (user_id, amount, reason, source, idempotency_key, balance_after)
+SELECT user_id, coins, 'opening_balance', 'users-service', 'opening-balance', coins
+FROM stats
+WHERE coins > 0;
+
+ALTER TABLE stats ADD CONSTRAINT stats_coins_non_negative CHECK (coins >= 0);
+
+---- create above / drop below ----
+
+ALTER TABLE stats DROP CONSTRAINT IF EXISTS stats_coins_non_negative;
+
+DROP TABLE IF EXISTS coin_transactions;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,38 @@
+-- Write your migrate up statements here
+
+ALTER TABLE stats
+    ADD COLUMN IF NOT EXISTS rating_deviation REAL NOT NULL DEFAULT 350,
+    ADD COLUMN IF NOT EXISTS rating_volatility REAL NOT NULL DEFAULT 0.06;
+
+CREATE TABLE IF NOT EXISTS rated_matches (
+    match_id UUID PRIMARY KEY,
+    algorithm VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS rating_history (
+    match_id UUID NOT NULL REFERENCES rated_matches(match_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    placement SMALLINT NOT NULL,
+    rating_before SMALLINT NOT NULL,
+    rating_after SMALLINT NOT NULL,
+    deviation_before REAL NOT NULL,
+    deviation_after REAL NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (match_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rating_history_user_id ON rating_history (user_id, created_at DESC);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS rating_history;
+
+DROP TABLE IF EXISTS rated_matches;
+
+ALTER TABLE stats
+    DROP COLUMN IF EXISTS rating_volatility,
+    DROP COLUMN IF EXISTS rating_deviation;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
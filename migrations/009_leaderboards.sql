@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS country CHAR(2);
+
+CREATE INDEX IF NOT EXISTS idx_users_country ON users (country) WHERE country IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_stats_rating ON stats (rating DESC, user_id DESC);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_stats_rating;
+
+DROP INDEX IF EXISTS idx_users_country;
+
+ALTER TABLE users DROP COLUMN IF EXISTS country;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,35 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(64) NOT NULL UNIQUE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reset_base SMALLINT NOT NULL DEFAULT 0,
+    reset_factor REAL NOT NULL DEFAULT 0.5,
+    archived_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT seasons_period CHECK (ends_at > starts_at),
+    CONSTRAINT seasons_reset_factor CHECK (reset_factor BETWEEN 0 AND 1),
+    CONSTRAINT seasons_no_overlap EXCLUDE USING gist (tsrange(starts_at, ends_at) WITH &&)
+);
+
+CREATE TABLE IF NOT EXISTS season_results (
+    season_id INT NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL,
+    rank INT NOT NULL,
+    reset_rating SMALLINT NOT NULL,
+    PRIMARY KEY (season_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_season_results_user_id ON season_results (user_id, season_id DESC);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS season_results;
+
+DROP TABLE IF EXISTS seasons;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,23 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS user_achievements (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(64) NOT NULL,
+    awarded_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, code)
+);
+
+CREATE TABLE IF NOT EXISTS user_login_days (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    PRIMARY KEY (user_id, day)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS user_login_days;
+
+DROP TABLE IF EXISTS user_achievements;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,34 @@
+-- Write your migrate up statements here
+
+-- next_level_xp defaults to the requirement of the first level on the default curve,
+-- the stored progress follows the configured curve from the next grant of the user.
+ALTER TABLE stats
+    ADD COLUMN IF NOT EXISTS xp BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS level INT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS level_xp BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_level_xp BIGINT NOT NULL DEFAULT 100;
+
+CREATE TABLE IF NOT EXISTS xp_grants (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id VARCHAR(128) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    source VARCHAR(64) NOT NULL,
+    level_before INT NOT NULL,
+    level_after INT NOT NULL,
+    coins_rewarded BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, event_id)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS xp_grants;
+
+ALTER TABLE stats
+    DROP COLUMN IF EXISTS next_level_xp,
+    DROP COLUMN IF EXISTS level_xp,
+    DROP COLUMN IF EXISTS level,
+    DROP COLUMN IF EXISTS xp;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
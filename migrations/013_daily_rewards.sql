@@ -0,0 +1,35 @@
+-- Write your migrate up statements here
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+CREATE TABLE IF NOT EXISTS daily_rewards (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    streak INT NOT NULL DEFAULT 0,
+    streak_freezes INT NOT NULL DEFAULT 0 CHECK (streak_freezes >= 0),
+    last_claimed_on DATE,
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS daily_reward_claims (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    streak INT NOT NULL,
+    coins BIGINT NOT NULL,
+    streak_freezes_earned INT NOT NULL DEFAULT 0,
+    streak_freeze_used BOOLEAN NOT NULL DEFAULT FALSE,
+    claimed_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, day)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS daily_reward_claims;
+
+DROP TABLE IF EXISTS daily_rewards;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS time_zone;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,27 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS category_stats (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category_id INT NOT NULL,
+    answered BIGINT NOT NULL DEFAULT 0,
+    correct BIGINT NOT NULL DEFAULT 0,
+    answer_time_ms BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, category_id)
+);
+
+CREATE TABLE IF NOT EXISTS answer_batches (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    batch_id VARCHAR(128) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, batch_id)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS answer_batches;
+
+DROP TABLE IF EXISTS category_stats;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
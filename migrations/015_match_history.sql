@@ -0,0 +1,32 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS matches (
+    id UUID PRIMARY KEY,
+    mode VARCHAR(32) NOT NULL,
+    category_ids INT[] NOT NULL DEFAULT '{}',
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT matches_period CHECK (ended_at >= started_at)
+);
+
+CREATE TABLE IF NOT EXISTS match_participants (
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    score BIGINT NOT NULL DEFAULT 0,
+    placement SMALLINT NOT NULL,
+    rating_delta SMALLINT,
+    ended_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (match_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_participants_user_id ON match_participants (user_id, ended_at DESC, match_id DESC);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS match_participants;
+
+DROP TABLE IF EXISTS matches;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,28 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS user_inventory (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_code VARCHAR(64) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    acquired_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, item_code)
+);
+
+CREATE TABLE IF NOT EXISTS item_purchases (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    idempotency_key VARCHAR(128) NOT NULL,
+    item_code VARCHAR(64) NOT NULL,
+    price BIGINT NOT NULL CHECK (price >= 0),
+    balance_after BIGINT NOT NULL,
+    purchased_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, idempotency_key)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS item_purchases;
+
+DROP TABLE IF EXISTS user_inventory;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+
+ALTER TABLE friends
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS idx_friends_friend_id ON friends (friend_id, status);
+
+CREATE INDEX IF NOT EXISTS idx_friends_user_id ON friends (user_id, status);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_friends_user_id;
+
+DROP INDEX IF EXISTS idx_friends_friend_id;
+
+ALTER TABLE friends
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
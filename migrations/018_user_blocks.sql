@@ -0,0 +1,29 @@
+-- Write your migrate up statements here
+
+CREATE TABLE IF NOT EXISTS user_blocks (
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+-- Blocked friendships did not record who blocked whom, so they become blocks in both directions.
+INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+SELECT user_id, friend_id, updated_at FROM friends WHERE status = 'blocked'
+UNION
+SELECT friend_id, user_id, updated_at FROM friends WHERE status = 'blocked'
+ON CONFLICT DO NOTHING;
+
+DELETE FROM friends WHERE status = 'blocked';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_user_blocks_blocked_id;
+
+DROP TABLE IF EXISTS user_blocks;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
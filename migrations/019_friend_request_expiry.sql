@@ -0,0 +1,16 @@
+-- Write your migrate up statements here
+
+ALTER TABLE friends
+    ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS idx_friends_pending_created_at ON friends (created_at) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS idx_friends_pending_created_at;
+
+ALTER TABLE friends
+    DROP COLUMN IF EXISTS reminded_at;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,45 @@
+-- Write your migrate up statements here
+
+CREATE TYPE clan_role AS ENUM ('owner', 'officer', 'member');
+
+CREATE TABLE IF NOT EXISTS clans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tag VARCHAR(5) UNIQUE NOT NULL,
+    name VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- A user belongs to at most one clan, so the user is the key of the membership.
+CREATE TABLE IF NOT EXISTS clan_members (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    clan_id UUID NOT NULL REFERENCES clans(id) ON DELETE CASCADE,
+    role clan_role NOT NULL DEFAULT 'member',
+    joined_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_clan_members_clan_id ON clan_members (clan_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_clan_members_owner ON clan_members (clan_id) WHERE role = 'owner';
+
+-- Invites are sent by officers to users, requests are sent by users to the clan.
+CREATE TABLE IF NOT EXISTS clan_applications (
+    clan_id UUID NOT NULL REFERENCES clans(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('invite', 'request')),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (clan_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_clan_applications_user_id ON clan_applications (user_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS clan_applications;
+
+DROP TABLE IF EXISTS clan_members;
+
+DROP TABLE IF EXISTS clans;
+
+DROP TYPE IF EXISTS clan_role;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
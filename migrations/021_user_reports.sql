@@ -0,0 +1,40 @@
+-- Write your migrate up statements here
+
+CREATE TYPE report_category AS ENUM ('cheating', 'abusive_username', 'harassment', 'other');
+CREATE TYPE report_status AS ENUM ('open', 'claimed', 'resolved', 'dismissed');
+CREATE TYPE report_action AS ENUM ('none', 'ban', 'reset_username');
+
+CREATE TABLE IF NOT EXISTS user_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reported_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category report_category NOT NULL,
+    text VARCHAR(1000) NOT NULL DEFAULT '',
+    match_id UUID REFERENCES matches(id) ON DELETE SET NULL,
+    status report_status NOT NULL DEFAULT 'open',
+    moderator_id UUID,
+    action report_action,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    claimed_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    CONSTRAINT user_reports_not_self CHECK (reporter_id <> reported_id)
+);
+
+-- A reporter has at most one report per user and category waiting for a moderator.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_user_reports_pending ON user_reports (reporter_id, reported_id, category)
+    WHERE status IN ('open', 'claimed');
+
+CREATE INDEX IF NOT EXISTS idx_user_reports_reporter_id ON user_reports (reporter_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_user_reports_queue ON user_reports (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_user_reports_reported_id ON user_reports (reported_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS user_reports;
+
+DROP TYPE IF EXISTS report_action;
+DROP TYPE IF EXISTS report_status;
+DROP TYPE IF EXISTS report_category;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
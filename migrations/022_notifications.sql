@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+
+CREATE TYPE notification_kind AS ENUM (
+    'friend_request_received',
+    'friend_request_accepted',
+    'friend_removed',
+    'friend_request_reminder',
+    'party_invite',
+    'achievement_awarded',
+    'level_up'
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind notification_kind NOT NULL,
+    actor_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    party_id UUID,
+    achievement_code VARCHAR(64),
+    level INT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    read_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_notifications_created_at ON notifications (created_at);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS notifications;
+
+DROP TYPE IF EXISTS notification_kind;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
@@ -15,12 +16,16 @@ import (
 type TestConfig struct {
 	ServiceConfig *config.Config
 	Postgres      *test.PostgresConfig
+	// QuestionsPort is the port of the local questions service stub.
+	QuestionsPort int
 }
 
 func NewTestConfig() *TestConfig {
 	postgresCfg := test.DefaultPostgresConfig()
+	questionsPort := 50052
 
 	return &TestConfig{
+		QuestionsPort: questionsPort,
 		ServiceConfig: &config.Config{
 			ServiceConfig: &def.ServiceConfig{
 				Name:         "users-service",
@@ -41,6 +46,88 @@ func NewTestConfig() *TestConfig {
 			},
 			Postgres: &config.PostgresConfig{},
 			Redis:    &config.RedisConfig{},
+			Account: &config.AccountConfig{
+				DeletionGracePeriod: time.Hour * 24 * 30,
+				PurgeInterval:       time.Hour,
+				PurgeBatchSize:      100,
+			},
+			Presence: &config.PresenceConfig{
+				TTL:            time.Minute,
+				ResyncInterval: time.Second,
+			},
+			Coins: &config.CoinsConfig{
+				ReconcileInterval:  time.Hour,
+				ReconcileBatchSize: 100,
+			},
+			Rating: &config.RatingConfig{
+				Algorithm: "glicko2",
+				EloK:      32,
+				GlickoTau: 0.5,
+			},
+			Leaderboard: &config.LeaderboardConfig{
+				RebuildInterval: time.Hour,
+			},
+			Seasons: &config.SeasonsConfig{
+				RolloverInterval: time.Minute,
+			},
+			Achievements: &config.AchievementsConfig{
+				Catalogue: []*config.AchievementConfig{
+					{Code: "first_login", Title: "Welcome", Metric: "login_days", Threshold: 1},
+					{Code: "ten_day_streak", Title: "Regular", Metric: "login_streak", Threshold: 10},
+					{Code: "first_friend", Title: "Company", Metric: "friends", Threshold: 1},
+					{Code: "first_match", Title: "Rookie", Metric: "matches_played", Threshold: 1},
+					{Code: "first_win", Title: "Winner", Metric: "matches_won", Threshold: 1},
+					{Code: "hundred_wins", Title: "Champion", Metric: "matches_won", Threshold: 100},
+					{Code: "level_three", Title: "Apprentice", Metric: "level", Threshold: 3},
+				},
+			},
+			Levels: &config.LevelsConfig{
+				BaseXP:        100,
+				Growth:        1.5,
+				MaxLevel:      10,
+				CoinsPerLevel: 5,
+			},
+			DailyRewards: &config.DailyRewardsConfig{
+				Rewards: []*config.DailyRewardConfig{
+					{Coins: 5},
+					{Coins: 10},
+					{Coins: 15, StreakFreezes: 1},
+				},
+			},
+			Questions: &config.QuestionsConfig{
+				URL:           fmt.Sprintf("localhost:%d", questionsPort),
+				Timeout:       time.Second,
+				CacheTTL:      time.Hour,
+				RetryInterval: time.Millisecond,
+			},
+			Shop: &config.ShopConfig{
+				Items: []*config.ShopItemConfig{
+					{Code: "golden_avatar", Kind: "avatar", Title: "Golden avatar", Price: 50, AvatarID: 100},
+					{Code: "golden_frame", Kind: "avatar_frame", Title: "Golden frame", Price: 30},
+					{Code: "champion", Kind: "title", Title: "Champion", Price: 1000},
+					{Code: "streak_freeze", Kind: "streak_freeze", Title: "Streak freeze", Price: 5},
+				},
+			},
+			Friends: &config.FriendsConfig{
+				MaxFriends:         2,
+				MaxPendingRequests: 2,
+			},
+			Clans: &config.ClansConfig{
+				MaxMembers:   3,
+				BlockedWords: []string{"bad", "h4ck"},
+			},
+			Parties: &config.PartiesConfig{
+				TTL:     time.Minute,
+				MaxSize: 4,
+			},
+			Reports: &config.ReportsConfig{
+				MaxPerWindow: 3,
+				Window:       time.Hour,
+			},
+			Notifications: &config.NotificationsConfig{
+				PurgeInterval:  time.Hour,
+				PurgeBatchSize: 1,
+			},
 		},
 		Postgres: &postgresCfg,
 	}
@@ -2,12 +2,16 @@ package modules
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	jw "github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
 	testerror "github.com/QuizWars-Ecosystem/go-common/pkg/testing/errors"
 	usersv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
 	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
 	"github.com/stretchr/testify/require"
 )
@@ -247,7 +251,8 @@ func AdminServiceTest(t *testing.T, client usersv1.UsersAdminServiceClient, _ *c
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, martin.Id, res.Id)
-		require.True(t, res.DeletedAt.IsValid())
+		require.True(t, res.BannedAt.IsValid())
+		require.False(t, res.DeletedAt.IsValid())
 	})
 
 	t.Run("admin.UnbanUser: access token not provided", func(t *testing.T) {
@@ -305,7 +310,7 @@ func AdminServiceTest(t *testing.T, client usersv1.UsersAdminServiceClient, _ *c
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, martin.Id, res.Id)
-		require.False(t, res.DeletedAt.IsValid())
+		require.False(t, res.BannedAt.IsValid())
 	})
 
 	t.Run("admin.SearchUsers: access token not provided", func(t *testing.T) {
@@ -443,4 +448,330 @@ func AdminServiceTest(t *testing.T, client usersv1.UsersAdminServiceClient, _ *c
 			require.True(t, u.Coins >= 0 && u.Coins <= 100)
 		}
 	})
+
+	t.Run("admin.ExportUserData: permission denied", func(t *testing.T) {
+		stream, err := client.ExportUserData(johnCtx, &usersv1.ExportUserDataRequest{
+			UserId: martin.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("admin.ExportUserData: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		stream, err := client.ExportUserData(johnAdminCtx, &usersv1.ExportUserDataRequest{
+			UserId: testID,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("admin.ExportUserData: deleted user: successful", func(t *testing.T) {
+		stream, err := client.ExportUserData(johnAdminCtx, &usersv1.ExportUserDataRequest{
+			UserId: lukas.Id,
+			Format: usersv1.ExportFormat_EXPORT_FORMAT_JSON,
+		})
+		require.NoError(t, err)
+
+		header, data := receiveExport(t, stream)
+
+		require.Equal(t, "application/json", header.GetContentType())
+		require.Contains(t, string(data), lukas.Id)
+	})
+
+	t.Run("admin.ReconcileCoins: permission denied", func(t *testing.T) {
+		_, err := client.ReconcileCoins(johnCtx, &usersv1.ReconcileCoinsRequest{})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("admin.ReconcileCoins: successful", func(t *testing.T) {
+		res, err := client.ReconcileCoins(johnAdminCtx, &usersv1.ReconcileCoinsRequest{})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Mismatches)
+	})
+
+	seasonStart := time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+	seasonEnd := seasonStart.AddDate(0, 3, 0)
+
+	var season *usersv1.Season
+
+	t.Run("admin.CreateSeason: permission denied", func(t *testing.T) {
+		_, err := client.CreateSeason(johnCtx, &usersv1.CreateSeasonRequest{
+			Name:        "Season 1",
+			StartsAt:    timestamppb.New(seasonStart),
+			EndsAt:      timestamppb.New(seasonEnd),
+			ResetFactor: 0.5,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("admin.CreateSeason: ends before start", func(t *testing.T) {
+		_, err := client.CreateSeason(johnAdminCtx, &usersv1.CreateSeasonRequest{
+			Name:        "Season 1",
+			StartsAt:    timestamppb.New(seasonEnd),
+			EndsAt:      timestamppb.New(seasonStart),
+			ResetFactor: 0.5,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("admin.CreateSeason: invalid reset factor", func(t *testing.T) {
+		_, err := client.CreateSeason(johnAdminCtx, &usersv1.CreateSeasonRequest{
+			Name:        "Season 1",
+			StartsAt:    timestamppb.New(seasonStart),
+			EndsAt:      timestamppb.New(seasonEnd),
+			ResetFactor: 1.5,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("admin.CreateSeason: successful", func(t *testing.T) {
+		res, err := client.CreateSeason(johnAdminCtx, &usersv1.CreateSeasonRequest{
+			Name:        "Season 1",
+			StartsAt:    timestamppb.New(seasonStart),
+			EndsAt:      timestamppb.New(seasonEnd),
+			ResetFactor: 0.5,
+		})
+
+		require.NoError(t, err)
+		require.NotZero(t, res.Id)
+		require.Equal(t, "Season 1", res.Name)
+		require.True(t, seasonStart.Equal(res.StartsAt.AsTime()))
+		require.True(t, seasonEnd.Equal(res.EndsAt.AsTime()))
+		require.Nil(t, res.ArchivedAt)
+
+		season = res
+	})
+
+	t.Run("admin.CreateSeason: name already taken", func(t *testing.T) {
+		_, err := client.CreateSeason(johnAdminCtx, &usersv1.CreateSeasonRequest{
+			Name:        "Season 1",
+			StartsAt:    timestamppb.New(seasonEnd),
+			EndsAt:      timestamppb.New(seasonEnd.AddDate(0, 3, 0)),
+			ResetFactor: 0.5,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "season", "name", "Season 1")
+	})
+
+	t.Run("admin.CreateSeason: overlapping season", func(t *testing.T) {
+		_, err := client.CreateSeason(johnAdminCtx, &usersv1.CreateSeasonRequest{
+			Name:        "Season 2",
+			StartsAt:    timestamppb.New(seasonEnd.AddDate(0, -1, 0)),
+			EndsAt:      timestamppb.New(seasonEnd.AddDate(0, 2, 0)),
+			ResetFactor: 0.5,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("admin.UpdateSeason: not found", func(t *testing.T) {
+		name := "Season 2"
+		_, err := client.UpdateSeason(johnAdminCtx, &usersv1.UpdateSeasonRequest{
+			SeasonId: season.Id + 1000,
+			Name:     &name,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "season", "id", season.Id+1000)
+	})
+
+	t.Run("admin.UpdateSeason: successful", func(t *testing.T) {
+		name := "Season 1: Origins"
+		factor := 0.75
+		res, err := client.UpdateSeason(johnAdminCtx, &usersv1.UpdateSeasonRequest{
+			SeasonId:    season.Id,
+			Name:        &name,
+			ResetFactor: &factor,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, name, res.Name)
+		require.Equal(t, factor, res.ResetFactor)
+		require.True(t, seasonStart.Equal(res.StartsAt.AsTime()))
+
+		season = res
+	})
+
+	t.Run("admin.ListSeasons: permission denied", func(t *testing.T) {
+		_, err := client.ListSeasons(johnCtx, &emptypb.Empty{})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("admin.ListSeasons: successful", func(t *testing.T) {
+		res, err := client.ListSeasons(johnAdminCtx, &emptypb.Empty{})
+
+		require.NoError(t, err)
+		require.Len(t, res.Seasons, 1)
+		require.Equal(t, season.Id, res.Seasons[0].Id)
+		require.Equal(t, season.Name, res.Seasons[0].Name)
+	})
+
+	var reports []*usersv1.UserReport
+
+	t.Run("admin.ListReports: permission denied", func(t *testing.T) {
+		_, err := client.ListReports(johnCtx, &usersv1.ListReportsRequest{
+			Page: page,
+			Size: size,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("admin.ListReports: open reports: successful", func(t *testing.T) {
+		status := usersv1.ReportStatus_REPORT_STATUS_OPEN
+		res, err := client.ListReports(johnAdminCtx, &usersv1.ListReportsRequest{
+			Page:       page,
+			Size:       size,
+			Status:     &status,
+			ReportedId: &masha.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), res.Amount)
+		require.Len(t, res.Reports, 3)
+		require.Equal(t, usersv1.ReportCategory_REPORT_CATEGORY_CHEATING, res.Reports[0].Category)
+		require.Equal(t, "answers every question in under a second", res.Reports[0].Text)
+		require.Equal(t, sonia.Id, res.Reports[0].ReporterId)
+		require.Nil(t, res.Reports[0].ModeratorId)
+
+		reports = res.Reports
+	})
+
+	t.Run("admin.ClaimReport: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.ClaimReport(johnAdminCtx, &usersv1.ClaimReportRequest{
+			ReportId: testID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "report", "id", testID)
+	})
+
+	t.Run("admin.ClaimReport: successful", func(t *testing.T) {
+		res, err := client.ClaimReport(johnAdminCtx, &usersv1.ClaimReportRequest{
+			ReportId: reports[0].Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, usersv1.ReportStatus_REPORT_STATUS_CLAIMED, res.Status)
+		require.Equal(t, john.Id, res.GetModeratorId())
+		require.True(t, res.ClaimedAt.IsValid())
+	})
+
+	t.Run("admin.ClaimReport: claimed by another moderator", func(t *testing.T) {
+		_, err := client.ClaimReport(superCtx, &usersv1.ClaimReportRequest{
+			ReportId: reports[0].Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, report.ErrReportClaimed)
+	})
+
+	t.Run("admin.ResolveReport: action not specified", func(t *testing.T) {
+		_, err := client.ResolveReport(johnAdminCtx, &usersv1.ResolveReportRequest{
+			ReportId: reports[0].Id,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("admin.ResolveReport: ban: successful", func(t *testing.T) {
+		res, err := client.ResolveReport(johnAdminCtx, &usersv1.ResolveReportRequest{
+			ReportId: reports[0].Id,
+			Action:   usersv1.ReportAction_REPORT_ACTION_BAN,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, usersv1.ReportStatus_REPORT_STATUS_RESOLVED, res.Status)
+		require.Equal(t, usersv1.ReportAction_REPORT_ACTION_BAN, res.Action)
+		require.True(t, res.ClosedAt.IsValid())
+
+		user, err := client.GetUserByIdentifier(johnAdminCtx, &usersv1.GetUserByIdentifierRequest{
+			Identifier: &usersv1.GetUserByIdentifierRequest_UserId{
+				UserId: masha.Id,
+			},
+		})
+
+		require.NoError(t, err)
+		require.True(t, user.BannedAt.IsValid())
+
+		_, err = client.UnbanUser(johnAdminCtx, &usersv1.UnbanUserRequest{
+			UserId: masha.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("admin.ResolveReport: already closed", func(t *testing.T) {
+		_, err := client.ResolveReport(johnAdminCtx, &usersv1.ResolveReportRequest{
+			ReportId: reports[0].Id,
+			Action:   usersv1.ReportAction_REPORT_ACTION_NONE,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, report.ErrReportClosed)
+	})
+
+	t.Run("admin.ResolveReport: reset username: successful", func(t *testing.T) {
+		res, err := client.ResolveReport(johnAdminCtx, &usersv1.ResolveReportRequest{
+			ReportId: reports[1].Id,
+			Action:   usersv1.ReportAction_REPORT_ACTION_RESET_USERNAME,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, usersv1.ReportStatus_REPORT_STATUS_RESOLVED, res.Status)
+		require.Equal(t, john.Id, res.GetModeratorId())
+
+		user, err := client.GetUserByIdentifier(johnAdminCtx, &usersv1.GetUserByIdentifierRequest{
+			Identifier: &usersv1.GetUserByIdentifierRequest_UserId{
+				UserId: masha.Id,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, report.ResetUsername(uuid.MustParse(masha.Id)), user.Username)
+	})
+
+	t.Run("admin.DismissReport: successful", func(t *testing.T) {
+		res, err := client.DismissReport(johnAdminCtx, &usersv1.DismissReportRequest{
+			ReportId: reports[2].Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, usersv1.ReportStatus_REPORT_STATUS_DISMISSED, res.Status)
+		require.Equal(t, usersv1.ReportAction_REPORT_ACTION_UNSPECIFIED, res.Action)
+	})
+
+	t.Run("admin.ListReports: queue is empty", func(t *testing.T) {
+		status := usersv1.ReportStatus_REPORT_STATUS_OPEN
+		res, err := client.ListReports(johnAdminCtx, &usersv1.ListReportsRequest{
+			Page:   page,
+			Size:   size,
+			Status: &status,
+		})
+
+		require.NoError(t, err)
+		require.Zero(t, res.Amount)
+		require.Empty(t, res.Reports)
+	})
 }
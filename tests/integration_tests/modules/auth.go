@@ -301,6 +301,65 @@ func AuthServiceTest(t *testing.T, client userspb.UsersAuthServiceClient, cfg *c
 	johnAdminCtx = jwt.SetTokenInContext(ctx, johnAdminToken)
 }
 
+func AuthRestoreServiceTest(t *testing.T, client userspb.UsersAuthServiceClient, _ *config.TestConfig) {
+	ctx := t.Context()
+
+	t.Run("auth.Login: deleted: wrong credentials", func(t *testing.T) {
+		_, err := client.Login(ctx, &userspb.LoginRequest{
+			Identifier: &userspb.LoginRequest_Email{
+				Email: martin.Email,
+			},
+			Password: "wrong_password",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("auth.Login: deleted: restored in grace period", func(t *testing.T) {
+		res, err := client.Login(ctx, &userspb.LoginRequest{
+			Identifier: &userspb.LoginRequest_Email{
+				Email: martin.Email,
+			},
+			Password: martinPassword,
+		})
+
+		require.NoError(t, err)
+
+		profile := res.GetProfile()
+
+		require.NotEqual(t, "", res.GetToken())
+		require.Equal(t, martin.Id, profile.GetId())
+		require.Equal(t, martin.Username, profile.GetUsername())
+
+		martinToken = res.GetToken()
+		martinCtx = jwt.SetTokenInContext(ctx, martinToken)
+	})
+
+	t.Run("auth.Logout: restored: successful", func(t *testing.T) {
+		_, err := client.Logout(ctx, &userspb.LogoutRequest{
+			UserId: martin.Id,
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+// registerUser registers a user that no other test relies on and returns it with its authorized context.
+func registerUser(t *testing.T, client userspb.UsersAuthServiceClient, username string) (*userspb.Profile, context.Context) {
+	t.Helper()
+
+	res, err := client.Register(t.Context(), &userspb.RegisterRequest{
+		AvatarId: 1,
+		Username: username,
+		Email:    username + "@mail.com",
+		Password: "pass123PASS!",
+	})
+
+	require.NoError(t, err)
+
+	return res.GetProfile(), jwt.SetTokenInContext(t.Context(), res.GetToken())
+}
+
 var (
 	john = &userspb.Profile{
 		AvatarId: 1,
@@ -0,0 +1,641 @@
+package modules
+
+import (
+	"context"
+	"testing"
+
+	jw "github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
+	testerror "github.com/QuizWars-Ecosystem/go-common/pkg/testing/errors"
+	"github.com/stretchr/testify/require"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/clan"
+	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
+)
+
+func ClansServiceTest(t *testing.T, client userspb.UsersClansServiceClient, _ *config.TestConfig) {
+	var clanID string
+
+	t.Run("clans.CreateClan: token not provided", func(t *testing.T) {
+		_, err := client.CreateClan(emptyCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "QZW",
+			Name:   "Quiz Wars",
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("clans.CreateClan: permission denied", func(t *testing.T) {
+		_, err := client.CreateClan(johnCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "QZW",
+			Name:   "Quiz Wars",
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("clans.CreateClan: invalid tag", func(t *testing.T) {
+		res, err := client.CreateClan(martinCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "Q!",
+			Name:   "Quiz Wars",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("clans.CreateClan: blocked word", func(t *testing.T) {
+		res, err := client.CreateClan(martinCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "B4D",
+			Name:   "Quiz Wars",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("clans.CreateClan: blocked word written with digits", func(t *testing.T) {
+		res, err := client.CreateClan(martinCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "QZW",
+			Name:   "Hack Squad",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("clans.CreateClan: successful", func(t *testing.T) {
+		res, err := client.CreateClan(martinCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "qzw",
+			Name:   "Quiz Wars",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "QZW", res.Tag)
+		require.Equal(t, "Quiz Wars", res.Name)
+		require.Equal(t, martin.Id, res.OwnerId)
+		require.Equal(t, uint64(1), res.MembersCount)
+
+		clanID = res.Id
+	})
+
+	t.Run("clans.CreateClan: tag already taken", func(t *testing.T) {
+		_, err := client.CreateClan(johnCtx, &userspb.CreateClanRequest{
+			UserId: john.Id,
+			Tag:    "QZW",
+			Name:   "Other clan",
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "clan", "tag", "QZW")
+	})
+
+	t.Run("clans.CreateClan: already in a clan", func(t *testing.T) {
+		_, err := client.CreateClan(martinCtx, &userspb.CreateClanRequest{
+			UserId: martin.Id,
+			Tag:    "MRT",
+			Name:   "Second clan",
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "clan member", "user_id", martin.Id)
+	})
+
+	t.Run("clans.JoinClan: join request sent", func(t *testing.T) {
+		res, err := client.JoinClan(johnCtx, &userspb.JoinClanRequest{
+			UserId: john.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.False(t, res.Joined)
+	})
+
+	t.Run("clans.JoinClan: repeated request", func(t *testing.T) {
+		_, err := client.JoinClan(johnCtx, &userspb.JoinClanRequest{
+			UserId: john.Id,
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "clan join request", "user_id", john.Id)
+	})
+
+	t.Run("clans.ListClanJoinRequests: not an officer", func(t *testing.T) {
+		_, err := client.ListClanJoinRequests(soniaCtx, &userspb.ListClanJoinRequestsRequest{
+			UserId: sonia.Id,
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrNotOfficer)
+	})
+
+	t.Run("clans.ListClanJoinRequests: successful", func(t *testing.T) {
+		res, err := client.ListClanJoinRequests(martinCtx, &userspb.ListClanJoinRequestsRequest{
+			UserId: martin.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Requests, 1)
+		require.Equal(t, john.Id, res.Requests[0].User.Id)
+	})
+
+	t.Run("clans.CancelClanJoinRequest: successful", func(t *testing.T) {
+		_, err := client.CancelClanJoinRequest(johnCtx, &userspb.CancelClanJoinRequestRequest{
+			UserId: john.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListClanJoinRequests(martinCtx, &userspb.ListClanJoinRequestsRequest{
+			UserId: martin.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Requests)
+	})
+
+	t.Run("clans.CancelClanJoinRequest: not found", func(t *testing.T) {
+		_, err := client.CancelClanJoinRequest(johnCtx, &userspb.CancelClanJoinRequestRequest{
+			UserId: john.Id,
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "clan join request", "user_id", john.Id)
+	})
+
+	t.Run("clans.DeclineClanJoinRequest: not an officer", func(t *testing.T) {
+		_, err := client.JoinClan(lukasCtx, &userspb.JoinClanRequest{
+			UserId: lukas.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.DeclineClanJoinRequest(soniaCtx, &userspb.DeclineClanJoinRequestRequest{
+			UserId:      sonia.Id,
+			ClanId:      clanID,
+			RequesterId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrNotOfficer)
+	})
+
+	t.Run("clans.DeclineClanJoinRequest: successful", func(t *testing.T) {
+		_, err := client.DeclineClanJoinRequest(martinCtx, &userspb.DeclineClanJoinRequestRequest{
+			UserId:      martin.Id,
+			ClanId:      clanID,
+			RequesterId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.DeclineClanJoinRequest: not found", func(t *testing.T) {
+		_, err := client.DeclineClanJoinRequest(martinCtx, &userspb.DeclineClanJoinRequestRequest{
+			UserId:      martin.Id,
+			ClanId:      clanID,
+			RequesterId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "clan join request", "user_id", lukas.Id)
+	})
+
+	t.Run("clans.JoinClan: join request sent again", func(t *testing.T) {
+		res, err := client.JoinClan(johnCtx, &userspb.JoinClanRequest{
+			UserId: john.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.False(t, res.Joined)
+	})
+
+	t.Run("clans.AcceptClanJoinRequest: successful", func(t *testing.T) {
+		_, err := client.AcceptClanJoinRequest(martinCtx, &userspb.AcceptClanJoinRequestRequest{
+			UserId:      martin.Id,
+			ClanId:      clanID,
+			RequesterId: john.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.AcceptClanJoinRequest: not found", func(t *testing.T) {
+		_, err := client.AcceptClanJoinRequest(martinCtx, &userspb.AcceptClanJoinRequestRequest{
+			UserId:      martin.Id,
+			ClanId:      clanID,
+			RequesterId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "clan join request", "user_id", john.Id)
+	})
+
+	t.Run("clans.InviteToClan: not an officer", func(t *testing.T) {
+		_, err := client.InviteToClan(johnCtx, &userspb.InviteToClanRequest{
+			UserId:    john.Id,
+			ClanId:    clanID,
+			InviteeId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrNotOfficer)
+	})
+
+	t.Run("clans.InviteToClan: successful", func(t *testing.T) {
+		_, err := client.InviteToClan(martinCtx, &userspb.InviteToClanRequest{
+			UserId:    martin.Id,
+			ClanId:    clanID,
+			InviteeId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.JoinClan(soniaCtx, &userspb.JoinClanRequest{
+			UserId: sonia.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.True(t, res.Joined)
+	})
+
+	t.Run("clans.CancelClanInvite: not an officer", func(t *testing.T) {
+		_, err := client.InviteToClan(martinCtx, &userspb.InviteToClanRequest{
+			UserId:    martin.Id,
+			ClanId:    clanID,
+			InviteeId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.CancelClanInvite(soniaCtx, &userspb.CancelClanInviteRequest{
+			UserId:    sonia.Id,
+			ClanId:    clanID,
+			InviteeId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrNotOfficer)
+	})
+
+	t.Run("clans.CancelClanInvite: successful", func(t *testing.T) {
+		_, err := client.CancelClanInvite(martinCtx, &userspb.CancelClanInviteRequest{
+			UserId:    martin.Id,
+			ClanId:    clanID,
+			InviteeId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.DeclineClanInvite: not found", func(t *testing.T) {
+		_, err := client.DeclineClanInvite(lukasCtx, &userspb.DeclineClanInviteRequest{
+			UserId: lukas.Id,
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "clan invite", "user_id", lukas.Id)
+	})
+
+	t.Run("clans.DeclineClanInvite: successful", func(t *testing.T) {
+		_, err := client.InviteToClan(martinCtx, &userspb.InviteToClanRequest{
+			UserId:    martin.Id,
+			ClanId:    clanID,
+			InviteeId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.DeclineClanInvite(lukasCtx, &userspb.DeclineClanInviteRequest{
+			UserId: lukas.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.JoinClan(lukasCtx, &userspb.JoinClanRequest{
+			UserId: lukas.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.False(t, res.Joined)
+
+		_, err = client.CancelClanJoinRequest(lukasCtx, &userspb.CancelClanJoinRequestRequest{
+			UserId: lukas.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.JoinClan: clan is full", func(t *testing.T) {
+		_, err := client.InviteToClan(martinCtx, &userspb.InviteToClanRequest{
+			UserId:    martin.Id,
+			ClanId:    clanID,
+			InviteeId: masha.Id,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.JoinClan(mashaCtx, &userspb.JoinClanRequest{
+			UserId: masha.Id,
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrClanFull)
+	})
+
+	t.Run("clans.KickClanMember: not an officer", func(t *testing.T) {
+		_, err := client.KickClanMember(johnCtx, &userspb.KickClanMemberRequest{
+			UserId:   john.Id,
+			ClanId:   clanID,
+			MemberId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrNotOfficer)
+	})
+
+	t.Run("clans.SetClanMemberRole: not the owner", func(t *testing.T) {
+		_, err := client.SetClanMemberRole(johnCtx, &userspb.SetClanMemberRoleRequest{
+			UserId:   john.Id,
+			ClanId:   clanID,
+			MemberId: sonia.Id,
+			Role:     userspb.ClanRole_CLAN_ROLE_OFFICER,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrNotOwner)
+	})
+
+	t.Run("clans.SetClanMemberRole: invalid role", func(t *testing.T) {
+		_, err := client.SetClanMemberRole(martinCtx, &userspb.SetClanMemberRoleRequest{
+			UserId:   martin.Id,
+			ClanId:   clanID,
+			MemberId: john.Id,
+			Role:     userspb.ClanRole_CLAN_ROLE_OWNER,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("clans.SetClanMemberRole: successful", func(t *testing.T) {
+		_, err := client.SetClanMemberRole(martinCtx, &userspb.SetClanMemberRoleRequest{
+			UserId:   martin.Id,
+			ClanId:   clanID,
+			MemberId: john.Id,
+			Role:     userspb.ClanRole_CLAN_ROLE_OFFICER,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.KickClanMember: outranked", func(t *testing.T) {
+		_, err := client.KickClanMember(johnCtx, &userspb.KickClanMemberRequest{
+			UserId:   john.Id,
+			ClanId:   clanID,
+			MemberId: martin.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrOutranked)
+	})
+
+	t.Run("clans.KickClanMember: successful", func(t *testing.T) {
+		_, err := client.KickClanMember(johnCtx, &userspb.KickClanMemberRequest{
+			UserId:   john.Id,
+			ClanId:   clanID,
+			MemberId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.JoinClan: invited: successful", func(t *testing.T) {
+		res, err := client.JoinClan(mashaCtx, &userspb.JoinClanRequest{
+			UserId: masha.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.True(t, res.Joined)
+	})
+
+	t.Run("clans.GetClan: successful", func(t *testing.T) {
+		res, err := client.GetClan(soniaCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), res.Clan.MembersCount)
+		require.Len(t, res.Members, 3)
+		require.Equal(t, martin.Id, res.Members[0].User.Id)
+		require.Equal(t, userspb.ClanRole_CLAN_ROLE_OWNER, res.Members[0].Role)
+		require.Equal(t, john.Id, res.Members[1].User.Id)
+		require.Equal(t, userspb.ClanRole_CLAN_ROLE_OFFICER, res.Members[1].Role)
+
+		var sum int32
+		for _, member := range res.Members {
+			sum += member.User.Rating
+		}
+
+		require.InDelta(t, float64(sum)/3, float64(res.Clan.Rating), 1)
+	})
+
+	t.Run("clans.LeaveClan: owner must transfer", func(t *testing.T) {
+		_, err := client.LeaveClan(martinCtx, &userspb.LeaveClanRequest{
+			UserId: martin.Id,
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, clan.ErrOwnerMustTransfer)
+	})
+
+	t.Run("clans.TransferClanOwnership: successful", func(t *testing.T) {
+		_, err := client.TransferClanOwnership(martinCtx, &userspb.TransferClanOwnershipRequest{
+			UserId:     martin.Id,
+			ClanId:     clanID,
+			NewOwnerId: john.Id,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.GetClan(martinCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, john.Id, res.Clan.OwnerId)
+	})
+
+	t.Run("clans.LeaveClan: successful", func(t *testing.T) {
+		_, err := client.LeaveClan(martinCtx, &userspb.LeaveClanRequest{
+			UserId: martin.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.LeaveClan(mashaCtx, &userspb.LeaveClanRequest{
+			UserId: masha.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("clans.LeaveClan: last member disbands the clan", func(t *testing.T) {
+		_, err := client.LeaveClan(johnCtx, &userspb.LeaveClanRequest{
+			UserId: john.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.GetClan(johnCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "clan", "id", clanID)
+	})
+}
+
+func ClanHandOverTest(t *testing.T, auth userspb.UsersAuthServiceClient, profile userspb.UsersProfileServiceClient, client userspb.UsersClansServiceClient, _ *config.TestConfig) {
+	owner, ownerCtx := registerUser(t, auth, "handover_owner")
+	member, memberCtx := registerUser(t, auth, "handover_member")
+	officer, officerCtx := registerUser(t, auth, "handover_officer")
+	newcomer, newcomerCtx := registerUser(t, auth, "handover_newcomer")
+
+	res, err := client.CreateClan(ownerCtx, &userspb.CreateClanRequest{
+		UserId: owner.Id,
+		Tag:    "HND",
+		Name:   "Hand Over",
+	})
+
+	require.NoError(t, err)
+
+	clanID := res.Id
+
+	for _, invitee := range []struct {
+		id  string
+		ctx context.Context
+	}{{member.Id, memberCtx}, {officer.Id, officerCtx}} {
+		_, err = client.InviteToClan(ownerCtx, &userspb.InviteToClanRequest{
+			UserId:    owner.Id,
+			ClanId:    clanID,
+			InviteeId: invitee.id,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.JoinClan(invitee.ctx, &userspb.JoinClanRequest{
+			UserId: invitee.id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+	}
+
+	_, err = client.SetClanMemberRole(ownerCtx, &userspb.SetClanMemberRoleRequest{
+		UserId:   owner.Id,
+		ClanId:   clanID,
+		MemberId: officer.Id,
+		Role:     userspb.ClanRole_CLAN_ROLE_OFFICER,
+	})
+
+	require.NoError(t, err)
+
+	deleteAccount := func(t *testing.T, userID string, ctx context.Context) {
+		_, err := profile.DeleteAccount(ctx, &userspb.DeleteAccountRequest{
+			UserId: userID,
+		})
+
+		require.NoError(t, err)
+	}
+
+	t.Run("clans.DeleteAccount: owner: officer takes over", func(t *testing.T) {
+		deleteAccount(t, owner.Id, ownerCtx)
+
+		res, err := client.GetClan(memberCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, officer.Id, res.Clan.OwnerId)
+		require.Equal(t, uint64(2), res.Clan.MembersCount)
+	})
+
+	t.Run("clans.JoinClan: deleted members do not count towards the cap", func(t *testing.T) {
+		_, err := client.InviteToClan(officerCtx, &userspb.InviteToClanRequest{
+			UserId:    officer.Id,
+			ClanId:    clanID,
+			InviteeId: newcomer.Id,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.JoinClan(newcomerCtx, &userspb.JoinClanRequest{
+			UserId: newcomer.Id,
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.True(t, res.Joined)
+	})
+
+	t.Run("clans.DeleteAccount: owner: longest serving member takes over", func(t *testing.T) {
+		deleteAccount(t, officer.Id, officerCtx)
+
+		res, err := client.GetClan(memberCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, member.Id, res.Clan.OwnerId)
+	})
+
+	t.Run("clans.DeleteAccount: owner: last member takes over", func(t *testing.T) {
+		deleteAccount(t, member.Id, memberCtx)
+
+		res, err := client.GetClan(newcomerCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, newcomer.Id, res.Clan.OwnerId)
+		require.Equal(t, uint64(1), res.Clan.MembersCount)
+	})
+
+	t.Run("clans.DeleteAccount: last owner disbands the clan", func(t *testing.T) {
+		deleteAccount(t, newcomer.Id, newcomerCtx)
+
+		_, err := client.GetClan(superCtx, &userspb.GetClanRequest{
+			ClanId: clanID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "clan", "id", clanID)
+	})
+}
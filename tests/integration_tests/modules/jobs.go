@@ -0,0 +1,306 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testerror "github.com/QuizWars-Ecosystem/go-common/pkg/testing/errors"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
+)
+
+// JobRunner runs a scheduler job of the test server on demand.
+type JobRunner interface {
+	RunJob(ctx context.Context, name string) (bool, error)
+}
+
+func FriendRequestExpiryTest(t *testing.T, auth userspb.UsersAuthServiceClient, client userspb.UsersSocialServiceClient, jobs JobRunner, cfg *config.TestConfig) {
+	ctx := t.Context()
+
+	friends := cfg.ServiceConfig.Friends
+	original := *friends
+	t.Cleanup(func() {
+		*friends = original
+	})
+
+	first, _ := registerUser(t, auth, "expiry_first")
+	second, _ := registerUser(t, auth, "expiry_second")
+	recipient, recipientCtx := registerUser(t, auth, "expiry_recipient")
+
+	for _, requester := range []*userspb.Profile{first, second} {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: requester.Id,
+			RecipientId: recipient.Id,
+		})
+
+		require.NoError(t, err)
+	}
+
+	reminders := func(t *testing.T) []*userspb.Notification {
+		res, err := client.ListNotifications(recipientCtx, &userspb.ListNotificationsRequest{
+			UserId: recipient.Id,
+			Size:   10,
+		})
+
+		require.NoError(t, err)
+
+		var found []*userspb.Notification
+		for _, n := range res.Notifications {
+			if n.Kind == userspb.NotificationKind_NOTIFICATION_KIND_FRIEND_REQUEST_REMINDER {
+				found = append(found, n)
+			}
+		}
+
+		return found
+	}
+
+	runExpiry := func(t *testing.T) {
+		ran, err := jobs.RunJob(ctx, "expire-friend-requests")
+
+		require.NoError(t, err)
+		require.True(t, ran)
+	}
+
+	t.Run("jobs.ExpireFriendRequests: nothing due", func(t *testing.T) {
+		friends.RemindAfter = time.Hour
+		friends.RequestTTL = 0
+
+		runExpiry(t)
+
+		require.Empty(t, reminders(t))
+	})
+
+	t.Run("jobs.ExpireFriendRequests: reminders sent in batches", func(t *testing.T) {
+		friends.RemindAfter = time.Nanosecond
+		friends.ExpiryBatchSize = 1
+
+		runExpiry(t)
+
+		found := reminders(t)
+		require.Len(t, found, 2)
+
+		actors := []string{found[0].GetActorId(), found[1].GetActorId()}
+		require.ElementsMatch(t, []string{first.Id, second.Id}, actors)
+	})
+
+	t.Run("jobs.ExpireFriendRequests: reminders sent once", func(t *testing.T) {
+		runExpiry(t)
+
+		require.Len(t, reminders(t), 2)
+	})
+
+	t.Run("jobs.ExpireFriendRequests: expired requests deleted in batches", func(t *testing.T) {
+		friends.RemindAfter = 0
+		friends.RequestTTL = time.Nanosecond
+
+		runExpiry(t)
+
+		res, err := client.ListIncomingFriendRequests(recipientCtx, &userspb.ListIncomingFriendRequestsRequest{
+			UserId: recipient.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Friends)
+	})
+}
+
+func NotificationRetentionTest(t *testing.T, auth userspb.UsersAuthServiceClient, client userspb.UsersSocialServiceClient, jobs JobRunner, cfg *config.TestConfig) {
+	ctx := t.Context()
+
+	notifications := cfg.ServiceConfig.Notifications
+	original := *notifications
+	t.Cleanup(func() {
+		*notifications = original
+	})
+
+	requester, _ := registerUser(t, auth, "retention_requester")
+	recipient, recipientCtx := registerUser(t, auth, "retention_recipient")
+
+	_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+		RequesterId: requester.Id,
+		RecipientId: recipient.Id,
+	})
+
+	require.NoError(t, err)
+
+	list := func(t *testing.T) *userspb.ListNotificationsResponse {
+		res, err := client.ListNotifications(recipientCtx, &userspb.ListNotificationsRequest{
+			UserId: recipient.Id,
+			Size:   10,
+		})
+
+		require.NoError(t, err)
+
+		return res
+	}
+
+	runPurge := func(t *testing.T) {
+		ran, err := jobs.RunJob(ctx, "purge-notifications")
+
+		require.NoError(t, err)
+		require.True(t, ran)
+	}
+
+	t.Run("jobs.PurgeNotifications: retention not set", func(t *testing.T) {
+		runPurge(t)
+
+		require.Len(t, list(t).Notifications, 1)
+	})
+
+	t.Run("jobs.PurgeNotifications: nothing due", func(t *testing.T) {
+		notifications.Retention = time.Hour
+
+		runPurge(t)
+
+		require.Len(t, list(t).Notifications, 1)
+	})
+
+	t.Run("jobs.PurgeNotifications: expired notifications deleted in batches", func(t *testing.T) {
+		notifications.Retention = time.Nanosecond
+
+		runPurge(t)
+
+		res := list(t)
+		require.Empty(t, res.Notifications)
+		require.Zero(t, res.UnreadCount)
+	})
+}
+
+func PurgeDeletedProfilesTest(t *testing.T, auth userspb.UsersAuthServiceClient, profile userspb.UsersProfileServiceClient, social userspb.UsersSocialServiceClient, admin userspb.UsersAdminServiceClient, jobs JobRunner, cfg *config.TestConfig) {
+	ctx := t.Context()
+
+	account := cfg.ServiceConfig.Account
+	original := *account
+	t.Cleanup(func() {
+		*account = original
+	})
+
+	friend, friendCtx := registerUser(t, auth, "purge_friend")
+	first, firstCtx := registerUser(t, auth, "purge_first")
+	second, secondCtx := registerUser(t, auth, "purge_second")
+	banned, _ := registerUser(t, auth, "purge_banned")
+
+	_, err := social.AddFriend(ctx, &userspb.AddFriendRequest{
+		RequesterId: first.Id,
+		RecipientId: friend.Id,
+	})
+
+	require.NoError(t, err)
+
+	_, err = social.AcceptFriend(ctx, &userspb.AcceptFriendRequest{
+		RecipientId: friend.Id,
+		RequesterId: first.Id,
+	})
+
+	require.NoError(t, err)
+
+	for _, deleted := range []struct {
+		id  string
+		ctx context.Context
+	}{{first.Id, firstCtx}, {second.Id, secondCtx}} {
+		_, err = profile.DeleteAccount(deleted.ctx, &userspb.DeleteAccountRequest{
+			UserId: deleted.id,
+		})
+
+		require.NoError(t, err)
+	}
+
+	_, err = admin.BanUser(johnAdminCtx, &userspb.BanUserRequest{
+		UserId: banned.Id,
+	})
+
+	require.NoError(t, err)
+
+	lookup := func(userID string) (*userspb.UserAdmin, error) {
+		return admin.GetUserByIdentifier(johnAdminCtx, &userspb.GetUserByIdentifierRequest{
+			Identifier: &userspb.GetUserByIdentifierRequest_UserId{
+				UserId: userID,
+			},
+		})
+	}
+
+	exportedFriends := func(t *testing.T) []string {
+		stream, err := profile.ExportMyData(friendCtx, &userspb.ExportMyDataRequest{
+			UserId: friend.Id,
+			Format: userspb.ExportFormat_EXPORT_FORMAT_JSON,
+		})
+		require.NoError(t, err)
+
+		_, data := receiveExport(t, stream)
+
+		var export struct {
+			Friends []struct {
+				RequesterID string `json:"requester_id"`
+			} `json:"friends"`
+		}
+
+		require.NoError(t, json.Unmarshal(data, &export))
+
+		requesters := make([]string, len(export.Friends))
+		for i, f := range export.Friends {
+			requesters[i] = f.RequesterID
+		}
+
+		return requesters
+	}
+
+	runPurge := func(t *testing.T) {
+		ran, err := jobs.RunJob(ctx, "purge-deleted-profiles")
+
+		require.NoError(t, err)
+		require.True(t, ran)
+	}
+
+	t.Run("jobs.PurgeDeletedProfiles: grace period not expired", func(t *testing.T) {
+		runPurge(t)
+
+		for _, userID := range []string{first.Id, second.Id} {
+			res, err := lookup(userID)
+
+			require.NoError(t, err)
+			require.True(t, res.DeletedAt.IsValid())
+		}
+
+		require.Equal(t, []string{first.Id}, exportedFriends(t))
+	})
+
+	t.Run("jobs.PurgeDeletedProfiles: purged in batches", func(t *testing.T) {
+		account.DeletionGracePeriod = time.Nanosecond
+		account.PurgeBatchSize = 1
+
+		runPurge(t)
+
+		// The oldest deletion goes first, so the latest one outlives a batch of one.
+		_, err := lookup(second.Id)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("jobs.PurgeDeletedProfiles: expired grace period: purged", func(t *testing.T) {
+		account.PurgeBatchSize = original.PurgeBatchSize
+
+		runPurge(t)
+
+		for _, userID := range []string{first.Id, second.Id} {
+			_, err := lookup(userID)
+
+			require.Error(t, err)
+			testerror.RequireNotFoundError(t, err, "user", "id", userID)
+		}
+
+		require.Empty(t, exportedFriends(t))
+	})
+
+	t.Run("jobs.PurgeDeletedProfiles: banned users kept", func(t *testing.T) {
+		res, err := lookup(banned.Id)
+
+		require.NoError(t, err)
+		require.True(t, res.BannedAt.IsValid())
+		require.False(t, res.DeletedAt.IsValid())
+	})
+}
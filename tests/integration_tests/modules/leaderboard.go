@@ -0,0 +1,123 @@
+package modules
+
+import (
+	"testing"
+
+	jw "github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
+	testerror "github.com/QuizWars-Ecosystem/go-common/pkg/testing/errors"
+	"github.com/stretchr/testify/require"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
+)
+
+func LeaderboardServiceTest(t *testing.T, client userspb.UsersLeaderboardServiceClient, _ *config.TestConfig) {
+	t.Run("leaderboard.GetGlobalLeaderboard: token not provided", func(t *testing.T) {
+		res, err := client.GetGlobalLeaderboard(emptyCtx, &userspb.GetGlobalLeaderboardRequest{})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("leaderboard.GetGlobalLeaderboard: successful", func(t *testing.T) {
+		res, err := client.GetGlobalLeaderboard(johnCtx, &userspb.GetGlobalLeaderboardRequest{})
+
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 4)
+		require.Equal(t, sonia.Id, res.Entries[0].User.Id)
+		require.Equal(t, masha.Id, res.Entries[3].User.Id)
+
+		for i, entry := range res.Entries {
+			require.Equal(t, uint64(i+1), entry.Rank)
+
+			if i > 0 {
+				require.LessOrEqual(t, entry.User.Rating, res.Entries[i-1].User.Rating)
+			}
+		}
+	})
+
+	t.Run("leaderboard.GetGlobalLeaderboard: by size: successful", func(t *testing.T) {
+		res, err := client.GetGlobalLeaderboard(johnCtx, &userspb.GetGlobalLeaderboardRequest{
+			Size: 1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		require.Equal(t, sonia.Id, res.Entries[0].User.Id)
+	})
+
+	t.Run("leaderboard.GetCountryLeaderboard: invalid country", func(t *testing.T) {
+		res, err := client.GetCountryLeaderboard(johnCtx, &userspb.GetCountryLeaderboardRequest{
+			Country: "DEU",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("leaderboard.GetCountryLeaderboard: successful", func(t *testing.T) {
+		res, err := client.GetCountryLeaderboard(johnCtx, &userspb.GetCountryLeaderboardRequest{
+			Country: "de",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		require.Equal(t, uint64(1), res.Entries[0].Rank)
+		require.Equal(t, sonia.Id, res.Entries[0].User.Id)
+		require.Equal(t, "DE", res.Entries[0].User.GetCountry())
+	})
+
+	t.Run("leaderboard.GetCountryLeaderboard: empty", func(t *testing.T) {
+		res, err := client.GetCountryLeaderboard(johnCtx, &userspb.GetCountryLeaderboardRequest{
+			Country: "FR",
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Entries)
+	})
+
+	t.Run("leaderboard.GetLeaderboardAroundMe: permission denied", func(t *testing.T) {
+		res, err := client.GetLeaderboardAroundMe(johnCtx, &userspb.GetLeaderboardAroundMeRequest{
+			UserId: masha.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("leaderboard.GetLeaderboardAroundMe: successful", func(t *testing.T) {
+		res, err := client.GetLeaderboardAroundMe(mashaCtx, &userspb.GetLeaderboardAroundMeRequest{
+			UserId: masha.Id,
+			Radius: 1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 2)
+		require.Equal(t, uint64(3), res.Entries[0].Rank)
+		require.Equal(t, uint64(4), res.Entries[1].Rank)
+		require.Equal(t, masha.Id, res.Entries[1].User.Id)
+	})
+
+	t.Run("leaderboard.GetFriendsLeaderboard: permission denied", func(t *testing.T) {
+		res, err := client.GetFriendsLeaderboard(soniaCtx, &userspb.GetFriendsLeaderboardRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("leaderboard.GetFriendsLeaderboard: without friends: successful", func(t *testing.T) {
+		res, err := client.GetFriendsLeaderboard(soniaCtx, &userspb.GetFriendsLeaderboardRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		require.Equal(t, uint64(1), res.Entries[0].Rank)
+		require.Equal(t, sonia.Id, res.Entries[0].User.Id)
+	})
+}
@@ -1,19 +1,29 @@
 package modules
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
 	jw "github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
 	testerror "github.com/QuizWars-Ecosystem/go-common/pkg/testing/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
 	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
 )
 
-func ProfileServiceTest(t *testing.T, client userspb.UsersProfileServiceClient, _ *config.TestConfig) {
+func ProfileServiceTest(t *testing.T, client userspb.UsersProfileServiceClient, social userspb.UsersSocialServiceClient, _ *config.TestConfig) {
 	t.Run("profile.GetProfile: by user id: token not provided", func(t *testing.T) {
 		res, err := client.GetProfile(emptyCtx, &userspb.GetProfileRequest{
 			Identifier: &userspb.GetProfileRequest_UserId{
@@ -294,6 +304,1280 @@ func ProfileServiceTest(t *testing.T, client userspb.UsersProfileServiceClient,
 		martinPassword = testData
 	})
 
+	t.Run("profile.GetUsersByIDs: token not provided", func(t *testing.T) {
+		res, err := client.GetUsersByIDs(emptyCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{john.Id},
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.GetUsersByIDs: batch too large", func(t *testing.T) {
+		ids := make([]string, 101)
+		for i := range ids {
+			ids[i] = uuid.New().String()
+		}
+
+		res, err := client.GetUsersByIDs(johnCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: ids,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("profile.GetUsersByIDs: request order and missing ids: successful", func(t *testing.T) {
+		testID := uuid.New().String()
+		res, err := client.GetUsersByIDs(johnCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{sonia.Id, testID, john.Id, sonia.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 2)
+		require.Equal(t, sonia.Id, res.Users[0].Id)
+		require.Equal(t, john.Id, res.Users[1].Id)
+		require.Equal(t, []string{testID}, res.MissingIds)
+	})
+
+	t.Run("profile.UpdateProfile: privacy: successful", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_PRIVATE
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("social.GetMutualFriends: private profile: hidden from friends", func(t *testing.T) {
+		_, err := social.GetMutualFriends(lukasCtx, &userspb.GetMutualFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrFriendsHidden)
+	})
+
+	t.Run("profile.GetUsersByIDs: private profile: redacted", func(t *testing.T) {
+		res, err := client.GetUsersByIDs(soniaCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{john.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Nil(t, res.Users[0].LastLoginAt)
+	})
+
+	t.Run("profile.GetUsersByIDs: private profile: self: successful", func(t *testing.T) {
+		res, err := client.GetUsersByIDs(johnCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{john.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.NotNil(t, res.Users[0].LastLoginAt)
+	})
+
+	t.Run("profile.GetUsersByIDs: friends only profile: friend: successful", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_FRIENDS
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+		require.NoError(t, err)
+
+		res, err := client.GetUsersByIDs(lukasCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{john.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.NotNil(t, res.Users[0].LastLoginAt)
+	})
+
+	t.Run("profile.GetUsersByIDs: friends only profile: stranger: redacted", func(t *testing.T) {
+		res, err := client.GetUsersByIDs(soniaCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{john.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Nil(t, res.Users[0].LastLoginAt)
+	})
+
+	t.Run("social.GetMutualFriends: friends only profile: stranger: hidden", func(t *testing.T) {
+		_, err := social.GetMutualFriends(soniaCtx, &userspb.GetMutualFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrFriendsHidden)
+	})
+
+	t.Run("social.GetMutualFriends: friends only profile: friend: successful", func(t *testing.T) {
+		_, err := social.GetMutualFriends(lukasCtx, &userspb.GetMutualFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.UpdateProfile: privacy: public: successful", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_PUBLIC
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.UpdateProfile: country: invalid", func(t *testing.T) {
+		country := "Germany"
+		_, err := client.UpdateProfile(soniaCtx, &userspb.UpdateProfileRequest{
+			UserId:  sonia.Id,
+			Country: &country,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.UpdateProfile: country: successful", func(t *testing.T) {
+		country := "de"
+		_, err := client.UpdateProfile(soniaCtx, &userspb.UpdateProfileRequest{
+			UserId:  sonia.Id,
+			Country: &country,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.GetUsersByIDs(johnCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{sonia.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, "DE", res.Users[0].GetCountry())
+	})
+
+	t.Run("profile.CreditCoins: permission denied", func(t *testing.T) {
+		_, err := client.CreditCoins(soniaCtx, &userspb.CreditCoinsRequest{
+			UserId:         sonia.Id,
+			Amount:         100,
+			Reason:         "match_reward",
+			Source:         "game-service",
+			IdempotencyKey: "match-1",
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.CreditCoins: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.CreditCoins(johnAdminCtx, &userspb.CreditCoinsRequest{
+			UserId:         testID,
+			Amount:         100,
+			Reason:         "match_reward",
+			Source:         "game-service",
+			IdempotencyKey: "match-1",
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("profile.CreditCoins: successful", func(t *testing.T) {
+		res, err := client.CreditCoins(johnAdminCtx, &userspb.CreditCoinsRequest{
+			UserId:         sonia.Id,
+			Amount:         100,
+			Reason:         "match_reward",
+			Source:         "game-service",
+			IdempotencyKey: "match-1",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(100), res.Amount)
+		require.Equal(t, int64(100), res.BalanceAfter)
+	})
+
+	t.Run("profile.CreditCoins: repeated idempotency key: successful", func(t *testing.T) {
+		res, err := client.CreditCoins(johnAdminCtx, &userspb.CreditCoinsRequest{
+			UserId:         sonia.Id,
+			Amount:         100,
+			Reason:         "match_reward",
+			Source:         "game-service",
+			IdempotencyKey: "match-1",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(100), res.BalanceAfter)
+	})
+
+	t.Run("profile.DebitCoins: repeated idempotency key with other amount", func(t *testing.T) {
+		_, err := client.DebitCoins(johnAdminCtx, &userspb.DebitCoinsRequest{
+			UserId:         sonia.Id,
+			Amount:         30,
+			Reason:         "purchase",
+			Source:         "shop",
+			IdempotencyKey: "match-1",
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "coin transaction", "idempotency_key", "match-1")
+	})
+
+	t.Run("profile.DebitCoins: insufficient coins", func(t *testing.T) {
+		_, err := client.DebitCoins(johnAdminCtx, &userspb.DebitCoinsRequest{
+			UserId:         sonia.Id,
+			Amount:         101,
+			Reason:         "purchase",
+			Source:         "shop",
+			IdempotencyKey: "purchase-1",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.DebitCoins: successful", func(t *testing.T) {
+		res, err := client.DebitCoins(johnAdminCtx, &userspb.DebitCoinsRequest{
+			UserId:         sonia.Id,
+			Amount:         30,
+			Reason:         "purchase",
+			Source:         "shop",
+			IdempotencyKey: "purchase-1",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(-30), res.Amount)
+		require.Equal(t, int64(70), res.BalanceAfter)
+
+		prof, err := client.GetProfile(soniaCtx, &userspb.GetProfileRequest{
+			Identifier: &userspb.GetProfileRequest_UserId{UserId: sonia.Id},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(70), prof.GetProfile().Coins)
+	})
+
+	t.Run("profile.ListCoinTransactions: permission denied", func(t *testing.T) {
+		_, err := client.ListCoinTransactions(johnCtx, &userspb.ListCoinTransactionsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.ListCoinTransactions: by cursor: successful", func(t *testing.T) {
+		first, err := client.ListCoinTransactions(soniaCtx, &userspb.ListCoinTransactionsRequest{
+			UserId: sonia.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, first.Transactions, 1)
+		require.Equal(t, "purchase-1", first.Transactions[0].IdempotencyKey)
+		require.NotNil(t, first.NextCursor)
+
+		second, err := client.ListCoinTransactions(soniaCtx, &userspb.ListCoinTransactionsRequest{
+			UserId: sonia.Id,
+			Size:   1,
+			Cursor: first.NextCursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, second.Transactions, 1)
+		require.Equal(t, "match-1", second.Transactions[0].IdempotencyKey)
+		require.Nil(t, second.NextCursor)
+	})
+
+	matchID := uuid.New().String()
+
+	t.Run("profile.ReportMatchResult: permission denied", func(t *testing.T) {
+		_, err := client.ReportMatchResult(soniaCtx, &userspb.ReportMatchResultRequest{
+			MatchId: matchID,
+			Participants: []*userspb.MatchParticipant{
+				{UserId: sonia.Id, Placement: 1},
+				{UserId: masha.Id, Placement: 2},
+			},
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.ReportMatchResult: single participant", func(t *testing.T) {
+		_, err := client.ReportMatchResult(johnAdminCtx, &userspb.ReportMatchResultRequest{
+			MatchId: matchID,
+			Participants: []*userspb.MatchParticipant{
+				{UserId: sonia.Id, Placement: 1},
+			},
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.ReportMatchResult: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.ReportMatchResult(johnAdminCtx, &userspb.ReportMatchResultRequest{
+			MatchId: uuid.New().String(),
+			Participants: []*userspb.MatchParticipant{
+				{UserId: sonia.Id, Placement: 1},
+				{UserId: testID, Placement: 2},
+			},
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	var ratingChanges []*userspb.RatingChange
+
+	t.Run("profile.ReportMatchResult: successful", func(t *testing.T) {
+		res, err := client.ReportMatchResult(johnAdminCtx, &userspb.ReportMatchResultRequest{
+			MatchId: matchID,
+			Participants: []*userspb.MatchParticipant{
+				{UserId: sonia.Id, Placement: 1},
+				{UserId: masha.Id, Placement: 2},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, matchID, res.MatchId)
+		require.Len(t, res.Changes, 2)
+
+		for _, c := range res.Changes {
+			switch c.UserId {
+			case sonia.Id:
+				require.Greater(t, c.RatingAfter, c.RatingBefore)
+			case masha.Id:
+				require.Less(t, c.RatingAfter, c.RatingBefore)
+			}
+
+			require.Less(t, c.DeviationAfter, c.DeviationBefore)
+		}
+
+		ratingChanges = res.Changes
+	})
+
+	t.Run("profile.ReportMatchResult: repeated match id: successful", func(t *testing.T) {
+		res, err := client.ReportMatchResult(johnAdminCtx, &userspb.ReportMatchResultRequest{
+			MatchId: matchID,
+			Participants: []*userspb.MatchParticipant{
+				{UserId: sonia.Id, Placement: 2},
+				{UserId: masha.Id, Placement: 1},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Changes, len(ratingChanges))
+
+		for i, c := range res.Changes {
+			require.Equal(t, ratingChanges[i].UserId, c.UserId)
+			require.Equal(t, ratingChanges[i].RatingAfter, c.RatingAfter)
+		}
+	})
+
+	now := time.Now().UTC()
+	casualID := uuid.New().String()
+
+	t.Run("profile.RecordMatch: permission denied", func(t *testing.T) {
+		_, err := client.RecordMatch(soniaCtx, &userspb.RecordMatchRequest{
+			MatchId:   matchID,
+			Mode:      "ranked",
+			StartedAt: timestamppb.New(now.Add(-10 * time.Minute)),
+			EndedAt:   timestamppb.New(now.Add(-5 * time.Minute)),
+			Participants: []*userspb.MatchRecordParticipant{
+				{UserId: sonia.Id, Score: 900, Placement: 1},
+			},
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.RecordMatch: ended before started", func(t *testing.T) {
+		_, err := client.RecordMatch(johnAdminCtx, &userspb.RecordMatchRequest{
+			MatchId:   matchID,
+			Mode:      "ranked",
+			StartedAt: timestamppb.New(now.Add(-5 * time.Minute)),
+			EndedAt:   timestamppb.New(now.Add(-10 * time.Minute)),
+			Participants: []*userspb.MatchRecordParticipant{
+				{UserId: sonia.Id, Score: 900, Placement: 1},
+			},
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.RecordMatch: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.RecordMatch(johnAdminCtx, &userspb.RecordMatchRequest{
+			MatchId:   uuid.New().String(),
+			Mode:      "ranked",
+			StartedAt: timestamppb.New(now.Add(-10 * time.Minute)),
+			EndedAt:   timestamppb.New(now.Add(-5 * time.Minute)),
+			Participants: []*userspb.MatchRecordParticipant{
+				{UserId: sonia.Id, Score: 900, Placement: 1},
+				{UserId: testID, Score: 400, Placement: 2},
+			},
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	rankedMatch := &userspb.RecordMatchRequest{
+		MatchId:     matchID,
+		Mode:        "ranked",
+		CategoryIds: []int32{1, 2},
+		StartedAt:   timestamppb.New(now.Add(-10 * time.Minute)),
+		EndedAt:     timestamppb.New(now.Add(-5 * time.Minute)),
+		Participants: []*userspb.MatchRecordParticipant{
+			{UserId: sonia.Id, Score: 900, Placement: 1},
+			{UserId: masha.Id, Score: 400, Placement: 2},
+		},
+	}
+
+	t.Run("profile.RecordMatch: rated match: successful", func(t *testing.T) {
+		_, err := client.RecordMatch(johnAdminCtx, rankedMatch)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.RecordMatch: repeated match id: successful", func(t *testing.T) {
+		_, err := client.RecordMatch(johnAdminCtx, rankedMatch)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.RecordMatch: unrated match: successful", func(t *testing.T) {
+		_, err := client.RecordMatch(johnAdminCtx, &userspb.RecordMatchRequest{
+			MatchId:     casualID,
+			Mode:        "casual",
+			CategoryIds: []int32{2},
+			StartedAt:   timestamppb.New(now.Add(-2 * time.Minute)),
+			EndedAt:     timestamppb.New(now.Add(-time.Minute)),
+			Participants: []*userspb.MatchRecordParticipant{
+				{UserId: john.Id, Score: 700, Placement: 1},
+				{UserId: sonia.Id, Score: 300, Placement: 2},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("social.SuggestFriends: played together: successful", func(t *testing.T) {
+		res, err := social.SuggestFriends(soniaCtx, &userspb.SuggestFriendsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		requireSuggestionsRanked(t, res.Suggestions)
+
+		opponents := make(map[string]*userspb.FriendSuggestion)
+		for _, suggestion := range res.Suggestions {
+			if suggestion.User.Id == john.Id || suggestion.User.Id == masha.Id {
+				opponents[suggestion.User.Id] = suggestion
+			}
+		}
+
+		require.Len(t, opponents, 2)
+
+		for _, suggestion := range opponents {
+			require.Equal(t, uint64(1), suggestion.MatchesTogether)
+			require.Contains(t, suggestion.Reasons, userspb.SuggestionReason_SUGGESTION_REASON_PLAYED_TOGETHER)
+		}
+
+		for _, suggestion := range res.Suggestions[:len(opponents)] {
+			require.Contains(t, opponents, suggestion.User.Id)
+		}
+	})
+
+	t.Run("profile.ListMatchHistory: token not provided", func(t *testing.T) {
+		res, err := client.ListMatchHistory(emptyCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.ListMatchHistory: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		res, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: testID,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("profile.ListMatchHistory: successful", func(t *testing.T) {
+		res, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Matches, 2)
+		require.Nil(t, res.NextCursor)
+
+		casual := res.Matches[0]
+		require.Equal(t, casualID, casual.MatchId)
+		require.Equal(t, "casual", casual.Mode)
+		require.Equal(t, uint32(2), casual.Placement)
+		require.Nil(t, casual.RatingDelta)
+
+		ranked := res.Matches[1]
+		require.Equal(t, matchID, ranked.MatchId)
+		require.Equal(t, []int32{1, 2}, ranked.CategoryIds)
+		require.Equal(t, int64(900), ranked.Score)
+		require.Equal(t, uint32(1), ranked.Placement)
+		require.NotNil(t, ranked.RatingDelta)
+
+		for _, c := range ratingChanges {
+			if c.UserId == sonia.Id {
+				require.Equal(t, c.RatingAfter-c.RatingBefore, ranked.GetRatingDelta())
+			}
+		}
+	})
+
+	t.Run("profile.ListMatchHistory: by mode: successful", func(t *testing.T) {
+		mode := "ranked"
+		res, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+			Mode:   &mode,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Matches, 1)
+		require.Equal(t, matchID, res.Matches[0].MatchId)
+	})
+
+	t.Run("profile.ListMatchHistory: by period: successful", func(t *testing.T) {
+		res, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+			From:   timestamppb.New(now.Add(-3 * time.Minute)),
+			To:     timestamppb.New(now),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Matches, 1)
+		require.Equal(t, casualID, res.Matches[0].MatchId)
+	})
+
+	t.Run("profile.ListMatchHistory: invalid period", func(t *testing.T) {
+		_, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+			From:   timestamppb.New(now),
+			To:     timestamppb.New(now.Add(-3 * time.Minute)),
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.ListMatchHistory: paginated: successful", func(t *testing.T) {
+		first, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, first.Matches, 1)
+		require.Equal(t, casualID, first.Matches[0].MatchId)
+		require.NotNil(t, first.NextCursor)
+
+		second, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: sonia.Id,
+			Size:   1,
+			Cursor: first.NextCursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, second.Matches, 1)
+		require.Equal(t, matchID, second.Matches[0].MatchId)
+		require.Nil(t, second.NextCursor)
+	})
+
+	t.Run("profile.ListMatchHistory: friends only: permission denied", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_FRIENDS
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListMatchHistory(soniaCtx, &userspb.ListMatchHistoryRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, profile.ErrMatchHistoryHidden)
+	})
+
+	t.Run("profile.ListMatchHistory: friends only: friend: successful", func(t *testing.T) {
+		res, err := client.ListMatchHistory(lukasCtx, &userspb.ListMatchHistoryRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Matches, 1)
+	})
+
+	t.Run("profile.ListMatchHistory: private: permission denied", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_PRIVATE
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListMatchHistory(lukasCtx, &userspb.ListMatchHistoryRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, profile.ErrMatchHistoryHidden)
+	})
+
+	t.Run("profile.ListMatchHistory: private: self: successful", func(t *testing.T) {
+		res, err := client.ListMatchHistory(johnCtx, &userspb.ListMatchHistoryRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Matches, 1)
+
+		privacy := userspb.Privacy_PRIVACY_PUBLIC
+		_, err = client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.GetSeasonHistory: token not provided", func(t *testing.T) {
+		res, err := client.GetSeasonHistory(emptyCtx, &userspb.GetSeasonHistoryRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.GetSeasonHistory: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		res, err := client.GetSeasonHistory(johnCtx, &userspb.GetSeasonHistoryRequest{
+			UserId: testID,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("profile.GetSeasonHistory: no past seasons: successful", func(t *testing.T) {
+		res, err := client.GetSeasonHistory(johnCtx, &userspb.GetSeasonHistoryRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Results)
+	})
+
+	t.Run("profile.GetSeasonHistory: private: permission denied", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_PRIVATE
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.GetSeasonHistory(lukasCtx, &userspb.GetSeasonHistoryRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, profile.ErrSeasonHistoryHidden)
+	})
+
+	t.Run("profile.GetSeasonHistory: private: self: successful", func(t *testing.T) {
+		_, err := client.GetSeasonHistory(johnCtx, &userspb.GetSeasonHistoryRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+
+		privacy := userspb.Privacy_PRIVACY_PUBLIC
+		_, err = client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.ListAchievements: token not provided", func(t *testing.T) {
+		res, err := client.ListAchievements(emptyCtx, &userspb.ListAchievementsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.ListAchievements: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		res, err := client.ListAchievements(johnCtx, &userspb.ListAchievementsRequest{
+			UserId: testID,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("profile.ListAchievements: match achievements: successful", func(t *testing.T) {
+		res, err := client.ListAchievements(johnCtx, &userspb.ListAchievementsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Achievements, 7)
+
+		for _, a := range res.Achievements {
+			switch a.Code {
+			case "first_match", "first_win":
+				require.NotNil(t, a.AwardedAt)
+				require.Equal(t, int64(1), a.Progress)
+			case "hundred_wins":
+				require.Nil(t, a.AwardedAt)
+				require.Equal(t, int64(1), a.Progress)
+			case "first_friend":
+				require.Nil(t, a.AwardedAt)
+			}
+		}
+	})
+
+	t.Run("profile.ListAchievements: friend and login achievements: successful", func(t *testing.T) {
+		res, err := client.ListAchievements(johnCtx, &userspb.ListAchievementsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+
+		awarded := make(map[string]bool, len(res.Achievements))
+		for _, a := range res.Achievements {
+			awarded[a.Code] = a.AwardedAt != nil
+		}
+
+		require.True(t, awarded["first_login"])
+		require.True(t, awarded["first_friend"])
+		require.False(t, awarded["ten_day_streak"])
+		require.False(t, awarded["first_match"])
+	})
+
+	t.Run("profile.ListAchievements: friends only: permission denied", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_FRIENDS
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListAchievements(soniaCtx, &userspb.ListAchievementsRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, profile.ErrAchievementsHidden)
+	})
+
+	t.Run("profile.ListAchievements: friends only: friend: successful", func(t *testing.T) {
+		res, err := client.ListAchievements(lukasCtx, &userspb.ListAchievementsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Achievements, 7)
+
+		privacy := userspb.Privacy_PRIVACY_PUBLIC
+		_, err = client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:  john.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.GetProfile: earned achievements: successful", func(t *testing.T) {
+		res, err := client.GetProfile(johnCtx, &userspb.GetProfileRequest{
+			Identifier: &userspb.GetProfileRequest_UserId{
+				UserId: sonia.Id,
+			},
+		})
+
+		require.NoError(t, err)
+
+		codes := make([]string, len(res.Achievements))
+		for i, a := range res.Achievements {
+			codes[i] = a.Code
+		}
+
+		require.ElementsMatch(t, []string{"first_match", "first_win"}, codes)
+	})
+
+	t.Run("profile.GrantXP: permission denied", func(t *testing.T) {
+		_, err := client.GrantXP(soniaCtx, &userspb.GrantXPRequest{
+			UserId:  masha.Id,
+			Amount:  260,
+			EventId: "match-xp-1",
+			Source:  "game-service",
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.GrantXP: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.GrantXP(johnAdminCtx, &userspb.GrantXPRequest{
+			UserId:  testID,
+			Amount:  260,
+			EventId: "match-xp-1",
+			Source:  "game-service",
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("profile.GetUsersByIDs: initial level: successful", func(t *testing.T) {
+		res, err := client.GetUsersByIDs(johnCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{masha.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, int32(1), res.Users[0].Level)
+		require.Equal(t, int64(0), res.Users[0].Xp)
+		require.Equal(t, int64(100), res.Users[0].NextLevelXp)
+	})
+
+	t.Run("profile.GrantXP: level up: successful", func(t *testing.T) {
+		res, err := client.GrantXP(johnAdminCtx, &userspb.GrantXPRequest{
+			UserId:  masha.Id,
+			Amount:  260,
+			EventId: "match-xp-1",
+			Source:  "game-service",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int32(1), res.LevelBefore)
+		require.Equal(t, int32(3), res.LevelAfter)
+		require.Equal(t, int64(10), res.CoinsRewarded)
+		require.Equal(t, int64(260), res.Xp)
+		require.Equal(t, int64(10), res.LevelXp)
+		require.Equal(t, int64(225), res.NextLevelXp)
+
+		notifications, err := social.ListNotifications(mashaCtx, &userspb.ListNotificationsRequest{
+			UserId:     masha.Id,
+			UnreadOnly: true,
+		})
+
+		require.NoError(t, err)
+
+		var levelUp *userspb.Notification
+		for _, n := range notifications.Notifications {
+			if n.Kind == userspb.NotificationKind_NOTIFICATION_KIND_LEVEL_UP {
+				levelUp = n
+				break
+			}
+		}
+
+		require.NotNil(t, levelUp)
+		require.Equal(t, int32(3), levelUp.GetLevel())
+	})
+
+	t.Run("profile.GrantXP: repeated event id: successful", func(t *testing.T) {
+		res, err := client.GrantXP(johnAdminCtx, &userspb.GrantXPRequest{
+			UserId:  masha.Id,
+			Amount:  260,
+			EventId: "match-xp-1",
+			Source:  "game-service",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int32(3), res.LevelAfter)
+		require.Equal(t, int64(10), res.CoinsRewarded)
+		require.Equal(t, int64(260), res.Xp)
+	})
+
+	t.Run("profile.GrantXP: repeated event id with other amount", func(t *testing.T) {
+		_, err := client.GrantXP(johnAdminCtx, &userspb.GrantXPRequest{
+			UserId:  masha.Id,
+			Amount:  50,
+			EventId: "match-xp-1",
+			Source:  "game-service",
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "xp grant", "event_id", "match-xp-1")
+	})
+
+	t.Run("profile.GetUsersByIDs: level and level-up achievement: successful", func(t *testing.T) {
+		res, err := client.GetUsersByIDs(johnCtx, &userspb.GetUsersByIDsRequest{
+			UserIds: []string{masha.Id},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, int32(3), res.Users[0].Level)
+		require.Equal(t, int64(260), res.Users[0].Xp)
+
+		achievements, err := client.ListAchievements(johnCtx, &userspb.ListAchievementsRequest{
+			UserId: masha.Id,
+		})
+
+		require.NoError(t, err)
+
+		for _, a := range achievements.Achievements {
+			if a.Code == "level_three" {
+				require.NotNil(t, a.AwardedAt)
+				require.Equal(t, int64(3), a.Progress)
+			}
+		}
+	})
+
+	t.Run("profile.UpdateProfile: time zone: invalid", func(t *testing.T) {
+		timeZone := "Mars/Olympus_Mons"
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:   john.Id,
+			TimeZone: &timeZone,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.UpdateProfile: time zone: successful", func(t *testing.T) {
+		timeZone := "Europe/Berlin"
+		_, err := client.UpdateProfile(johnCtx, &userspb.UpdateProfileRequest{
+			UserId:   john.Id,
+			TimeZone: &timeZone,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.GetProfile(johnCtx, &userspb.GetProfileRequest{
+			Identifier: &userspb.GetProfileRequest_UserId{UserId: john.Id},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, timeZone, res.GetProfile().TimeZone)
+	})
+
+	t.Run("profile.ClaimDailyReward: permission denied", func(t *testing.T) {
+		_, err := client.ClaimDailyReward(martinCtx, &userspb.ClaimDailyRewardRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	var claimedDay string
+
+	t.Run("profile.ClaimDailyReward: first day: successful", func(t *testing.T) {
+		res, err := client.ClaimDailyReward(johnCtx, &userspb.ClaimDailyRewardRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int32(1), res.Streak)
+		require.Equal(t, int64(5), res.Coins)
+		require.False(t, res.StreakFreezeUsed)
+		require.NotEmpty(t, res.Day)
+
+		claimedDay = res.Day
+	})
+
+	t.Run("profile.ClaimDailyReward: already claimed", func(t *testing.T) {
+		_, err := client.ClaimDailyReward(johnCtx, &userspb.ClaimDailyRewardRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "daily reward", "day", claimedDay)
+	})
+
+	t.Run("profile.ListShopItems: token not provided", func(t *testing.T) {
+		res, err := client.ListShopItems(emptyCtx, &emptypb.Empty{})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.ListShopItems: successful", func(t *testing.T) {
+		res, err := client.ListShopItems(soniaCtx, &emptypb.Empty{})
+
+		require.NoError(t, err)
+		require.Len(t, res.Items, 4)
+		require.Equal(t, "golden_avatar", res.Items[0].Code)
+		require.Equal(t, userspb.ShopItemKind_SHOP_ITEM_KIND_AVATAR, res.Items[0].Kind)
+		require.Equal(t, int32(100), res.Items[0].GetAvatarId())
+		require.Nil(t, res.Items[1].AvatarId)
+	})
+
+	t.Run("profile.UpdateAvatar: shop avatar not owned", func(t *testing.T) {
+		_, err := client.UpdateAvatar(soniaCtx, &userspb.UpdateAvatarRequest{
+			UserId:   sonia.Id,
+			AvatarId: 100,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrAvatarNotOwned)
+	})
+
+	t.Run("profile.PurchaseItem: permission denied", func(t *testing.T) {
+		_, err := client.PurchaseItem(martinCtx, &userspb.PurchaseItemRequest{
+			UserId:         sonia.Id,
+			ItemCode:       "golden_avatar",
+			IdempotencyKey: "avatar-1",
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.PurchaseItem: item not found", func(t *testing.T) {
+		_, err := client.PurchaseItem(soniaCtx, &userspb.PurchaseItemRequest{
+			UserId:         sonia.Id,
+			ItemCode:       "unknown",
+			IdempotencyKey: "avatar-1",
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "item", "code", "unknown")
+	})
+
+	t.Run("profile.PurchaseItem: insufficient coins", func(t *testing.T) {
+		_, err := client.PurchaseItem(mashaCtx, &userspb.PurchaseItemRequest{
+			UserId:         masha.Id,
+			ItemCode:       "golden_avatar",
+			IdempotencyKey: "avatar-1",
+		})
+
+		require.Error(t, err)
+	})
+
+	var purchase *userspb.Purchase
+
+	t.Run("profile.PurchaseItem: successful", func(t *testing.T) {
+		res, err := client.PurchaseItem(soniaCtx, &userspb.PurchaseItemRequest{
+			UserId:         sonia.Id,
+			ItemCode:       "golden_avatar",
+			IdempotencyKey: "avatar-1",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "golden_avatar", res.Item.Code)
+		require.Equal(t, int64(50), res.Price)
+		require.Equal(t, int64(20), res.BalanceAfter)
+
+		purchase = res
+	})
+
+	t.Run("profile.PurchaseItem: repeated idempotency key: successful", func(t *testing.T) {
+		res, err := client.PurchaseItem(soniaCtx, &userspb.PurchaseItemRequest{
+			UserId:         sonia.Id,
+			ItemCode:       "golden_avatar",
+			IdempotencyKey: "avatar-1",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, purchase.BalanceAfter, res.BalanceAfter)
+		require.Equal(t, purchase.PurchasedAt.AsTime(), res.PurchasedAt.AsTime())
+	})
+
+	t.Run("profile.PurchaseItem: already owned", func(t *testing.T) {
+		_, err := client.PurchaseItem(soniaCtx, &userspb.PurchaseItemRequest{
+			UserId:         sonia.Id,
+			ItemCode:       "golden_avatar",
+			IdempotencyKey: "avatar-2",
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "item", "code", "golden_avatar")
+	})
+
+	t.Run("profile.UpdateAvatar: shop avatar owned: successful", func(t *testing.T) {
+		var testData int32 = 100
+		_, err := client.UpdateAvatar(soniaCtx, &userspb.UpdateAvatarRequest{
+			UserId:   sonia.Id,
+			AvatarId: testData,
+		})
+
+		require.NoError(t, err)
+		sonia.AvatarId = testData
+	})
+
+	t.Run("profile.PurchaseItem: streak freeze: successful", func(t *testing.T) {
+		res, err := client.PurchaseItem(johnCtx, &userspb.PurchaseItemRequest{
+			UserId:         john.Id,
+			ItemCode:       "streak_freeze",
+			IdempotencyKey: "freeze-1",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, userspb.ShopItemKind_SHOP_ITEM_KIND_STREAK_FREEZE, res.Item.Kind)
+		require.Equal(t, int64(0), res.BalanceAfter)
+	})
+
+	t.Run("profile.ListInventory: permission denied", func(t *testing.T) {
+		res, err := client.ListInventory(johnCtx, &userspb.ListInventoryRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.ListInventory: successful", func(t *testing.T) {
+		res, err := client.ListInventory(soniaCtx, &userspb.ListInventoryRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		require.Equal(t, "golden_avatar", res.Items[0].Item.Code)
+		require.Equal(t, "Golden avatar", res.Items[0].Item.Title)
+	})
+
+	t.Run("profile.ListInventory: consumables not kept: successful", func(t *testing.T) {
+		res, err := client.ListInventory(johnCtx, &userspb.ListInventoryRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Items)
+	})
+
+	t.Run("profile.ExportMyData: token not provided", func(t *testing.T) {
+		stream, err := client.ExportMyData(emptyCtx, &userspb.ExportMyDataRequest{
+			UserId: john.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.ExportMyData: permission denied", func(t *testing.T) {
+		stream, err := client.ExportMyData(martinCtx, &userspb.ExportMyDataRequest{
+			UserId: john.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.ExportMyData: json: successful", func(t *testing.T) {
+		stream, err := client.ExportMyData(johnCtx, &userspb.ExportMyDataRequest{
+			UserId: john.Id,
+			Format: userspb.ExportFormat_EXPORT_FORMAT_JSON,
+		})
+		require.NoError(t, err)
+
+		header, data := receiveExport(t, stream)
+
+		require.Equal(t, "application/json", header.GetContentType())
+		require.Contains(t, header.GetFileName(), john.Id)
+
+		var export struct {
+			Profile struct {
+				Email string `json:"email"`
+				User  struct {
+					ID       string `json:"id"`
+					Username string `json:"username"`
+				} `json:"user"`
+			} `json:"profile"`
+			Friends []struct {
+				RequesterID string `json:"requester_id"`
+				RecipientID string `json:"recipient_id"`
+				Status      string `json:"status"`
+			} `json:"friends"`
+			CoinTransactions []json.RawMessage `json:"coin_transactions"`
+			Achievements     []struct {
+				Code string `json:"code"`
+			} `json:"achievements"`
+			LoginDays     []json.RawMessage `json:"login_days"`
+			Notifications []json.RawMessage `json:"notifications"`
+			ReportsFiled  []json.RawMessage `json:"reports_filed"`
+		}
+
+		require.NoError(t, json.Unmarshal(data, &export))
+		require.Equal(t, john.Id, export.Profile.User.ID)
+		require.Equal(t, john.Username, export.Profile.User.Username)
+		require.Equal(t, john.Email, export.Profile.Email)
+		require.Len(t, export.Friends, 1)
+		require.Equal(t, john.Id, export.Friends[0].RequesterID)
+		require.Equal(t, lukas.Id, export.Friends[0].RecipientID)
+		require.Equal(t, "accepted", export.Friends[0].Status)
+		require.NotEmpty(t, export.LoginDays)
+		require.NotEmpty(t, export.Achievements)
+		require.NotNil(t, export.CoinTransactions)
+		require.NotNil(t, export.Notifications)
+		require.NotNil(t, export.ReportsFiled)
+	})
+
+	t.Run("profile.ExportMyData: zip: successful", func(t *testing.T) {
+		stream, err := client.ExportMyData(soniaCtx, &userspb.ExportMyDataRequest{
+			UserId: sonia.Id,
+			Format: userspb.ExportFormat_EXPORT_FORMAT_ZIP,
+		})
+		require.NoError(t, err)
+
+		header, data := receiveExport(t, stream)
+
+		require.Equal(t, "application/zip", header.GetContentType())
+
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		require.Len(t, archive.File, 19)
+
+		names := make([]string, len(archive.File))
+		for i, file := range archive.File {
+			names[i] = file.Name
+		}
+
+		require.Contains(t, names, "coin_transactions.json")
+		require.Contains(t, names, "clan_membership.json")
+		require.Contains(t, names, "notifications.json")
+	})
+
 	t.Run("profile.DeleteAccount: token not provided", func(t *testing.T) {
 		_, err := client.DeleteAccount(emptyCtx, &userspb.DeleteAccountRequest{
 			UserId: sonia.Id,
@@ -347,3 +1631,27 @@ func ProfileServiceTest(t *testing.T, client userspb.UsersProfileServiceClient,
 		require.NoError(t, err)
 	})
 }
+
+func receiveExport(t *testing.T, stream grpc.ServerStreamingClient[userspb.ExportDataChunk]) (*userspb.ExportDataChunk, []byte) {
+	var header *userspb.ExportDataChunk
+	var data []byte
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		if header == nil {
+			header = chunk
+		}
+
+		data = append(data, chunk.GetData()...)
+	}
+
+	require.NotNil(t, header)
+
+	return header, data
+}
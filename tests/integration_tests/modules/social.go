@@ -1,7 +1,10 @@
 package modules
 
 import (
+	"context"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/report"
 	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
 )
 
@@ -36,6 +41,35 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		require.NoError(t, err)
 	})
 
+	t.Run("social.AddFriend: self", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: john.Id,
+			RecipientId: john.Id,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.AddFriend: repeated request", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: john.Id,
+			RecipientId: martin.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "friend request", "recipient_id", martin.Id)
+	})
+
+	t.Run("social.AcceptFriend: not found", func(t *testing.T) {
+		_, err := client.AcceptFriend(ctx, &userspb.AcceptFriendRequest{
+			RecipientId: john.Id,
+			RequesterId: martin.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "friend request", "requester_id", martin.Id)
+	})
+
 	t.Run("social.RejectFriend: not found", func(t *testing.T) {
 		_, err := client.RejectFriend(ctx, &userspb.RejectFriendRequest{
 			RecipientId: lukas.Id,
@@ -43,7 +77,7 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		})
 
 		require.Error(t, err)
-		testerror.RequireNotFoundError(t, err, "user", "id", martin.Id)
+		testerror.RequireNotFoundError(t, err, "friend request", "requester_id", martin.Id)
 	})
 
 	t.Run("social.RejectFriend: successful", func(t *testing.T) {
@@ -74,6 +108,57 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		}
 	})
 
+	t.Run("social.AddFriend: pending limit reached", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: john.Id,
+			RecipientId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrPendingLimitReached)
+	})
+
+	t.Run("social.ListIncomingFriendRequests: permission denied", func(t *testing.T) {
+		res, err := client.ListIncomingFriendRequests(johnCtx, &userspb.ListIncomingFriendRequestsRequest{
+			UserId: martin.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.ListIncomingFriendRequests: successful", func(t *testing.T) {
+		res, err := client.ListIncomingFriendRequests(martinCtx, &userspb.ListIncomingFriendRequestsRequest{
+			UserId: martin.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 1)
+		require.Equal(t, john.Id, res.Friends[0].User.Id)
+		require.Equal(t, userspb.Status_STATUS_PENDING, res.Friends[0].Status)
+		require.NotNil(t, res.Friends[0].Since)
+		require.Nil(t, res.NextCursor)
+	})
+
+	t.Run("social.ListOutgoingFriendRequests: successful", func(t *testing.T) {
+		res, err := client.ListOutgoingFriendRequests(johnCtx, &userspb.ListOutgoingFriendRequestsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 2)
+	})
+
+	t.Run("social.ListOutgoingFriendRequests: empty", func(t *testing.T) {
+		res, err := client.ListOutgoingFriendRequests(martinCtx, &userspb.ListOutgoingFriendRequestsRequest{
+			UserId: martin.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Friends)
+	})
+
 	t.Run("social.AcceptFriend: by list: successful", func(t *testing.T) {
 		reqs := []*userspb.AcceptFriendRequest{
 			{
@@ -93,6 +178,46 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		}
 	})
 
+	t.Run("social.AcceptFriend: already accepted", func(t *testing.T) {
+		_, err := client.AcceptFriend(ctx, &userspb.AcceptFriendRequest{
+			RecipientId: martin.Id,
+			RequesterId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "friend request", "requester_id", john.Id)
+	})
+
+	t.Run("social.RejectFriend: already accepted", func(t *testing.T) {
+		_, err := client.RejectFriend(ctx, &userspb.RejectFriendRequest{
+			RecipientId: martin.Id,
+			RequesterId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "friend request", "requester_id", john.Id)
+	})
+
+	t.Run("social.AddFriend: already friends", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: lukas.Id,
+			RecipientId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "friend", "id", john.Id)
+	})
+
+	t.Run("social.AddFriend: friend limit reached", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: john.Id,
+			RecipientId: masha.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrFriendLimitReached)
+	})
+
 	t.Run("social.ListFriends: not found", func(t *testing.T) {
 		res, err := client.ListFriends(soniaCtx, &userspb.ListFriendsRequest{
 			UserId: sonia.Id,
@@ -123,58 +248,197 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		require.Len(t, res.Friends, 1)
 	})
 
-	t.Run("social.BlockFriend: token not provided", func(t *testing.T) {
-		_, err := client.BlockFriend(emptyCtx, &userspb.BlockFriendRequest{
-			UserId:   martin.Id,
-			FriendId: john.Id,
+	t.Run("social.SuggestFriends: permission denied", func(t *testing.T) {
+		res, err := client.SuggestFriends(lukasCtx, &userspb.SuggestFriendsRequest{
+			UserId: martin.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
 	})
 
-	t.Run("social.BlockFriend: invalid token", func(t *testing.T) {
-		_, err := client.BlockFriend(invalidCtx, &userspb.BlockFriendRequest{
-			UserId:   martin.Id,
-			FriendId: john.Id,
+	t.Run("social.SuggestFriends: mutual friends first: successful", func(t *testing.T) {
+		res, err := client.SuggestFriends(martinCtx, &userspb.SuggestFriendsRequest{
+			UserId: martin.Id,
 		})
 
-		require.Error(t, err)
-		testerror.RequireForbiddenError(t, err, jw.AuthInvalidTokenError)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Suggestions)
+
+		first := res.Suggestions[0]
+		require.Equal(t, lukas.Id, first.User.Id)
+		require.Equal(t, uint64(1), first.MutualFriends)
+		require.Equal(t, userspb.SuggestionReason_SUGGESTION_REASON_MUTUAL_FRIENDS, first.Reasons[0])
+
+		for _, suggestion := range res.Suggestions {
+			require.NotEqual(t, john.Id, suggestion.User.Id)
+			require.NotEqual(t, martin.Id, suggestion.User.Id)
+			require.NotEmpty(t, suggestion.Reasons)
+		}
 	})
 
-	t.Run("social.BlockFriend: permission denied", func(t *testing.T) {
-		_, err := client.BlockFriend(lukasCtx, &userspb.BlockFriendRequest{
-			UserId:   martin.Id,
-			FriendId: john.Id,
+	t.Run("social.SuggestFriends: friends excluded: successful", func(t *testing.T) {
+		res, err := client.SuggestFriends(johnCtx, &userspb.SuggestFriendsRequest{
+			UserId: john.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Suggestions), 1)
+
+		for _, suggestion := range res.Suggestions {
+			require.NotContains(t, []string{john.Id, martin.Id, lukas.Id}, suggestion.User.Id)
+		}
+	})
+
+	t.Run("social.SuggestFriends: ranked by score with reasons: successful", func(t *testing.T) {
+		res, err := client.SuggestFriends(martinCtx, &userspb.SuggestFriendsRequest{
+			UserId: martin.Id,
+		})
+
+		require.NoError(t, err)
+		requireSuggestionsRanked(t, res.Suggestions)
+
+		var found bool
+		for _, suggestion := range res.Suggestions {
+			if suggestion.User.Id != sonia.Id {
+				continue
+			}
+
+			found = true
+			require.Zero(t, suggestion.MutualFriends)
+			require.Zero(t, suggestion.MatchesTogether)
+			require.Equal(t, []userspb.SuggestionReason{userspb.SuggestionReason_SUGGESTION_REASON_SIMILAR_RATING}, suggestion.Reasons)
+		}
+
+		require.True(t, found)
+	})
+
+	t.Run("social.GetMutualFriends: token not provided", func(t *testing.T) {
+		_, err := client.GetMutualFriends(emptyCtx, &userspb.GetMutualFriendsRequest{
+			UserId: lukas.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
 	})
 
-	t.Run("social.BlockFriend: not found", func(t *testing.T) {
-		testID := uuid.New().String()
-		_, err := client.BlockFriend(martinCtx, &userspb.BlockFriendRequest{
-			UserId:   martin.Id,
-			FriendId: testID,
+	t.Run("social.GetMutualFriends: self", func(t *testing.T) {
+		res, err := client.GetMutualFriends(martinCtx, &userspb.GetMutualFriendsRequest{
+			UserId: martin.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+		require.Nil(t, res)
+		require.ErrorContains(t, err, profile.ErrMutualFriendsSelf.Error())
 	})
 
-	t.Run("social.BlockFriend: successful", func(t *testing.T) {
-		_, err := client.BlockFriend(martinCtx, &userspb.BlockFriendRequest{
-			UserId:   martin.Id,
-			FriendId: john.Id,
+	t.Run("social.GetMutualFriends: successful", func(t *testing.T) {
+		res, err := client.GetMutualFriends(martinCtx, &userspb.GetMutualFriendsRequest{
+			UserId: lukas.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.Count)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, john.Id, res.Users[0].Id)
+		require.Nil(t, res.NextCursor)
+
+		cursor := (&profile.FriendsCursor{
+			Sort:     profile.SortByUsername,
+			Username: res.Users[0].Username,
+			ID:       uuid.MustParse(res.Users[0].Id),
+		}).Encode()
+
+		next, err := client.GetMutualFriends(martinCtx, &userspb.GetMutualFriendsRequest{
+			UserId: lukas.Id,
+			Size:   1,
+			Cursor: &cursor,
 		})
 
 		require.NoError(t, err)
+		require.Equal(t, uint64(1), next.Count)
+		require.Empty(t, next.Users)
 	})
 
-	t.Run("social.UnblockFriend: token not provided", func(t *testing.T) {
-		_, err := client.UnblockFriend(emptyCtx, &userspb.UnblockFriendRequest{
+	t.Run("social.GetMutualFriends: none in common", func(t *testing.T) {
+		res, err := client.GetMutualFriends(soniaCtx, &userspb.GetMutualFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Zero(t, res.Count)
+		require.Empty(t, res.Users)
+	})
+
+	t.Run("social.ListAcceptedFriends: empty", func(t *testing.T) {
+		res, err := client.ListAcceptedFriends(soniaCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Friends)
+		require.Nil(t, res.NextCursor)
+	})
+
+	t.Run("social.ListAcceptedFriends: by cursor: successful", func(t *testing.T) {
+		first, err := client.ListAcceptedFriends(johnCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: john.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, first.Friends, 1)
+		require.NotNil(t, first.NextCursor)
+
+		second, err := client.ListAcceptedFriends(johnCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: john.Id,
+			Size:   1,
+			Cursor: first.NextCursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, second.Friends, 1)
+		require.Nil(t, second.NextCursor)
+		require.NotEqual(t, first.Friends[0].User.Id, second.Friends[0].User.Id)
+		require.Less(t, first.Friends[0].User.Username, second.Friends[0].User.Username)
+	})
+
+	t.Run("social.ListAcceptedFriends: by rating descending: successful", func(t *testing.T) {
+		res, err := client.ListAcceptedFriends(johnCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId:     john.Id,
+			Sort:       userspb.FriendsSort_FRIENDS_SORT_RATING,
+			Descending: true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 2)
+		require.GreaterOrEqual(t, res.Friends[0].User.Rating, res.Friends[1].User.Rating)
+	})
+
+	t.Run("social.ListAcceptedFriends: cursor of another sort", func(t *testing.T) {
+		first, err := client.ListAcceptedFriends(johnCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: john.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListAcceptedFriends(johnCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: john.Id,
+			Sort:   userspb.FriendsSort_FRIENDS_SORT_SINCE,
+			Size:   1,
+			Cursor: first.NextCursor,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("social.BlockFriend: token not provided", func(t *testing.T) {
+		_, err := client.BlockFriend(emptyCtx, &userspb.BlockFriendRequest{
 			UserId:   martin.Id,
 			FriendId: john.Id,
 		})
@@ -183,7 +447,7 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
 	})
 
-	t.Run("social.UnblockFriend: invalid token", func(t *testing.T) {
+	t.Run("social.BlockFriend: invalid token", func(t *testing.T) {
 		_, err := client.BlockFriend(invalidCtx, &userspb.BlockFriendRequest{
 			UserId:   martin.Id,
 			FriendId: john.Id,
@@ -193,7 +457,7 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		testerror.RequireForbiddenError(t, err, jw.AuthInvalidTokenError)
 	})
 
-	t.Run("social.UnblockFriend: permission denied", func(t *testing.T) {
+	t.Run("social.BlockFriend: permission denied", func(t *testing.T) {
 		_, err := client.BlockFriend(lukasCtx, &userspb.BlockFriendRequest{
 			UserId:   martin.Id,
 			FriendId: john.Id,
@@ -203,7 +467,7 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
 	})
 
-	t.Run("social.UnblockFriend: not found", func(t *testing.T) {
+	t.Run("social.BlockFriend: not found", func(t *testing.T) {
 		testID := uuid.New().String()
 		_, err := client.BlockFriend(martinCtx, &userspb.BlockFriendRequest{
 			UserId:   martin.Id,
@@ -214,7 +478,7 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		testerror.RequireNotFoundError(t, err, "user", "id", testID)
 	})
 
-	t.Run("social.UnblockFriend: successful", func(t *testing.T) {
+	t.Run("social.BlockFriend: successful", func(t *testing.T) {
 		_, err := client.BlockFriend(martinCtx, &userspb.BlockFriendRequest{
 			UserId:   martin.Id,
 			FriendId: john.Id,
@@ -223,63 +487,937 @@ func SocialServiceTest(t *testing.T, client userspb.UsersSocialServiceClient, _
 		require.NoError(t, err)
 	})
 
-	t.Run("social.RemoveFriend: token not provided", func(t *testing.T) {
-		_, err := client.RemoveFriend(emptyCtx, &userspb.RemoveFriendRequest{
-			RequesterId: martin.Id,
-			FriendId:    john.Id,
+	t.Run("social.BlockFriend: self", func(t *testing.T) {
+		_, err := client.BlockFriend(martinCtx, &userspb.BlockFriendRequest{
+			UserId:   martin.Id,
+			FriendId: martin.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
 	})
 
-	t.Run("social.RemoveFriend: invalid token", func(t *testing.T) {
-		_, err := client.RemoveFriend(invalidCtx, &userspb.RemoveFriendRequest{
-			RequesterId: martin.Id,
-			FriendId:    john.Id,
+	t.Run("social.BlockFriend: friendship removed", func(t *testing.T) {
+		res, err := client.ListAcceptedFriends(johnCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 1)
+		require.Equal(t, lukas.Id, res.Friends[0].User.Id)
+	})
+
+	t.Run("social.AddFriend: blocked by recipient", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: john.Id,
+			RecipientId: martin.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireForbiddenError(t, err, jw.AuthInvalidTokenError)
+		testerror.RequireForbiddenError(t, err, profile.ErrUserBlocked)
 	})
 
-	t.Run("social.RemoveFriend: permission denied", func(t *testing.T) {
-		_, err := client.RemoveFriend(lukasCtx, &userspb.RemoveFriendRequest{
+	t.Run("social.AddFriend: recipient blocked", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
 			RequesterId: martin.Id,
-			FriendId:    john.Id,
+			RecipientId: john.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+		testerror.RequireForbiddenError(t, err, profile.ErrUserBlocked)
 	})
 
-	t.Run("social.RemoveFriend: not found", func(t *testing.T) {
-		testID := uuid.New().String()
-		_, err := client.RemoveFriend(martinCtx, &userspb.RemoveFriendRequest{
-			RequesterId: martin.Id,
-			FriendId:    testID,
+	t.Run("social.ListBlockedUsers: permission denied", func(t *testing.T) {
+		res, err := client.ListBlockedUsers(lukasCtx, &userspb.ListBlockedUsersRequest{
+			UserId: martin.Id,
 		})
 
 		require.Error(t, err)
-		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
 	})
 
-	t.Run("social.RemoveFriend: successful", func(t *testing.T) {
-		_, err := client.RemoveFriend(johnCtx, &userspb.RemoveFriendRequest{
-			RequesterId: john.Id,
-			FriendId:    martin.Id,
+	t.Run("social.ListBlockedUsers: successful", func(t *testing.T) {
+		res, err := client.ListBlockedUsers(martinCtx, &userspb.ListBlockedUsersRequest{
+			UserId: martin.Id,
 		})
 
 		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, john.Id, res.Users[0].User.Id)
+		require.NotNil(t, res.Users[0].BlockedAt)
 	})
 
-	t.Run("social.ListFriends: by requester id: successful", func(t *testing.T) {
-		res, err := client.ListFriends(johnCtx, &userspb.ListFriendsRequest{
+	t.Run("social.ListBlockedUsers: not blocked back", func(t *testing.T) {
+		res, err := client.ListBlockedUsers(johnCtx, &userspb.ListBlockedUsersRequest{
 			UserId: john.Id,
 		})
 
 		require.NoError(t, err)
-		require.NotNil(t, res)
-		require.Len(t, res.Friends, 1)
+		require.Empty(t, res.Users)
+	})
+
+	t.Run("social.GetMutualFriends: blocked", func(t *testing.T) {
+		_, err := client.GetMutualFriends(martinCtx, &userspb.GetMutualFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrUserBlocked)
 	})
+
+	t.Run("social.GetMutualFriends: blocked by the user", func(t *testing.T) {
+		_, err := client.GetMutualFriends(johnCtx, &userspb.GetMutualFriendsRequest{
+			UserId: martin.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrUserBlocked)
+	})
+
+	t.Run("social.UnblockFriend: token not provided", func(t *testing.T) {
+		_, err := client.UnblockFriend(emptyCtx, &userspb.UnblockFriendRequest{
+			UserId:   martin.Id,
+			FriendId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("social.UnblockFriend: invalid token", func(t *testing.T) {
+		_, err := client.UnblockFriend(invalidCtx, &userspb.UnblockFriendRequest{
+			UserId:   martin.Id,
+			FriendId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthInvalidTokenError)
+	})
+
+	t.Run("social.UnblockFriend: permission denied", func(t *testing.T) {
+		_, err := client.UnblockFriend(lukasCtx, &userspb.UnblockFriendRequest{
+			UserId:   martin.Id,
+			FriendId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.UnblockFriend: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.UnblockFriend(martinCtx, &userspb.UnblockFriendRequest{
+			UserId:   martin.Id,
+			FriendId: testID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "block", "id", testID)
+	})
+
+	t.Run("social.UnblockFriend: not blocked", func(t *testing.T) {
+		_, err := client.UnblockFriend(johnCtx, &userspb.UnblockFriendRequest{
+			UserId:   john.Id,
+			FriendId: martin.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "block", "id", martin.Id)
+	})
+
+	t.Run("social.SearchPlayers: token not provided", func(t *testing.T) {
+		_, err := client.SearchPlayers(emptyCtx, &userspb.SearchPlayersRequest{
+			Query: "ma",
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("social.SearchPlayers: empty query", func(t *testing.T) {
+		_, err := client.SearchPlayers(lukasCtx, &userspb.SearchPlayersRequest{
+			Query: "  ",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.SearchPlayers: by prefix: successful", func(t *testing.T) {
+		res, err := client.SearchPlayers(lukasCtx, &userspb.SearchPlayersRequest{
+			Query: "ma",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Len(t, res.Users, 2)
+		require.Nil(t, res.NextCursor)
+
+		usernames := []string{res.Users[0].Username, res.Users[1].Username}
+		require.ElementsMatch(t, []string{martin.Username, masha.Username}, usernames)
+	})
+
+	t.Run("social.SearchPlayers: fuzzy: successful", func(t *testing.T) {
+		res, err := client.SearchPlayers(lukasCtx, &userspb.SearchPlayersRequest{
+			Query: "mashaa",
+		})
+
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Users)
+		require.Equal(t, masha.Username, res.Users[0].Username)
+	})
+
+	t.Run("social.SearchPlayers: by cursor: successful", func(t *testing.T) {
+		first, err := client.SearchPlayers(lukasCtx, &userspb.SearchPlayersRequest{
+			Query: "ma",
+			Size:  1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, first.Users, 1)
+		require.NotNil(t, first.NextCursor)
+
+		second, err := client.SearchPlayers(lukasCtx, &userspb.SearchPlayersRequest{
+			Query:  "ma",
+			Size:   1,
+			Cursor: first.NextCursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, second.Users, 1)
+		require.Nil(t, second.NextCursor)
+		require.NotEqual(t, first.Users[0].Id, second.Users[0].Id)
+	})
+
+	t.Run("social.SearchPlayers: invalid cursor", func(t *testing.T) {
+		cursor := "not a cursor"
+		_, err := client.SearchPlayers(lukasCtx, &userspb.SearchPlayersRequest{
+			Query:  "ma",
+			Cursor: &cursor,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.SearchPlayers: blocked users excluded", func(t *testing.T) {
+		res, err := client.SearchPlayers(johnCtx, &userspb.SearchPlayersRequest{
+			Query: "ma",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, masha.Username, res.Users[0].Username)
+	})
+
+	t.Run("social.SearchPlayers: blocked users excluded for the blocker", func(t *testing.T) {
+		res, err := client.SearchPlayers(martinCtx, &userspb.SearchPlayersRequest{
+			Query: john.Username,
+		})
+
+		require.NoError(t, err)
+
+		for _, u := range res.Users {
+			require.NotEqual(t, john.Id, u.Id)
+		}
+	})
+
+	t.Run("social.UnblockFriend: successful", func(t *testing.T) {
+		_, err := client.UnblockFriend(martinCtx, &userspb.UnblockFriendRequest{
+			UserId:   martin.Id,
+			FriendId: john.Id,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.SearchPlayers(johnCtx, &userspb.SearchPlayersRequest{
+			Query: "ma",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Users, 2)
+	})
+
+	t.Run("social.AddFriend: after unblock: successful", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: john.Id,
+			RecipientId: martin.Id,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.AcceptFriend(ctx, &userspb.AcceptFriendRequest{
+			RecipientId: martin.Id,
+			RequesterId: john.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("social.RemoveFriend: token not provided", func(t *testing.T) {
+		_, err := client.RemoveFriend(emptyCtx, &userspb.RemoveFriendRequest{
+			RequesterId: martin.Id,
+			FriendId:    john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("social.RemoveFriend: invalid token", func(t *testing.T) {
+		_, err := client.RemoveFriend(invalidCtx, &userspb.RemoveFriendRequest{
+			RequesterId: martin.Id,
+			FriendId:    john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthInvalidTokenError)
+	})
+
+	t.Run("social.RemoveFriend: permission denied", func(t *testing.T) {
+		_, err := client.RemoveFriend(lukasCtx, &userspb.RemoveFriendRequest{
+			RequesterId: martin.Id,
+			FriendId:    john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.RemoveFriend: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.RemoveFriend(martinCtx, &userspb.RemoveFriendRequest{
+			RequesterId: martin.Id,
+			FriendId:    testID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("social.RemoveFriend: successful", func(t *testing.T) {
+		_, err := client.RemoveFriend(johnCtx, &userspb.RemoveFriendRequest{
+			RequesterId: john.Id,
+			FriendId:    martin.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("social.ListFriends: by requester id: successful", func(t *testing.T) {
+		res, err := client.ListFriends(johnCtx, &userspb.ListFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Len(t, res.Friends, 1)
+	})
+
+	t.Run("social.AddFriend: mutual requests: accepted", func(t *testing.T) {
+		_, err := client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: masha.Id,
+			RecipientId: martin.Id,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.AddFriend(ctx, &userspb.AddFriendRequest{
+			RequesterId: martin.Id,
+			RecipientId: masha.Id,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListAcceptedFriends(martinCtx, &userspb.ListAcceptedFriendsRequest{
+			UserId: martin.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 1)
+		require.Equal(t, masha.Id, res.Friends[0].User.Id)
+
+		outgoing, err := client.ListOutgoingFriendRequests(martinCtx, &userspb.ListOutgoingFriendRequestsRequest{
+			UserId: martin.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, outgoing.Friends)
+
+		_, err = client.RemoveFriend(martinCtx, &userspb.RemoveFriendRequest{
+			RequesterId: martin.Id,
+			FriendId:    masha.Id,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("social.Heartbeat: token not provided", func(t *testing.T) {
+		_, err := client.Heartbeat(emptyCtx, &userspb.HeartbeatRequest{
+			UserId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("social.Heartbeat: permission denied", func(t *testing.T) {
+		_, err := client.Heartbeat(johnCtx, &userspb.HeartbeatRequest{
+			UserId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.Heartbeat: successful", func(t *testing.T) {
+		_, err := client.Heartbeat(lukasCtx, &userspb.HeartbeatRequest{
+			UserId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListFriends(johnCtx, &userspb.ListFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 1)
+		require.NotNil(t, res.Friends[0].Presence)
+		require.Equal(t, userspb.PresenceStatus_PRESENCE_STATUS_ONLINE, res.Friends[0].Presence.Status)
+	})
+
+	t.Run("social.UpdatePresence: status not provided", func(t *testing.T) {
+		_, err := client.UpdatePresence(lukasCtx, &userspb.UpdatePresenceRequest{
+			UserId: lukas.Id,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.UpdatePresence: successful", func(t *testing.T) {
+		_, err := client.UpdatePresence(lukasCtx, &userspb.UpdatePresenceRequest{
+			UserId: lukas.Id,
+			Status: userspb.PresenceStatus_PRESENCE_STATUS_IN_MATCH,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.ListFriends(johnCtx, &userspb.ListFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 1)
+		require.Equal(t, userspb.PresenceStatus_PRESENCE_STATUS_IN_MATCH, res.Friends[0].Presence.Status)
+	})
+
+	t.Run("social.ListFriends: presence hidden from others", func(t *testing.T) {
+		res, err := client.ListFriends(lukasCtx, &userspb.ListFriendsRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Friends, 1)
+		require.Nil(t, res.Friends[0].Presence)
+	})
+
+	t.Run("social.SubscribeFriendsPresence: permission denied", func(t *testing.T) {
+		stream, err := client.SubscribeFriendsPresence(lukasCtx, &userspb.SubscribeFriendsPresenceRequest{
+			UserId: john.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.SubscribeFriendsPresence: successful", func(t *testing.T) {
+		streamCtx, cancel := context.WithTimeout(johnCtx, time.Second*10)
+		defer cancel()
+
+		stream, err := client.SubscribeFriendsPresence(streamCtx, &userspb.SubscribeFriendsPresenceRequest{
+			UserId: john.Id,
+		})
+		require.NoError(t, err)
+
+		initial, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, lukas.Id, initial.UserId)
+		require.Equal(t, userspb.PresenceStatus_PRESENCE_STATUS_IN_MATCH, initial.Status)
+
+		_, err = client.UpdatePresence(lukasCtx, &userspb.UpdatePresenceRequest{
+			UserId: lukas.Id,
+			Status: userspb.PresenceStatus_PRESENCE_STATUS_OFFLINE,
+		})
+		require.NoError(t, err)
+
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, lukas.Id, update.UserId)
+		require.Equal(t, userspb.PresenceStatus_PRESENCE_STATUS_OFFLINE, update.Status)
+	})
+
+	var partyID string
+
+	t.Run("social.CreateParty: permission denied", func(t *testing.T) {
+		_, err := client.CreateParty(lukasCtx, &userspb.CreatePartyRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.CreateParty: successful", func(t *testing.T) {
+		res, err := client.CreateParty(johnCtx, &userspb.CreatePartyRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, john.Id, res.LeaderId)
+		require.Equal(t, []string{john.Id}, res.MemberIds)
+		require.Equal(t, userspb.PartyEvent_PARTY_EVENT_CREATED, res.Event)
+
+		partyID = res.Id
+	})
+
+	t.Run("social.CreateParty: already in a party", func(t *testing.T) {
+		_, err := client.CreateParty(johnCtx, &userspb.CreatePartyRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "party member", "user_id", john.Id)
+	})
+
+	t.Run("social.InviteToParty: not friends", func(t *testing.T) {
+		_, err := client.InviteToParty(johnCtx, &userspb.InviteToPartyRequest{
+			UserId:   john.Id,
+			PartyId:  partyID,
+			FriendId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrNotFriends)
+	})
+
+	t.Run("social.InviteToParty: not the leader", func(t *testing.T) {
+		_, err := client.InviteToParty(lukasCtx, &userspb.InviteToPartyRequest{
+			UserId:   lukas.Id,
+			PartyId:  partyID,
+			FriendId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrNotPartyLeader)
+	})
+
+	t.Run("social.SubscribeParty: not a member", func(t *testing.T) {
+		stream, err := client.SubscribeParty(lukasCtx, &userspb.SubscribePartyRequest{
+			UserId:  lukas.Id,
+			PartyId: partyID,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, profile.ErrNotPartyMember)
+	})
+
+	t.Run("social.InviteToParty: successful", func(t *testing.T) {
+		res, err := client.InviteToParty(johnCtx, &userspb.InviteToPartyRequest{
+			UserId:   john.Id,
+			PartyId:  partyID,
+			FriendId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{lukas.Id}, res.InvitedIds)
+		require.Equal(t, userspb.PartyEvent_PARTY_EVENT_INVITED, res.Event)
+	})
+
+	t.Run("social.InviteToParty: already invited", func(t *testing.T) {
+		_, err := client.InviteToParty(johnCtx, &userspb.InviteToPartyRequest{
+			UserId:   john.Id,
+			PartyId:  partyID,
+			FriendId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "party invite", "user_id", lukas.Id)
+	})
+
+	t.Run("social.DeclinePartyInvite: successful", func(t *testing.T) {
+		_, err := client.DeclinePartyInvite(lukasCtx, &userspb.DeclinePartyInviteRequest{
+			UserId:  lukas.Id,
+			PartyId: partyID,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.DeclinePartyInvite(lukasCtx, &userspb.DeclinePartyInviteRequest{
+			UserId:  lukas.Id,
+			PartyId: partyID,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "party invite", "user_id", lukas.Id)
+	})
+
+	t.Run("social.SubscribeParty: invite accepted: successful", func(t *testing.T) {
+		_, err := client.InviteToParty(johnCtx, &userspb.InviteToPartyRequest{
+			UserId:   john.Id,
+			PartyId:  partyID,
+			FriendId: lukas.Id,
+		})
+		require.NoError(t, err)
+
+		streamCtx, cancel := context.WithTimeout(lukasCtx, time.Second*10)
+		defer cancel()
+
+		stream, err := client.SubscribeParty(streamCtx, &userspb.SubscribePartyRequest{
+			UserId:  lukas.Id,
+			PartyId: partyID,
+		})
+		require.NoError(t, err)
+
+		initial, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []string{lukas.Id}, initial.InvitedIds)
+
+		_, err = client.AcceptPartyInvite(lukasCtx, &userspb.AcceptPartyInviteRequest{
+			UserId:  lukas.Id,
+			PartyId: partyID,
+		})
+		require.NoError(t, err)
+
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, userspb.PartyEvent_PARTY_EVENT_JOINED, update.Event)
+		require.Equal(t, []string{john.Id, lukas.Id}, update.MemberIds)
+		require.Empty(t, update.InvitedIds)
+	})
+
+	t.Run("social.PromotePartyLeader: successful", func(t *testing.T) {
+		res, err := client.PromotePartyLeader(johnCtx, &userspb.PromotePartyLeaderRequest{
+			UserId:   john.Id,
+			PartyId:  partyID,
+			MemberId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, lukas.Id, res.LeaderId)
+		require.Equal(t, userspb.PartyEvent_PARTY_EVENT_LEADER_CHANGED, res.Event)
+	})
+
+	t.Run("social.LeaveParty: leader leaves", func(t *testing.T) {
+		_, err := client.LeaveParty(lukasCtx, &userspb.LeavePartyRequest{
+			UserId:  lukas.Id,
+			PartyId: partyID,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.GetParty(johnCtx, &userspb.GetPartyRequest{
+			UserId: john.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, partyID, res.Id)
+		require.Equal(t, john.Id, res.LeaderId)
+		require.Equal(t, []string{john.Id}, res.MemberIds)
+	})
+
+	t.Run("social.LeaveParty: last member disbands the party", func(t *testing.T) {
+		_, err := client.LeaveParty(johnCtx, &userspb.LeavePartyRequest{
+			UserId:  john.Id,
+			PartyId: partyID,
+		})
+
+		require.NoError(t, err)
+
+		_, err = client.GetParty(johnCtx, &userspb.GetPartyRequest{
+			UserId: john.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "party member", "user_id", john.Id)
+	})
+
+	t.Run("social.ReportUser: access token not provided", func(t *testing.T) {
+		_, err := client.ReportUser(emptyCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: masha.Id,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_CHEATING,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("social.ReportUser: self", func(t *testing.T) {
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: sonia.Id,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_CHEATING,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.ReportUser: category not specified", func(t *testing.T) {
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: masha.Id,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.ReportUser: reported user not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: testID,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_CHEATING,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("social.ReportUser: match not played together", func(t *testing.T) {
+		matchID := uuid.New().String()
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: masha.Id,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_CHEATING,
+			MatchId:    &matchID,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("social.ReportUser: successful", func(t *testing.T) {
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: masha.Id,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_CHEATING,
+			Text:       "  answers every question in under a second  ",
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("social.ReportUser: duplicate", func(t *testing.T) {
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: masha.Id,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_CHEATING,
+		})
+
+		require.Error(t, err)
+		testerror.RequireAlreadyExistsError(t, err, "report", "reported_id", masha.Id)
+	})
+
+	t.Run("social.ReportUser: other categories: successful", func(t *testing.T) {
+		for _, category := range []userspb.ReportCategory{
+			userspb.ReportCategory_REPORT_CATEGORY_ABUSIVE_USERNAME,
+			userspb.ReportCategory_REPORT_CATEGORY_HARASSMENT,
+		} {
+			_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+				UserId:     sonia.Id,
+				ReportedId: masha.Id,
+				Category:   category,
+			})
+
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("social.ReportUser: rate limited", func(t *testing.T) {
+		_, err := client.ReportUser(soniaCtx, &userspb.ReportUserRequest{
+			UserId:     sonia.Id,
+			ReportedId: masha.Id,
+			Category:   userspb.ReportCategory_REPORT_CATEGORY_OTHER,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, report.ErrReportLimitReached)
+	})
+
+	t.Run("social.ListNotifications: access token not provided", func(t *testing.T) {
+		_, err := client.ListNotifications(emptyCtx, &userspb.ListNotificationsRequest{
+			UserId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("social.ListNotifications: permission denied", func(t *testing.T) {
+		_, err := client.ListNotifications(johnCtx, &userspb.ListNotificationsRequest{
+			UserId: lukas.Id,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.ListNotifications: successful", func(t *testing.T) {
+		res, err := client.ListNotifications(lukasCtx, &userspb.ListNotificationsRequest{
+			UserId: lukas.Id,
+			Size:   1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Notifications, 1)
+		require.NotNil(t, res.NextCursor)
+		require.Equal(t, userspb.NotificationKind_NOTIFICATION_KIND_PARTY_INVITE, res.Notifications[0].Kind)
+		require.Equal(t, john.Id, res.Notifications[0].GetActorId())
+		require.Equal(t, partyID, res.Notifications[0].GetPartyId())
+		require.Nil(t, res.Notifications[0].ReadAt)
+		require.GreaterOrEqual(t, res.UnreadCount, int64(2))
+
+		next, err := client.ListNotifications(lukasCtx, &userspb.ListNotificationsRequest{
+			UserId: lukas.Id,
+			Size:   1,
+			Cursor: res.NextCursor,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, next.Notifications, 1)
+		require.NotEqual(t, res.Notifications[0].Id, next.Notifications[0].Id)
+		require.False(t, next.Notifications[0].CreatedAt.AsTime().After(res.Notifications[0].CreatedAt.AsTime()))
+
+		marked, err := client.MarkRead(lukasCtx, &userspb.MarkReadRequest{
+			UserId:          lukas.Id,
+			NotificationIds: []string{res.Notifications[0].Id},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, res.UnreadCount-1, marked.UnreadCount)
+	})
+
+	t.Run("social.MarkRead: all: successful", func(t *testing.T) {
+		marked, err := client.MarkRead(lukasCtx, &userspb.MarkReadRequest{
+			UserId: lukas.Id,
+		})
+
+		require.NoError(t, err)
+		require.Zero(t, marked.UnreadCount)
+
+		res, err := client.ListNotifications(lukasCtx, &userspb.ListNotificationsRequest{
+			UserId:     lukas.Id,
+			UnreadOnly: true,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Notifications)
+		require.Zero(t, res.UnreadCount)
+	})
+
+	t.Run("social.SubscribeNotifications: permission denied", func(t *testing.T) {
+		stream, err := client.SubscribeNotifications(johnCtx, &userspb.SubscribeNotificationsRequest{
+			UserId: lukas.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("social.SubscribeNotifications: successful", func(t *testing.T) {
+		party, err := client.CreateParty(johnCtx, &userspb.CreatePartyRequest{
+			UserId: john.Id,
+		})
+		require.NoError(t, err)
+
+		defer func() {
+			_, _ = client.LeaveParty(johnCtx, &userspb.LeavePartyRequest{
+				UserId:  john.Id,
+				PartyId: party.Id,
+			})
+		}()
+
+		invite := &userspb.InviteToPartyRequest{
+			UserId:   john.Id,
+			PartyId:  party.Id,
+			FriendId: lukas.Id,
+		}
+
+		_, err = client.InviteToParty(johnCtx, invite)
+		require.NoError(t, err)
+
+		streamCtx, cancel := context.WithTimeout(lukasCtx, time.Second*10)
+		defer cancel()
+
+		stream, err := client.SubscribeNotifications(streamCtx, &userspb.SubscribeNotificationsRequest{
+			UserId: lukas.Id,
+		})
+		require.NoError(t, err)
+
+		missed, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, userspb.NotificationKind_NOTIFICATION_KIND_PARTY_INVITE, missed.Kind)
+		require.Equal(t, party.Id, missed.GetPartyId())
+
+		_, err = client.DeclinePartyInvite(lukasCtx, &userspb.DeclinePartyInviteRequest{
+			UserId:  lukas.Id,
+			PartyId: party.Id,
+		})
+		require.NoError(t, err)
+
+		_, err = client.InviteToParty(johnCtx, invite)
+		require.NoError(t, err)
+
+		live, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, userspb.NotificationKind_NOTIFICATION_KIND_PARTY_INVITE, live.Kind)
+		require.Equal(t, john.Id, live.GetActorId())
+		require.NotEqual(t, missed.Id, live.Id)
+	})
+}
+
+// requireSuggestionsRanked checks that suggestions come by descending score and list their reasons strongest first.
+func requireSuggestionsRanked(t *testing.T, suggestions []*userspb.FriendSuggestion) {
+	t.Helper()
+
+	order := []userspb.SuggestionReason{
+		userspb.SuggestionReason_SUGGESTION_REASON_MUTUAL_FRIENDS,
+		userspb.SuggestionReason_SUGGESTION_REASON_PLAYED_TOGETHER,
+		userspb.SuggestionReason_SUGGESTION_REASON_SIMILAR_RATING,
+		userspb.SuggestionReason_SUGGESTION_REASON_SAME_COUNTRY,
+	}
+
+	score := func(suggestion *userspb.FriendSuggestion) uint64 {
+		value := suggestion.MutualFriends*3 + suggestion.MatchesTogether*2
+
+		if slices.Contains(suggestion.Reasons, userspb.SuggestionReason_SUGGESTION_REASON_SIMILAR_RATING) {
+			value++
+		}
+
+		if slices.Contains(suggestion.Reasons, userspb.SuggestionReason_SUGGESTION_REASON_SAME_COUNTRY) {
+			value++
+		}
+
+		return value
+	}
+
+	for i, suggestion := range suggestions {
+		require.NotEmpty(t, suggestion.Reasons)
+		require.Equal(t, suggestion.MutualFriends > 0, slices.Contains(suggestion.Reasons, order[0]))
+		require.Equal(t, suggestion.MatchesTogether > 0, slices.Contains(suggestion.Reasons, order[1]))
+		require.True(t, slices.IsSortedFunc(suggestion.Reasons, func(a, b userspb.SuggestionReason) int {
+			return slices.Index(order, a) - slices.Index(order, b)
+		}))
+
+		if i > 0 {
+			require.LessOrEqual(t, score(suggestion), score(suggestions[i-1]))
+		}
+	}
 }
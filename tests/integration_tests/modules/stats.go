@@ -0,0 +1,182 @@
+package modules
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	jw "github.com/QuizWars-Ecosystem/go-common/pkg/jwt"
+	testerror "github.com/QuizWars-Ecosystem/go-common/pkg/testing/errors"
+	"github.com/stretchr/testify/require"
+
+	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
+	"github.com/QuizWars-Ecosystem/users-service/internal/models/profile"
+	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
+	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/stubs"
+)
+
+func CategoryStatsServiceTest(t *testing.T, client userspb.UsersProfileServiceClient, questions *stubs.Questions, _ *config.TestConfig) {
+	results := []*userspb.AnswerResult{
+		{QuestionId: "q-1", CategoryId: 1, Correct: true, AnswerTimeMs: 1000},
+		{QuestionId: "q-2", CategoryId: 1, Correct: false, AnswerTimeMs: 3000},
+		{QuestionId: "q-3", CategoryId: 2, Correct: true, AnswerTimeMs: 500},
+		{QuestionId: "q-4", CategoryId: 99, Correct: true, AnswerTimeMs: 700},
+	}
+
+	t.Run("profile.ReportAnswerResults: permission denied", func(t *testing.T) {
+		_, err := client.ReportAnswerResults(soniaCtx, &userspb.ReportAnswerResultsRequest{
+			UserId:  sonia.Id,
+			BatchId: "match-1",
+			Results: results,
+		})
+
+		require.Error(t, err)
+		testerror.RequireForbiddenError(t, err, jw.AuthPermissionDeniedError)
+	})
+
+	t.Run("profile.ReportAnswerResults: not found", func(t *testing.T) {
+		testID := uuid.New().String()
+		_, err := client.ReportAnswerResults(johnAdminCtx, &userspb.ReportAnswerResultsRequest{
+			UserId:  testID,
+			BatchId: "match-1",
+			Results: results,
+		})
+
+		require.Error(t, err)
+		testerror.RequireNotFoundError(t, err, "user", "id", testID)
+	})
+
+	t.Run("profile.ReportAnswerResults: results not provided", func(t *testing.T) {
+		_, err := client.ReportAnswerResults(johnAdminCtx, &userspb.ReportAnswerResultsRequest{
+			UserId:  sonia.Id,
+			BatchId: "match-1",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("profile.ReportAnswerResults: successful", func(t *testing.T) {
+		_, err := client.ReportAnswerResults(johnAdminCtx, &userspb.ReportAnswerResultsRequest{
+			UserId:  sonia.Id,
+			BatchId: "match-1",
+			Results: results,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.ReportAnswerResults: repeated batch: successful", func(t *testing.T) {
+		_, err := client.ReportAnswerResults(johnAdminCtx, &userspb.ReportAnswerResultsRequest{
+			UserId:  sonia.Id,
+			BatchId: "match-1",
+			Results: results,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("profile.GetCategoryStats: token not provided", func(t *testing.T) {
+		res, err := client.GetCategoryStats(emptyCtx, &userspb.GetCategoryStatsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, jw.AuthAccessTokenNotProvidedError)
+	})
+
+	t.Run("profile.GetCategoryStats: questions service down: successful", func(t *testing.T) {
+		questions.SetDown(true)
+
+		res, err := client.GetCategoryStats(johnCtx, &userspb.GetCategoryStatsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Stats, 3)
+
+		history := res.Stats[0]
+		require.Equal(t, int32(1), history.CategoryId)
+		require.Nil(t, history.Name)
+		require.Equal(t, int64(2), history.Answered)
+		require.Equal(t, int64(1), history.Correct)
+		require.InDelta(t, 0.5, history.Accuracy, 1e-9)
+		require.InDelta(t, 2000, history.AverageAnswerTimeMs, 1e-9)
+	})
+
+	t.Run("profile.GetCategoryStats: names resolved: successful", func(t *testing.T) {
+		questions.SetDown(false)
+
+		res, err := client.GetCategoryStats(johnCtx, &userspb.GetCategoryStatsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Stats, 3)
+
+		names := make(map[int32]string, len(res.Stats))
+		for _, s := range res.Stats {
+			if s.Name != nil {
+				names[s.CategoryId] = s.GetName()
+			}
+		}
+
+		require.Equal(t, map[int32]string{1: "History", 2: "Science"}, names)
+	})
+
+	t.Run("profile.GetCategoryStats: cached names: successful", func(t *testing.T) {
+		questions.SetDown(true)
+		defer questions.SetDown(false)
+
+		res, err := client.GetCategoryStats(johnCtx, &userspb.GetCategoryStatsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "History", res.Stats[0].GetName())
+	})
+
+	t.Run("profile.GetCategoryStats: empty", func(t *testing.T) {
+		res, err := client.GetCategoryStats(johnCtx, &userspb.GetCategoryStatsRequest{
+			UserId: masha.Id,
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, res.Stats)
+	})
+
+	t.Run("profile.GetCategoryStats: private: permission denied", func(t *testing.T) {
+		privacy := userspb.Privacy_PRIVACY_PRIVATE
+		_, err := client.UpdateProfile(soniaCtx, &userspb.UpdateProfileRequest{
+			UserId:  sonia.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+
+		res, err := client.GetCategoryStats(johnCtx, &userspb.GetCategoryStatsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+		testerror.RequireForbiddenError(t, err, profile.ErrCategoryStatsHidden)
+	})
+
+	t.Run("profile.GetCategoryStats: private: self: successful", func(t *testing.T) {
+		res, err := client.GetCategoryStats(soniaCtx, &userspb.GetCategoryStatsRequest{
+			UserId: sonia.Id,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, res.Stats, 3)
+
+		privacy := userspb.Privacy_PRIVACY_PUBLIC
+		_, err = client.UpdateProfile(soniaCtx, &userspb.UpdateProfileRequest{
+			UserId:  sonia.Id,
+			Privacy: &privacy,
+		})
+
+		require.NoError(t, err)
+	})
+}
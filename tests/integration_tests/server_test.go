@@ -4,10 +4,12 @@ import (
 	"testing"
 
 	test "github.com/QuizWars-Ecosystem/go-common/pkg/testing/server"
+	questionsv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/questions/v1"
 	userspb "github.com/QuizWars-Ecosystem/users-service/gen/external/users/v1"
 	"github.com/QuizWars-Ecosystem/users-service/internal/server"
 	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/config"
 	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/modules"
+	"github.com/QuizWars-Ecosystem/users-service/tests/integration_tests/stubs"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,6 +21,12 @@ func Test(t *testing.T) {
 }
 
 func runServer(t *testing.T, cfg *config.TestConfig) {
+	questions := stubs.NewQuestions(
+		&questionsv1.Category{Id: 1, Name: "History"},
+		&questionsv1.Category{Id: 2, Name: "Science"},
+	)
+	questions.Serve(t, cfg.QuestionsPort)
+
 	srv, err := server.NewTestServer(t.Context(), cfg.ServiceConfig)
 	require.NoError(t, err)
 
@@ -29,9 +37,19 @@ func runServer(t *testing.T, cfg *config.TestConfig) {
 	socialClient := userspb.NewUsersSocialServiceClient(conn)
 	profileClient := userspb.NewUsersProfileServiceClient(conn)
 	adminClient := userspb.NewUsersAdminServiceClient(conn)
+	leaderboardClient := userspb.NewUsersLeaderboardServiceClient(conn)
+	clansClient := userspb.NewUsersClansServiceClient(conn)
 
 	modules.AuthServiceTest(t, authClient, cfg)
 	modules.SocialServiceTest(t, socialClient, cfg)
-	modules.ProfileServiceTest(t, profileClient, cfg)
+	modules.ClansServiceTest(t, clansClient, cfg)
+	modules.ProfileServiceTest(t, profileClient, socialClient, cfg)
+	modules.CategoryStatsServiceTest(t, profileClient, questions, cfg)
+	modules.AuthRestoreServiceTest(t, authClient, cfg)
+	modules.LeaderboardServiceTest(t, leaderboardClient, cfg)
 	modules.AdminServiceTest(t, adminClient, cfg)
+	modules.FriendRequestExpiryTest(t, authClient, socialClient, srv, cfg)
+	modules.ClanHandOverTest(t, authClient, profileClient, clansClient, cfg)
+	modules.NotificationRetentionTest(t, authClient, socialClient, srv, cfg)
+	modules.PurgeDeletedProfilesTest(t, authClient, profileClient, socialClient, adminClient, srv, cfg)
 }
@@ -0,0 +1,64 @@
+package stubs
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	questionsv1 "github.com/QuizWars-Ecosystem/users-service/gen/external/questions/v1"
+)
+
+// Questions is a local questions service serving a fixed set of categories,
+// it answers with Unavailable while it is down.
+type Questions struct {
+	questionsv1.UnimplementedQuestionsClientServiceServer
+
+	mu         sync.RWMutex
+	categories []*questionsv1.Category
+	down       bool
+}
+
+func NewQuestions(categories ...*questionsv1.Category) *Questions {
+	return &Questions{categories: categories}
+}
+
+// Serve starts the stub on the port, it is stopped when the test ends.
+func (q *Questions) Serve(t *testing.T, port int) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	questionsv1.RegisterQuestionsClientServiceServer(server, q)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+}
+
+func (q *Questions) SetDown(down bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.down = down
+}
+
+func (q *Questions) GetCategories(_ context.Context, _ *emptypb.Empty) (*questionsv1.GetCategoriesResponse, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.down {
+		return nil, status.Error(codes.Unavailable, "questions service is down")
+	}
+
+	return &questionsv1.GetCategoriesResponse{Categories: q.categories}, nil
+}